@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recorder"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -58,6 +59,9 @@ type TestData struct {
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
+	configureEmulator()
+	recordingMode := configureRecording(t)
+
 	env, err := Environment()
 	if err != nil {
 		t.Fatalf("Error retrieving Environment: %+v", err)
@@ -85,6 +89,10 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	if recordingMode == recorder.ReplayMode {
+		loadRecordedTestData(t, &testData)
+	}
+
 	return testData
 }
 
@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"log"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/emulator"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+const emulatedId = "00000000-0000-0000-0000-000000000000"
+
+var (
+	emulatorServer *emulator.Server
+	emulatorOnce   = &sync.Once{}
+)
+
+// configureEmulator starts the emulated Resource Manager API when opted into (which is then used
+// for the lifetime of the test binary) and configures the Test Client to target it - the Provider
+// is configured to target it using the TestOptions returned from providerTestOptions.
+func configureEmulator() {
+	if !features.UseEmulatedAcceptanceTests() {
+		return
+	}
+
+	emulatorOnce.Do(func() {
+		emulatorServer = emulator.NewServer()
+		log.Printf("[DEBUG] Running the Acceptance Tests against the emulated Resource Manager API at %q", emulatorServer.Endpoint())
+
+		testclient.UseResourceManagerEndpoint(emulatorServer.Endpoint())
+
+		// these are always overwritten to ensure we don't send credentials to Azure
+		overrides := map[string]string{
+			"ARM_CLIENT_ID":       emulatedId,
+			"ARM_CLIENT_SECRET":   "emulated",
+			"ARM_SUBSCRIPTION_ID": emulatedId,
+			"ARM_TENANT_ID":       emulatedId,
+
+			// the emulator only supports the public cloud
+			"ARM_ENVIRONMENT":       "public",
+			"ARM_METADATA_HOST":     "",
+			"ARM_METADATA_HOSTNAME": "",
+			"ARM_METADATA_URL":      "",
+
+			// the emulator doesn't return a list of Resource Providers or Locations
+			"ARM_SKIP_PROVIDER_REGISTRATION":   "true",
+			"ARM_PROVIDER_ENHANCED_VALIDATION": "false",
+		}
+		for k, v := range overrides {
+			os.Setenv(k, v)
+		}
+
+		// whereas any Azure Region can be used, so these are defaulted if unset
+		defaults := map[string]string{
+			"ARM_TEST_LOCATION":      "westeurope",
+			"ARM_TEST_LOCATION_ALT":  "northeurope",
+			"ARM_TEST_LOCATION_ALT2": "eastus2",
+		}
+		for k, v := range defaults {
+			if os.Getenv(k) == "" {
+				os.Setenv(k, v)
+			}
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package emulator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resourceID is a parsed representation of a Resource Manager URI (either a Resource
+// or a Collection of Resources) which is used to determine how a request is handled
+type resourceID struct {
+	// segments is the list of segments within the URI, with the original casing
+	segments []string
+}
+
+// wellKnownSegments are the segments which Resource Manager normalizes the casing of, since
+// some API's use a different casing in the URI (e.g. `resourcegroups`)
+var wellKnownSegments = []string{
+	"subscriptions",
+	"resourceGroups",
+	"providers",
+}
+
+func parseResourceID(path string) resourceID {
+	segments := make([]string, 0)
+	for _, v := range strings.Split(path, "/") {
+		if v == "" {
+			continue
+		}
+
+		// only the keys (rather than the values) are normalized
+		if len(segments)%2 == 0 {
+			for _, segment := range wellKnownSegments {
+				if strings.EqualFold(v, segment) {
+					v = segment
+				}
+			}
+		}
+		segments = append(segments, v)
+	}
+	return resourceID{
+		segments: segments,
+	}
+}
+
+// ID returns the Resource ID, using the original casing for everything other than the well-known segments
+func (id resourceID) ID() string {
+	return "/" + strings.Join(id.segments, "/")
+}
+
+// key returns the case-insensitive key used to store this Resource
+func (id resourceID) key() string {
+	return strings.ToLower(id.ID())
+}
+
+// isCollection returns whether this URI refers to a Collection of Resources rather than a Resource.
+//
+// Resource Manager URI's are comprised of key/value pairs (e.g. `subscriptions/{id}`, `resourceGroups/{name}`
+// and `providers/{namespace}` followed by `{type}/{name}`) - as such an odd number of segments is a Collection.
+func (id resourceID) isCollection() bool {
+	return len(id.segments)%2 == 1
+}
+
+// isSubscription returns whether this is the URI for a Subscription, which always exists
+func (id resourceID) isSubscription() bool {
+	return len(id.segments) == 2 && strings.EqualFold(id.segments[0], "subscriptions")
+}
+
+// isResourceGroup returns whether this is the URI for a Resource Group
+func (id resourceID) isResourceGroup() bool {
+	return len(id.segments) == 4 && strings.EqualFold(id.segments[0], "subscriptions") && strings.EqualFold(id.segments[2], "resourceGroups")
+}
+
+// name returns the name of this Resource
+func (id resourceID) name() string {
+	if len(id.segments) == 0 {
+		return ""
+	}
+	return id.segments[len(id.segments)-1]
+}
+
+// resourceType returns the fully qualified Resource Type for this Resource (e.g.
+// `Microsoft.Network/dnsZones/A`), based on the last `providers` segment
+func (id resourceID) resourceType() string {
+	if id.isSubscription() {
+		return "Microsoft.Resources/subscriptions"
+	}
+	if id.isResourceGroup() {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	providersIndex := id.lastProvidersIndex()
+	if providersIndex == -1 || providersIndex+1 >= len(id.segments) {
+		return ""
+	}
+
+	types := []string{id.segments[providersIndex+1]}
+	for i := providersIndex + 2; i < len(id.segments); i += 2 {
+		types = append(types, id.segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+// parent returns the Resource which must exist for this Resource to be created. For a Nested
+// Resource this is the Parent Resource, for a top-level Resource (or an Extension Resource,
+// such as a Management Lock) this is the scope (e.g. the Resource Group) - and for a
+// Resource Group this is the Subscription.
+func (id resourceID) parent() *resourceID {
+	if len(id.segments) <= 2 {
+		return nil
+	}
+
+	segments := id.segments[0 : len(id.segments)-2]
+	if len(segments) >= 2 && strings.EqualFold(segments[len(segments)-2], "providers") {
+		segments = segments[0 : len(segments)-2]
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+
+	return &resourceID{
+		segments: segments,
+	}
+}
+
+// isChildOf returns whether this Resource is directly within the specified Collection
+func (id resourceID) isChildOf(collection resourceID) bool {
+	if len(id.segments) != len(collection.segments)+1 {
+		return false
+	}
+	return strings.HasPrefix(id.key(), collection.key()+"/")
+}
+
+func (id resourceID) lastProvidersIndex() int {
+	for i := len(id.segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(id.segments[i], "providers") {
+			return i
+		}
+	}
+	return -1
+}
+
+func (id resourceID) String() string {
+	return fmt.Sprintf("Resource %q", id.ID())
+}
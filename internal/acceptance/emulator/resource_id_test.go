@@ -0,0 +1,80 @@
+package emulator
+
+import "testing"
+
+func TestResourceIDNormalizesCasing(t *testing.T) {
+	id := parseResourceID("/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/Group1/Providers/Microsoft.Network/dnsZones/example.com")
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Network/dnsZones/example.com"
+	if id.ID() != expected {
+		t.Fatalf("expected %q but got %q", expected, id.ID())
+	}
+}
+
+func TestResourceID(t *testing.T) {
+	testData := []struct {
+		Input        string
+		Collection   bool
+		ResourceType string
+		Parent       string
+	}{
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012",
+			ResourceType: "Microsoft.Resources/subscriptions",
+		},
+		{
+			Input:      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups",
+			Collection: true,
+		},
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			ResourceType: "Microsoft.Resources/resourceGroups",
+			Parent:       "/subscriptions/12345678-1234-9876-4563-123456789012",
+		},
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/dnsZones/example.com",
+			ResourceType: "Microsoft.Network/dnsZones",
+			Parent:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		},
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/dnsZones/example.com/A/www",
+			ResourceType: "Microsoft.Network/dnsZones/A",
+			Parent:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/dnsZones/example.com",
+		},
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/dnsZones/example.com/providers/Microsoft.Authorization/locks/lock1",
+			ResourceType: "Microsoft.Authorization/locks",
+			Parent:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/dnsZones/example.com",
+		},
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+			ResourceType: "Microsoft.Authorization/locks",
+			Parent:       "/subscriptions/12345678-1234-9876-4563-123456789012",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		id := parseResourceID(v.Input)
+		if id.ID() != v.Input {
+			t.Fatalf("expected the ID to be %q but got %q", v.Input, id.ID())
+		}
+		if id.isCollection() != v.Collection {
+			t.Fatalf("expected isCollection to be %t but got %t", v.Collection, id.isCollection())
+		}
+		if v.Collection {
+			continue
+		}
+		if id.resourceType() != v.ResourceType {
+			t.Fatalf("expected the Resource Type to be %q but got %q", v.ResourceType, id.resourceType())
+		}
+
+		parent := ""
+		if p := id.parent(); p != nil {
+			parent = p.ID()
+		}
+		if parent != v.Parent {
+			t.Fatalf("expected the parent to be %q but got %q", v.Parent, parent)
+		}
+	}
+}
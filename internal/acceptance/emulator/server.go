@@ -0,0 +1,443 @@
+package emulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// Server is an in-process emulation of the Azure Resource Manager API, which allows the lifecycle of
+// simple Resources (e.g. Resource Groups, Management Locks and DNS Records) to be tested without
+// access to an Azure Subscription.
+//
+// Rather than emulating each API, this implements the generic behaviour of Resource Manager for
+// arbitrary Resource ID's:
+//
+//   - PUT creates (or replaces) the Resource, returning the `Azure-AsyncOperation` header to poll
+//   - GET retrieves the Resource (or lists the Resources within a Collection)
+//   - HEAD checks for the existence of the Resource
+//   - PATCH merges the request body into the existing Resource
+//   - DELETE removes the Resource, along with any Nested Resources
+//
+// As such Resources which rely on a specific API behaviour (such as computed fields populated by
+// the API, or POST actions to retrieve keys) aren't supported.
+type Server struct {
+	server *httptest.Server
+
+	lock       sync.RWMutex
+	resources  map[string]storedResource
+	operations map[string]time.Time
+}
+
+// operationRetention is how long an operation which hasn't been polled is retained for, since
+// clients can skip polling when the response already contains a terminal provisioning state
+const operationRetention = 10 * time.Minute
+
+type storedResource struct {
+	id   resourceID
+	body map[string]interface{}
+}
+
+// NewServer starts a new emulated Resource Manager API, which should be stopped by calling Close
+func NewServer() *Server {
+	s := newServer()
+	s.server = httptest.NewServer(s)
+	log.Printf("[DEBUG] Emulated Resource Manager API listening on %q", s.Endpoint())
+	return s
+}
+
+func newServer() *Server {
+	return &Server{
+		resources:  make(map[string]storedResource),
+		operations: make(map[string]time.Time),
+	}
+}
+
+// Endpoint returns the Resource Manager Endpoint for this Server, in the same format
+// as the Resource Manager Endpoint for an Azure Environment
+func (s *Server) Endpoint() string {
+	return s.server.URL + "/"
+}
+
+// Close shuts down the Server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// ResourceIDs returns the ID's of each Resource which currently exists, sorted alphabetically
+func (s *Server) ResourceIDs() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	out := make([]string, 0, len(s.resources))
+	for _, v := range s.resources {
+		out = append(out, v.id.ID())
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Printf("[DEBUG] Emulator: %s %s", r.Method, r.URL.String())
+
+	id := parseResourceID(r.URL.Path)
+	if len(id.segments) == 3 && strings.EqualFold(id.segments[0], "emulator") && strings.EqualFold(id.segments[1], "operations") {
+		s.getOperation(w, id.segments[2])
+		return
+	}
+
+	if r.URL.Query().Get("api-version") == "" {
+		writeError(w, http.StatusBadRequest, "MissingApiVersionParameter", "The api-version query parameter (?api-version=) is required for all requests.")
+		return
+	}
+
+	if len(id.segments) < 2 || !strings.EqualFold(id.segments[0], "subscriptions") {
+		writeError(w, http.StatusNotFound, "InvalidResourceType", fmt.Sprintf("The URI %q isn't supported by the emulator.", r.URL.Path))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, id)
+	case http.MethodHead:
+		s.head(w, id)
+	case http.MethodPut:
+		s.put(w, r, id)
+	case http.MethodPatch:
+		s.patch(w, r, id)
+	case http.MethodDelete:
+		s.delete(w, id)
+	case http.MethodPost:
+		s.post(w, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %q isn't supported by the emulator.", r.Method))
+	}
+}
+
+func (s *Server) get(w http.ResponseWriter, id resourceID) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if id.isCollection() {
+		items := make([]map[string]interface{}, 0)
+		for _, v := range s.resources {
+			if v.id.isChildOf(id) {
+				items = append(items, v.body)
+			}
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return strings.ToLower(items[i]["id"].(string)) < strings.ToLower(items[j]["id"].(string))
+		})
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": items,
+		})
+		return
+	}
+
+	if id.isSubscription() {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":             id.ID(),
+			"subscriptionId": id.name(),
+			"displayName":    "Emulated Subscription",
+			"state":          "Enabled",
+		})
+		return
+	}
+
+	if isResourceProvider(id) {
+		writeJSON(w, http.StatusOK, resourceProvider(id))
+		return
+	}
+
+	existing, ok := s.resources[id.key()]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, existing.body)
+}
+
+func (s *Server) head(w http.ResponseWriter, id resourceID) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.resources[id.key()]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, id resourceID) {
+	if id.isCollection() || id.isSubscription() {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("A PUT isn't supported for %q.", id.ID()))
+		return
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if parent := id.parent(); parent != nil && !parent.isSubscription() {
+		if _, ok := s.resources[parent.key()]; !ok {
+			writeParentNotFound(w, id, *parent)
+			return
+		}
+	}
+
+	existing, exists := s.resources[id.key()]
+	if exists {
+		if r.Header.Get("If-None-Match") == "*" {
+			writeError(w, http.StatusPreconditionFailed, "PreconditionFailed", fmt.Sprintf("The Resource '%s' already exists.", existing.id.ID()))
+			return
+		}
+
+		// the casing of the existing Resource is retained
+		id = existing.id
+	}
+
+	body["id"] = id.ID()
+	body["name"] = id.name()
+	body["type"] = id.resourceType()
+	setProvisioningState(body)
+	s.resources[id.key()] = storedResource{
+		id:   id,
+		body: body,
+	}
+
+	statusCode := http.StatusCreated
+	if exists {
+		statusCode = http.StatusOK
+	}
+	s.writeWithAsyncOperation(w, r, statusCode, body)
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, id resourceID) {
+	if id.isCollection() || id.isSubscription() {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("A PATCH isn't supported for %q.", id.ID()))
+		return
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	existing, ok := s.resources[id.key()]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	// the read-only fields can't be changed
+	delete(body, "id")
+	delete(body, "name")
+	delete(body, "type")
+	mergePatch(existing.body, body)
+	setProvisioningState(existing.body)
+
+	s.writeWithAsyncOperation(w, r, http.StatusOK, existing.body)
+}
+
+func (s *Server) delete(w http.ResponseWriter, id resourceID) {
+	if id.isCollection() || id.isSubscription() {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("A DELETE isn't supported for %q.", id.ID()))
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.resources[id.key()]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// deleting a Resource also deletes any Nested Resources, e.g. deleting a Resource Group
+	// deletes everything within it
+	prefix := id.key() + "/"
+	for k := range s.resources {
+		if k == id.key() || strings.HasPrefix(k, prefix) {
+			delete(s.resources, k)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) post(w http.ResponseWriter, id resourceID) {
+	// Resource Provider registration is the only action supported by the emulator, since this
+	// is used by the Provider during initialization
+	if len(id.segments) == 5 && strings.EqualFold(id.segments[4], "register") {
+		provider := resourceID{
+			segments: id.segments[0:4],
+		}
+		if isResourceProvider(provider) {
+			writeJSON(w, http.StatusOK, resourceProvider(provider))
+			return
+		}
+	}
+
+	writeError(w, http.StatusBadRequest, "UnsupportedOperation", fmt.Sprintf("The action %q isn't supported by the emulator.", id.ID()))
+}
+
+func (s *Server) getOperation(w http.ResponseWriter, operationId string) {
+	// operations complete immediately, since the changes have already been applied - as such
+	// once an operation has been polled it's reached a terminal state and can be removed
+	s.lock.Lock()
+	_, ok := s.operations[operationId]
+	delete(s.operations, operationId)
+	s.lock.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The operation %q was not found.", operationId))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":     operationId,
+		"name":   operationId,
+		"status": "Succeeded",
+	})
+}
+
+// writeWithAsyncOperation writes the response including the `Azure-AsyncOperation` header, which
+// Long Running Operations poll until completion - the caller must hold the write lock
+func (s *Server) writeWithAsyncOperation(w http.ResponseWriter, r *http.Request, statusCode int, body map[string]interface{}) {
+	operationId, err := uuid.GenerateUUID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalServerError", err.Error())
+		return
+	}
+	now := time.Now()
+	for k, createdAt := range s.operations {
+		if now.Sub(createdAt) > operationRetention {
+			delete(s.operations, k)
+		}
+	}
+	s.operations[operationId] = now
+
+	operationURI := fmt.Sprintf("%s/emulator/operations/%s?api-version=%s", s.baseURI(r), operationId, r.URL.Query().Get("api-version"))
+	w.Header().Set("Azure-AsyncOperation", operationURI)
+	writeJSON(w, statusCode, body)
+}
+
+func (s *Server) baseURI(r *http.Request) string {
+	if s.server != nil {
+		return s.server.URL
+	}
+	return fmt.Sprintf("http://%s", r.Host)
+}
+
+// isResourceProvider returns whether the ID is a Resource Provider (e.g. `/subscriptions/{id}/providers/Microsoft.Network`)
+func isResourceProvider(id resourceID) bool {
+	return len(id.segments) == 4 && strings.EqualFold(id.segments[0], "subscriptions") && strings.EqualFold(id.segments[2], "providers")
+}
+
+func resourceProvider(id resourceID) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                id.ID(),
+		"namespace":         id.name(),
+		"registrationState": "Registered",
+	}
+}
+
+func setProvisioningState(body map[string]interface{}) {
+	props, ok := body["properties"].(map[string]interface{})
+	if !ok || props == nil {
+		props = make(map[string]interface{})
+		body["properties"] = props
+	}
+	props["provisioningState"] = "Succeeded"
+}
+
+// mergePatch applies the patch to the existing object as a JSON Merge Patch (RFC 7396)
+func mergePatch(existing map[string]interface{}, patch map[string]interface{}) {
+	for k, v := range patch {
+		if v == nil {
+			delete(existing, k)
+			continue
+		}
+
+		patchValue, isObject := v.(map[string]interface{})
+		existingValue, existingIsObject := existing[k].(map[string]interface{})
+		if isObject && existingIsObject {
+			mergePatch(existingValue, patchValue)
+			continue
+		}
+
+		existing[k] = v
+	}
+}
+
+func readBody(r *http.Request) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	if r.Body == nil {
+		return body, nil
+	}
+	defer r.Body.Close()
+
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&body); err != nil {
+		if err == io.EOF {
+			return body, nil
+		}
+		return nil, fmt.Errorf("parsing the request body: %+v", err)
+	}
+	return body, nil
+}
+
+func writeNotFound(w http.ResponseWriter, id resourceID) {
+	if id.isResourceGroup() {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", id.name()))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", id.ID()))
+}
+
+func writeParentNotFound(w http.ResponseWriter, id resourceID, parent resourceID) {
+	if parent.isResourceGroup() {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", parent.name()))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource '%s'. Parent resource '%s' not found.", id.ID(), parent.ID()))
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("[DEBUG] Emulator: writing response: %+v", err)
+	}
+}
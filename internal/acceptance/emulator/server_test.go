@@ -0,0 +1,255 @@
+package emulator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const testSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+func TestServerResourceGroupLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.TODO()
+	client := resources.NewGroupsClientWithBaseURI(server.Endpoint(), testSubscriptionId)
+	client.Authorizer = autorest.NullAuthorizer{}
+
+	existence, err := client.CheckExistence(ctx, "example")
+	if err != nil {
+		t.Fatalf("checking existence: %+v", err)
+	}
+	if existence.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the Resource Group not to exist but got %d", existence.StatusCode)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, "example", resources.Group{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"env": utils.String("test"),
+		},
+	}); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	group, err := client.Get(ctx, "example")
+	if err != nil {
+		t.Fatalf("retrieving: %+v", err)
+	}
+	expectedId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	if group.ID == nil || *group.ID != expectedId {
+		t.Fatalf("expected the ID to be %q but got %v", expectedId, utils.NormalizeNilableString(group.ID))
+	}
+	if group.Type == nil || *group.Type != "Microsoft.Resources/resourceGroups" {
+		t.Fatalf("expected the type to be `Microsoft.Resources/resourceGroups` but got %v", group.Type)
+	}
+	if group.Properties == nil || group.Properties.ProvisioningState == nil || *group.Properties.ProvisioningState != "Succeeded" {
+		t.Fatalf("expected the Provisioning State to be `Succeeded`")
+	}
+
+	if _, err := client.Update(ctx, "EXAMPLE", resources.GroupPatchable{
+		Tags: map[string]*string{
+			"owner": utils.String("someone"),
+		},
+	}); err != nil {
+		t.Fatalf("updating: %+v", err)
+	}
+	group, err = client.Get(ctx, "example")
+	if err != nil {
+		t.Fatalf("retrieving: %+v", err)
+	}
+	if len(group.Tags) != 2 || group.Location == nil || *group.Location != "westeurope" {
+		t.Fatalf("expected the patch to be merged into the existing Resource Group but got %+v", group)
+	}
+
+	page, err := client.List(ctx, "", nil)
+	if err != nil {
+		t.Fatalf("listing: %+v", err)
+	}
+	if len(page.Values()) != 1 {
+		t.Fatalf("expected 1 Resource Group but got %d", len(page.Values()))
+	}
+
+	future, err := client.Delete(ctx, "example", "")
+	if err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("waiting for deletion: %+v", err)
+	}
+
+	group, err = client.Get(ctx, "example")
+	if !utils.ResponseWasNotFound(group.Response) {
+		t.Fatalf("expected the Resource Group to be Not Found but got %+v", err)
+	}
+}
+
+func TestServerNestedResources(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.TODO()
+	groupsClient := resources.NewGroupsClientWithBaseURI(server.Endpoint(), testSubscriptionId)
+	groupsClient.Authorizer = autorest.NullAuthorizer{}
+	locksClient := locks.NewManagementLocksClientWithBaseURI(server.Endpoint(), testSubscriptionId)
+	locksClient.Authorizer = autorest.NullAuthorizer{}
+
+	lock := locks.ManagementLockObject{
+		ManagementLockProperties: &locks.ManagementLockProperties{
+			Level: locks.CanNotDelete,
+		},
+	}
+	resp, err := locksClient.CreateOrUpdateAtResourceGroupLevel(ctx, "example", "lock1", lock)
+	if err == nil {
+		t.Fatalf("expected an error creating a Lock within a Resource Group which doesn't exist")
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 but got %d", resp.StatusCode)
+	}
+
+	if _, err := groupsClient.CreateOrUpdate(ctx, "example", resources.Group{Location: utils.String("westeurope")}); err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+	if _, err := locksClient.CreateOrUpdateAtResourceGroupLevel(ctx, "example", "lock1", lock); err != nil {
+		t.Fatalf("creating Lock: %+v", err)
+	}
+
+	existing, err := locksClient.GetAtResourceGroupLevel(ctx, "example", "lock1")
+	if err != nil {
+		t.Fatalf("retrieving Lock: %+v", err)
+	}
+	if existing.Type == nil || *existing.Type != "Microsoft.Authorization/locks" {
+		t.Fatalf("expected the type to be `Microsoft.Authorization/locks` but got %v", existing.Type)
+	}
+	if existing.ManagementLockProperties == nil || existing.ManagementLockProperties.Level != locks.CanNotDelete {
+		t.Fatalf("expected the Lock Level to be `CanNotDelete`")
+	}
+
+	// deleting the Resource Group should delete the Lock within it
+	future, err := groupsClient.Delete(ctx, "example", "")
+	if err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+		t.Fatalf("waiting for deletion: %+v", err)
+	}
+	if ids := server.ResourceIDs(); len(ids) != 0 {
+		t.Fatalf("expected no Resources to exist but got %s", strings.Join(ids, ", "))
+	}
+}
+
+func TestServerLongRunningOperation(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.TODO()
+	client := autorest.NewClientWithUserAgent("emulator")
+	client.Authorizer = autorest.NullAuthorizer{}
+
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	req, err := autorest.Prepare(&http.Request{},
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(server.Endpoint()),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": "2020-06-01"}),
+		autorest.WithJSON(map[string]interface{}{"location": "westeurope"}))
+	if err != nil {
+		t.Fatalf("preparing request: %+v", err)
+	}
+	resp, err := client.Send(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 but got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Azure-AsyncOperation") == "" {
+		t.Fatalf("expected the `Azure-AsyncOperation` header to be set")
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		t.Fatalf("building future: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client); err != nil {
+		t.Fatalf("waiting for completion: %+v", err)
+	}
+	if status := future.Status(); status != "Succeeded" {
+		t.Fatalf("expected the status to be `Succeeded` but got %q", status)
+	}
+
+	pollOperation := func() *http.Response {
+		pollReq, err := autorest.Prepare(&http.Request{},
+			autorest.AsGet(),
+			autorest.WithBaseURL(resp.Header.Get("Azure-AsyncOperation")))
+		if err != nil {
+			t.Fatalf("preparing poll request: %+v", err)
+		}
+		pollResp, err := client.Send(pollReq)
+		if err != nil {
+			t.Fatalf("sending poll request: %+v", err)
+		}
+		return pollResp
+	}
+	if pollResp := pollOperation(); pollResp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 when polling the operation but got %d", pollResp.StatusCode)
+	}
+
+	// the operation should be removed once it's been polled to completion
+	server.lock.RLock()
+	remaining := len(server.operations)
+	server.lock.RUnlock()
+	if remaining != 0 {
+		t.Fatalf("expected no operations to remain but got %d", remaining)
+	}
+	if pollResp := pollOperation(); pollResp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 for a completed operation but got %d", pollResp.StatusCode)
+	}
+}
+
+func TestServerExpiresOperationsWhichAreNotPolled(t *testing.T) {
+	server := newServer()
+	server.operations["stale"] = time.Now().Add(-2 * operationRetention)
+	server.operations["recent"] = time.Now()
+
+	req := httptest.NewRequest(http.MethodPut, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", nil)
+	server.lock.Lock()
+	server.writeWithAsyncOperation(httptest.NewRecorder(), req, http.StatusCreated, map[string]interface{}{})
+	server.lock.Unlock()
+
+	if _, ok := server.operations["stale"]; ok {
+		t.Fatalf("expected the stale operation to be removed")
+	}
+	if _, ok := server.operations["recent"]; !ok {
+		t.Fatalf("expected the recent operation to be retained")
+	}
+	if len(server.operations) != 2 {
+		t.Fatalf("expected 2 operations but got %d", len(server.operations))
+	}
+}
+
+func TestServerRequiresAPIVersion(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(server.Endpoint() + "subscriptions/" + testSubscriptionId + "/resourceGroups/example")
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a 400 but got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,60 @@
+package acceptance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestEmulatedAcceptanceTests(t *testing.T) {
+	t.Setenv("ARM_PROVIDER_EMULATED_TEST", "true")
+
+	data := BuildTestData(t, "azurerm_resource_group", "test")
+	if data.Locations.Primary == "" {
+		t.Fatalf("expected the Primary Location to be defaulted")
+	}
+	ctx := context.TODO()
+
+	// the Provider should target the emulator
+	azurerm, err := data.providers()["azurerm"]()
+	if err != nil {
+		t.Fatalf("building the Provider: %+v", err)
+	}
+	diags := azurerm.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"features": []interface{}{
+			map[string]interface{}{},
+		},
+	}))
+	if diags.HasError() {
+		t.Fatalf("configuring the Provider: %+v", diags)
+	}
+	providerClient := azurerm.Meta().(*clients.Client)
+	if providerClient.Account.Environment.ResourceManagerEndpoint != emulatorServer.Endpoint() {
+		t.Fatalf("expected the Provider to target %q but got %q", emulatorServer.Endpoint(), providerClient.Account.Environment.ResourceManagerEndpoint)
+	}
+
+	name := "acctestRG-" + data.RandomString
+	if _, err := providerClient.Resource.GroupsClient.CreateOrUpdate(ctx, name, resources.Group{
+		Location: utils.String(data.Locations.Primary),
+	}); err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+
+	// as should the Test Client used by the Exists/Destroy checks
+	client, err := testclient.Build()
+	if err != nil {
+		t.Fatalf("building Test Client: %+v", err)
+	}
+	group, err := client.Resource.GroupsClient.Get(ctx, name)
+	if err != nil {
+		t.Fatalf("retrieving Resource Group: %+v", err)
+	}
+	if group.Location == nil || *group.Location != data.Locations.Primary {
+		t.Fatalf("expected the Location to be %q", data.Locations.Primary)
+	}
+}
@@ -0,0 +1,30 @@
+package recorder
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+type Mode string
+
+const (
+	// DisabledMode sends requests to Azure without recording them
+	DisabledMode Mode = ""
+
+	// RecordMode sends requests to Azure and records the interactions
+	RecordMode Mode = "record"
+
+	// ReplayMode replays previously recorded interactions, without sending requests to Azure
+	ReplayMode Mode = "replay"
+)
+
+// CurrentMode returns the Mode configured via the `ARM_TEST_RECORDING_MODE` Environment Variable
+func CurrentMode() (Mode, error) {
+	switch mode := Mode(features.AcceptanceTestRecordingMode()); mode {
+	case DisabledMode, RecordMode, ReplayMode:
+		return mode, nil
+	default:
+		return DisabledMode, fmt.Errorf("unsupported recording mode %q - supported values are `record` and `replay`", string(mode))
+	}
+}
@@ -0,0 +1,236 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/sender"
+)
+
+// Recorder is an autorest.Sender which either records the requests sent to Azure (and the responses
+// returned) - or which replays the responses from a previous recording without calling Azure.
+type Recorder struct {
+	mode      Mode
+	path      string
+	sanitizer sanitizer
+
+	// next is the Sender used to send requests to Azure when recording
+	next autorest.Sender
+
+	lock      sync.Mutex
+	recording Recording
+	used      []bool
+}
+
+// NewRecorder returns a Recorder which sends requests to Azure and records the interactions, which
+// are saved to the specified path when Stop is called. The keys within `replacements` are replaced
+// with the associated value in the recorded interactions (e.g. to remove the Subscription ID).
+func NewRecorder(path string, metadata Recording, replacements map[string]string) *Recorder {
+	metadata.Interactions = make([]Interaction, 0)
+	return &Recorder{
+		mode:      RecordMode,
+		path:      path,
+		sanitizer: newSanitizer(replacements),
+		next:      sender.BuildSender("AzureRM"),
+		recording: metadata,
+	}
+}
+
+// NewReplayer returns a Recorder which replays the interactions from the Recording at the specified path
+func NewReplayer(path string) (*Recorder, error) {
+	recording, err := LoadRecording(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		mode:      ReplayMode,
+		path:      path,
+		sanitizer: newSanitizer(nil),
+		recording: *recording,
+		used:      make([]bool, len(recording.Interactions)),
+	}, nil
+}
+
+// Do sends the request to Azure (recording the interaction) or returns the previously recorded response
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	request := Request{
+		Method: req.Method,
+		URL:    r.sanitizer.sanitizeString(normalizeURL(req.URL)),
+		Body:   r.sanitizer.sanitizeBody(body),
+	}
+
+	if r.mode == ReplayMode {
+		return r.replay(req, request)
+	}
+
+	resp, err := r.next.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	responseBody, err := readResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		StatusCode: resp.StatusCode,
+		Headers:    map[string]string{},
+		Body:       r.sanitizer.sanitizeBody(responseBody),
+	}
+	for _, header := range responseHeadersToRecord {
+		if v := resp.Header.Get(header); v != "" {
+			response.Headers[header] = r.sanitizer.sanitizeString(v)
+		}
+	}
+
+	r.lock.Lock()
+	r.recording.Interactions = append(r.recording.Interactions, Interaction{
+		Request:  request,
+		Response: response,
+	})
+	r.lock.Unlock()
+
+	return resp, nil
+}
+
+// Stop saves the Recording when recording - or when replaying, confirms that each of the recorded
+// interactions has been used
+func (r *Recorder) Stop() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.mode == RecordMode {
+		return r.recording.save(r.path)
+	}
+
+	unused := make([]string, 0)
+	for i, used := range r.used {
+		if !used {
+			request := r.recording.Interactions[i].Request
+			unused = append(unused, fmt.Sprintf("%s %s", request.Method, request.URL))
+		}
+	}
+	if len(unused) > 0 {
+		return fmt.Errorf("%d recorded requests were not sent when replaying %q:\n\n%s", len(unused), r.path, strings.Join(unused, "\n"))
+	}
+
+	return nil
+}
+
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var interaction *Interaction
+	for i, item := range r.recording.Interactions {
+		if r.used[i] || item.Request.Method != request.Method || item.Request.URL != request.URL {
+			continue
+		}
+
+		// since requests are matched in order, a request with a different body is a change in behaviour
+		if !bodiesMatch(item.Request.Body, request.Body) {
+			return nil, fmt.Errorf("the request body for %s %s doesn't match the recording %q.\n\nExpected: %s\n\nActual: %s", request.Method, request.URL, r.path, item.Request.Body, request.Body)
+		}
+
+		r.used[i] = true
+		interaction = &r.recording.Interactions[i]
+		break
+	}
+	if interaction == nil {
+		return nil, fmt.Errorf("no recorded response was found for %s %s in the recording %q - this test needs to be recorded again", request.Method, request.URL, r.path)
+	}
+
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(bytes.NewBufferString(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}
+	for k, v := range interaction.Response.Headers {
+		resp.Header.Set(k, v)
+	}
+
+	// the recorded responses are available immediately, so there's no need to wait between polling requests
+	resp.Header.Set("Retry-After", "0")
+
+	return resp, nil
+}
+
+// normalizeURL returns the path and (sorted) query string for the URL, since the host differs
+// between environments and isn't used to match requests
+func normalizeURL(input *url.URL) string {
+	out := input.EscapedPath()
+	if query := input.Query(); len(query) > 0 {
+		out = fmt.Sprintf("%s?%s", out, query.Encode())
+	}
+	return out
+}
+
+func bodiesMatch(expected, actual string) bool {
+	if expected == actual {
+		return true
+	}
+
+	var expectedValue, actualValue interface{}
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(actual), &actualValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(expectedValue, actualValue)
+}
+
+func readRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading request body: %+v", err)
+	}
+	req.Body.Close()
+
+	// the body has been consumed, so needs to be reset for the request to be sent
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	return string(body), nil
+}
+
+func readResponseBody(resp *http.Response) (string, error) {
+	if resp.Body == nil {
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading response body: %+v", err)
+	}
+	resp.Body.Close()
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return string(body), nil
+}
@@ -0,0 +1,194 @@
+package recorder
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const realSubscriptionId = "11111111-2222-3333-4444-555555555555"
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "some-cookie")
+		w.Header().Set("Azure-AsyncOperation", "https://management.azure.com/subscriptions/"+realSubscriptionId+"/operations/abc")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"/subscriptions/` + realSubscriptionId + `/resourceGroups/example","properties":{"primaryKey":"abc123","count":1}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "recording.json")
+	requestPath := "/subscriptions/" + realSubscriptionId + "/resourceGroups/example?api-version=2020-06-01"
+
+	recorder := NewRecorder(path, Recording{
+		TestName:      "TestRecordAndReplay",
+		RandomInteger: 123,
+		RandomString:  "abcde",
+	}, map[string]string{
+		realSubscriptionId: PlaceholderID,
+	})
+	resp, err := recorder.Do(newRequest(t, server.URL+requestPath, `{"location": "westeurope", "password": "P@ssw0rd"}`))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	// the response should be returned as-is when recording
+	if body := readBody(t, resp); !strings.Contains(body, "abc123") {
+		t.Fatalf("expected the unsanitized response to be returned but got %s", body)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading recording: %+v", err)
+	}
+	for _, secret := range []string{realSubscriptionId, "abc123", "P@ssw0rd", "some-cookie"} {
+		if strings.Contains(string(contents), secret) {
+			t.Fatalf("expected %q to be removed from the recording:\n\n%s", secret, string(contents))
+		}
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("loading recording: %+v", err)
+	}
+	if replayer.recording.RandomInteger != 123 || replayer.recording.RandomString != "abcde" {
+		t.Fatalf("expected the random values to be loaded from the recording")
+	}
+
+	// requests are matched on the path rather than the host, with the body compared semantically
+	replayPath := strings.Replace(requestPath, realSubscriptionId, PlaceholderID, 1)
+	resp, err = replayer.Do(newRequest(t, "https://example.com"+replayPath, `{"password":"REDACTED","location":"westeurope"}`))
+	if err != nil {
+		t.Fatalf("replaying request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 but got %d", resp.StatusCode)
+	}
+	if v := resp.Header.Get("Retry-After"); v != "0" {
+		t.Fatalf("expected `Retry-After` to be `0` but got %q", v)
+	}
+	if v := resp.Header.Get("Azure-AsyncOperation"); !strings.Contains(v, PlaceholderID) {
+		t.Fatalf("expected the `Azure-AsyncOperation` header to be replayed but got %q", v)
+	}
+	if body := readBody(t, resp); !strings.Contains(body, PlaceholderID) {
+		t.Fatalf("expected the recorded response to be returned but got %s", body)
+	}
+
+	// the recorded interaction has been used, so a second request shouldn't match
+	if _, err := replayer.Do(newRequest(t, "https://example.com"+replayPath, "")); err == nil {
+		t.Fatalf("expected an error when no recorded interaction is available")
+	}
+	if err := replayer.Stop(); err != nil {
+		t.Fatalf("expected all interactions to be used but got: %+v", err)
+	}
+}
+
+func TestReplayDetectsChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+	recording := Recording{
+		Interactions: []Interaction{
+			{
+				Request: Request{
+					Method: http.MethodPut,
+					URL:    "/subscriptions/" + PlaceholderID + "/resourceGroups/example?api-version=2020-06-01",
+					Body:   `{"location":"westeurope"}`,
+				},
+				Response: Response{
+					StatusCode: http.StatusOK,
+				},
+			},
+		},
+	}
+	if err := recording.save(path); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("loading recording: %+v", err)
+	}
+	url := "https://example.com/subscriptions/" + PlaceholderID + "/resourceGroups/example?api-version=2020-06-01"
+	if _, err := replayer.Do(newRequest(t, url, `{"location":"eastus"}`)); err == nil {
+		t.Fatalf("expected an error when the request body differs from the recording")
+	}
+	if err := replayer.Stop(); err == nil {
+		t.Fatalf("expected an error when a recorded interaction wasn't used")
+	}
+}
+
+func TestActiveSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+	if err := (Recording{}).save(path); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("loading recording: %+v", err)
+	}
+
+	sender := Sender()
+	if _, err := sender.Do(newRequest(t, "https://example.com/", "")); err == nil {
+		t.Fatalf("expected an error when no recording is in progress")
+	}
+
+	if err := Start(replayer); err != nil {
+		t.Fatalf("starting: %+v", err)
+	}
+	if err := Start(replayer); err == nil {
+		t.Fatalf("expected an error when a recording is already in progress")
+	}
+	if err := Stop(); err != nil {
+		t.Fatalf("stopping: %+v", err)
+	}
+}
+
+func TestPathForTest(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "TestAccResourceGroup_basic",
+			Expected: filepath.Join("testdata", "recordings", "TestAccResourceGroup_basic.json"),
+		},
+		{
+			Input:    "TestAccResourceGroup_subtests/basic",
+			Expected: filepath.Join("testdata", "recordings", "TestAccResourceGroup_subtests_basic.json"),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := PathForTest(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func newRequest(t *testing.T, url string, body string) *http.Request {
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if body == "" {
+		req.Body = http.NoBody
+	}
+	return req
+}
+
+func readBody(t *testing.T, resp *http.Response) string {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %+v", err)
+	}
+	return string(body)
+}
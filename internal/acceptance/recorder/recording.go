@@ -0,0 +1,93 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Recording is the set of (sanitized) requests sent to Azure during an Acceptance Test, along with
+// the random values used by that test - which must be re-used when replaying the test so that the
+// requests are the same.
+type Recording struct {
+	// TestName is the name of the Acceptance Test which was recorded
+	TestName string `json:"testName"`
+
+	// RandomInteger is the random integer used during the Acceptance Test
+	RandomInteger int `json:"randomInteger"`
+
+	// RandomString is the random string used during the Acceptance Test
+	RandomString string `json:"randomString"`
+
+	// Locations is the list of Azure Regions used during the Acceptance Test, in
+	// the order Primary, Secondary and Ternary
+	Locations []string `json:"locations"`
+
+	// Interactions is the list of requests sent to Azure and the responses returned, in order
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a Request sent to Azure and the Response which was returned
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a sanitized HTTP Request sent to Azure
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a sanitized HTTP Response returned from Azure
+type Response struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// PathForTest returns the path to the Recording for the specified Acceptance Test, which is
+// within the `testdata` directory of the package containing the test
+func PathForTest(testName string) string {
+	fileName := strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(testName)
+	return filepath.Join("testdata", "recordings", fmt.Sprintf("%s.json", fileName))
+}
+
+// LoadRecording loads the Recording from the specified path
+func LoadRecording(path string) (*Recording, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recording exists at %q - this test needs to be run in `record` mode first", path)
+		}
+		return nil, fmt.Errorf("reading recording %q: %+v", path, err)
+	}
+
+	var recording Recording
+	if err := json.Unmarshal(contents, &recording); err != nil {
+		return nil, fmt.Errorf("parsing recording %q: %+v", path, err)
+	}
+
+	return &recording, nil
+}
+
+func (r Recording) save(path string) error {
+	contents, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing recording: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory for recording %q: %+v", path, err)
+	}
+
+	if err := ioutil.WriteFile(path, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing recording %q: %+v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+)
+
+const (
+	// PlaceholderID is the value which the Subscription, Tenant and Client ID's are replaced with
+	PlaceholderID = "00000000-0000-0000-0000-000000000000"
+
+	// redactedValue is the value which secrets within a request/response body are replaced with
+	redactedValue = "REDACTED"
+)
+
+// secretFieldNames matches the names of fields within a JSON request/response body whose values are secrets
+var secretFieldNames = regexp.MustCompile(`(?i)(password|secret|token|connectionstring|accesskey|sharedkey|primarykey|secondarykey|^key$)`)
+
+// responseHeadersToRecord is the list of response headers which are recorded, all other headers are
+// discarded since these are either unused or can contain sensitive information
+var responseHeadersToRecord = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"ETag",
+	"Location",
+	"Retry-After",
+}
+
+type replacement struct {
+	pattern *regexp.Regexp
+	value   string
+}
+
+// sanitizer removes sensitive information from the requests and responses before these are recorded
+type sanitizer struct {
+	replacements []replacement
+}
+
+// newSanitizer returns a sanitizer which replaces each of the keys within the map with the value,
+// for example to replace the Subscription ID with a placeholder value
+func newSanitizer(replacements map[string]string) sanitizer {
+	keys := make([]string, 0)
+	for k := range replacements {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	// replace the longest values first, in case one value contains another
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+
+	out := sanitizer{
+		replacements: make([]replacement, 0),
+	}
+	for _, k := range keys {
+		out.replacements = append(out.replacements, replacement{
+			pattern: regexp.MustCompile("(?i)" + regexp.QuoteMeta(k)),
+			value:   replacements[k],
+		})
+	}
+	return out
+}
+
+// sanitizeString replaces any sensitive values within the string
+func (s sanitizer) sanitizeString(input string) string {
+	for _, r := range s.replacements {
+		input = r.pattern.ReplaceAllLiteralString(input, r.value)
+	}
+	return input
+}
+
+// sanitizeBody replaces any sensitive values within the request/response body - and when
+// this is JSON, redacts the value of any fields which contain secrets
+func (s sanitizer) sanitizeBody(input string) string {
+	input = s.sanitizeString(input)
+	if input == "" {
+		return input
+	}
+
+	decoder := json.NewDecoder(bytes.NewBufferString(input))
+	decoder.UseNumber()
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil {
+		// not JSON, so there's nothing to redact
+		return input
+	}
+
+	out, err := json.Marshal(redactSecrets(body))
+	if err != nil {
+		return input
+	}
+	return string(out)
+}
+
+func redactSecrets(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if _, isString := val.(string); isString && secretFieldNames.MatchString(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactSecrets(val)
+		}
+		return v
+
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactSecrets(val)
+		}
+		return v
+	}
+
+	return input
+}
@@ -0,0 +1,59 @@
+package recorder
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var (
+	activeLock     sync.RWMutex
+	activeRecorder *Recorder
+)
+
+// Start sets the Recorder used by the Sender returned from `Sender`, for the duration of an Acceptance Test
+//
+// Since the Recorder is shared by all Provider instances, only a single test can be recorded/replayed at a time.
+func Start(recorder *Recorder) error {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	if activeRecorder != nil {
+		return fmt.Errorf("a recording is already in progress for %q - recorded tests cannot be run in parallel", activeRecorder.path)
+	}
+
+	activeRecorder = recorder
+	return nil
+}
+
+// Stop stops the active Recorder - saving the recording when recording, or confirming all of the
+// recorded interactions were used when replaying
+func Stop() error {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	if activeRecorder == nil {
+		return nil
+	}
+
+	recorder := activeRecorder
+	activeRecorder = nil
+	return recorder.Stop()
+}
+
+// Sender returns an autorest.Sender which sends requests via the active Recorder
+func Sender() autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		activeLock.RLock()
+		recorder := activeRecorder
+		activeLock.RUnlock()
+
+		if recorder == nil {
+			return nil, fmt.Errorf("no recording is in progress for %s %s", req.Method, req.URL.String())
+		}
+
+		return recorder.Do(req)
+	})
+}
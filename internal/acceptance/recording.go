@@ -0,0 +1,125 @@
+package acceptance
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recorder"
+)
+
+var recordingOnce = &sync.Once{}
+
+// configureRecording validates the recording mode (if opted into) and configures the environment
+// variables used by the Provider and the Test Client to record/replay the requests sent to Azure.
+func configureRecording(t *testing.T) recorder.Mode {
+	mode, err := recorder.CurrentMode()
+	if err != nil {
+		t.Fatalf("determining the recording mode: %+v", err)
+	}
+	if mode == recorder.DisabledMode {
+		return mode
+	}
+
+	recordingOnce.Do(func() {
+		// the list of Resource Providers and Locations would otherwise need to be recorded for each test
+		overrides := map[string]string{
+			"ARM_SKIP_PROVIDER_REGISTRATION":   "true",
+			"ARM_PROVIDER_ENHANCED_VALIDATION": "false",
+		}
+
+		if mode == recorder.ReplayMode {
+			// these are always overwritten to ensure we don't send requests (or credentials) to Azure
+			replayOverrides := map[string]string{
+				"ARM_CLIENT_ID":         recorder.PlaceholderID,
+				"ARM_CLIENT_SECRET":     "replayed",
+				"ARM_SUBSCRIPTION_ID":   recorder.PlaceholderID,
+				"ARM_TENANT_ID":         recorder.PlaceholderID,
+				"ARM_ENVIRONMENT":       "public",
+				"ARM_METADATA_HOST":     "",
+				"ARM_METADATA_HOSTNAME": "",
+				"ARM_METADATA_URL":      "",
+			}
+			for k, v := range replayOverrides {
+				overrides[k] = v
+			}
+
+			// the Locations are loaded from each recording, however these need to be set for the PreCheck
+			for _, k := range []string{"ARM_TEST_LOCATION", "ARM_TEST_LOCATION_ALT", "ARM_TEST_LOCATION_ALT2"} {
+				if os.Getenv(k) == "" {
+					overrides[k] = "replayed"
+				}
+			}
+		}
+
+		for k, v := range overrides {
+			os.Setenv(k, v)
+		}
+	})
+
+	return mode
+}
+
+// loadRecordedTestData overwrites the random values and Locations within the TestData with
+// those used when the test was recorded, so that the same requests are sent when replaying
+func loadRecordedTestData(t *testing.T, testData *TestData) {
+	recording, err := recorder.LoadRecording(recorder.PathForTest(t.Name()))
+	if err != nil {
+		t.Fatalf("loading recording: %+v", err)
+	}
+
+	testData.RandomInteger = recording.RandomInteger
+	testData.RandomString = recording.RandomString
+	if len(recording.Locations) == 3 {
+		testData.Locations = Regions{
+			Primary:   recording.Locations[0],
+			Secondary: recording.Locations[1],
+			Ternary:   recording.Locations[2],
+		}
+	}
+}
+
+// runRecordedTest runs the test case whilst recording/replaying the requests sent to Azure
+//
+// Since a single Recorder is used for each Provider instance (and the Test Client), recorded
+// tests are run sequentially rather than in parallel.
+func (td TestData) runRecordedTest(t *testing.T, testCase resource.TestCase, mode recorder.Mode) {
+	path := recorder.PathForTest(t.Name())
+
+	var r *recorder.Recorder
+	if mode == recorder.RecordMode {
+		r = recorder.NewRecorder(path, recorder.Recording{
+			TestName:      t.Name(),
+			RandomInteger: td.RandomInteger,
+			RandomString:  td.RandomString,
+			Locations: []string{
+				td.Locations.Primary,
+				td.Locations.Secondary,
+				td.Locations.Ternary,
+			},
+		}, map[string]string{
+			os.Getenv("ARM_CLIENT_ID"):       recorder.PlaceholderID,
+			os.Getenv("ARM_SUBSCRIPTION_ID"): recorder.PlaceholderID,
+			os.Getenv("ARM_TENANT_ID"):       recorder.PlaceholderID,
+		})
+	} else {
+		var err error
+		r, err = recorder.NewReplayer(path)
+		if err != nil {
+			t.Fatalf("loading recording: %+v", err)
+		}
+	}
+
+	if err := recorder.Start(r); err != nil {
+		t.Fatalf("starting recording: %+v", err)
+	}
+	defer func() {
+		// when replaying a failed test there'll be unused interactions, which isn't a useful error
+		if err := recorder.Stop(); err != nil && (mode == recorder.RecordMode || !t.Failed()) {
+			t.Errorf("stopping recording: %+v", err)
+		}
+	}()
+
+	resource.Test(t, testCase)
+}
@@ -44,7 +44,8 @@ func TestAccEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 
 	availableResourceProviders := providerList.Values()
 	requiredResourceProviders := rmResourceProviders.Required()
-	err = rmResourceProviders.EnsureRegistered(ctx, *client, availableResourceProviders, requiredResourceProviders)
+	registrar := rmResourceProviders.NewRegistrar(*client, availableResourceProviders)
+	err = registrar.EnsureRegistered(ctx, rmResourceProviders.ForRegistrationMode(rmResourceProviders.RegistrationModeAll, nil))
 	if err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
@@ -8,8 +8,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recorder"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -107,6 +109,11 @@ func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
 	testCase.ProviderFactories = td.providers()
 
+	if mode, _ := recorder.CurrentMode(); mode != recorder.DisabledMode {
+		td.runRecordedTest(t, testCase, mode)
+		return
+	}
+
 	resource.ParallelTest(t, testCase)
 }
 
@@ -114,23 +121,69 @@ func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.T
 	testCase.ExternalProviders = td.externalProviders()
 	testCase.ProviderFactories = td.providers()
 
+	if mode, _ := recorder.CurrentMode(); mode != recorder.DisabledMode {
+		td.runRecordedTest(t, testCase, mode)
+		return
+	}
+
 	resource.Test(t, testCase)
 }
 
 func (td TestData) providers() map[string]func() (*schema.Provider, error) {
+	newProvider := provider.TestAzureProvider
+	if options, customised := providerTestOptions(); customised {
+		newProvider = func() *schema.Provider {
+			return provider.TestAzureProviderWithOptions(options)
+		}
+	}
+
 	return map[string]func() (*schema.Provider, error){
 		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := newProvider()
 			return azurerm, nil
 		},
 		"azurerm-alt": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := newProvider()
 			return azurerm, nil
 		},
 	}
 }
 
+// providerTestOptions returns the options used to customise the Provider when the Acceptance Tests are run
+// against the emulated Resource Manager API, or when the requests sent to Azure are being recorded/replayed
+func providerTestOptions() (provider.TestOptions, bool) {
+	options := provider.TestOptions{}
+	customised := false
+
+	if mode, _ := recorder.CurrentMode(); mode != recorder.DisabledMode {
+		// the requests are sent via the active Recorder, which records/replays them
+		options.Sender = recorder.Sender()
+		options.SkipAuthentication = mode == recorder.ReplayMode
+		customised = true
+	}
+
+	if emulatorServer != nil {
+		// the emulator doesn't require authentication
+		options.ResourceManagerEndpoint = emulatorServer.Endpoint()
+		options.SkipAuthentication = true
+		customised = true
+	}
+
+	return options, customised
+}
+
 func (td TestData) externalProviders() map[string]resource.ExternalProvider {
+	// the emulated Resource Manager API is intended to be run without network access, so
+	// can't download (or be used by) other providers
+	if features.UseEmulatedAcceptanceTests() {
+		return map[string]resource.ExternalProvider{}
+	}
+
+	// likewise when replaying a recording, since requests from other providers aren't recorded
+	if mode, _ := recorder.CurrentMode(); mode == recorder.ReplayMode {
+		return map[string]resource.ExternalProvider{}
+	}
+
 	return map[string]resource.ExternalProvider{
 		"azuread": {
 			VersionConstraint: "=2.8.0",
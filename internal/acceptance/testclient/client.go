@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recorder"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -14,8 +15,22 @@ import (
 var (
 	_client    *clients.Client
 	clientLock = &sync.Mutex{}
+
+	// resourceManagerEndpoint optionally overrides the Resource Manager endpoint, which is set
+	// when running the Acceptance Tests against the emulated Resource Manager API
+	resourceManagerEndpoint string
 )
 
+// UseResourceManagerEndpoint configures the Test Client to send requests to the specified
+// Resource Manager endpoint (e.g. the emulated Resource Manager API) without authenticating
+func UseResourceManagerEndpoint(endpoint string) {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+
+	resourceManagerEndpoint = endpoint
+	_client = nil
+}
+
 func Build() (*clients.Client, error) {
 	clientLock.Lock()
 	defer clientLock.Unlock()
@@ -26,20 +41,43 @@ func Build() (*clients.Client, error) {
 			environment = "public"
 		}
 
-		builder := authentication.Builder{
-			SubscriptionID: os.Getenv("ARM_SUBSCRIPTION_ID"),
-			ClientID:       os.Getenv("ARM_CLIENT_ID"),
-			TenantID:       os.Getenv("ARM_TENANT_ID"),
-			ClientSecret:   os.Getenv("ARM_CLIENT_SECRET"),
-			Environment:    environment,
-			MetadataHost:   os.Getenv("ARM_METADATA_HOST"),
+		// when running against the emulated Resource Manager API no authentication is required
+		emulatorEndpoint := resourceManagerEndpoint
 
-			// we intentionally only support Client Secret auth for tests (since those variables are used all over)
-			SupportsClientSecretAuth: true,
-		}
-		config, err := builder.Build()
+		// nor is it when replaying the requests from a recording
+		recordingMode, err := recorder.CurrentMode()
 		if err != nil {
-			return nil, fmt.Errorf("building ARM Client: %+v", err)
+			return nil, fmt.Errorf("determining the recording mode: %+v", err)
+		}
+		skipAuthentication := emulatorEndpoint != "" || recordingMode == recorder.ReplayMode
+
+		var config *authentication.Config
+		if skipAuthentication {
+			config = &authentication.Config{
+				ClientID:                         os.Getenv("ARM_CLIENT_ID"),
+				SubscriptionID:                   os.Getenv("ARM_SUBSCRIPTION_ID"),
+				TenantID:                         os.Getenv("ARM_TENANT_ID"),
+				Environment:                      environment,
+				MetadataHost:                     os.Getenv("ARM_METADATA_HOST"),
+				AuthenticatedAsAServicePrincipal: true,
+			}
+		} else {
+			builder := authentication.Builder{
+				SubscriptionID: os.Getenv("ARM_SUBSCRIPTION_ID"),
+				ClientID:       os.Getenv("ARM_CLIENT_ID"),
+				TenantID:       os.Getenv("ARM_TENANT_ID"),
+				ClientSecret:   os.Getenv("ARM_CLIENT_SECRET"),
+				Environment:    environment,
+				MetadataHost:   os.Getenv("ARM_METADATA_HOST"),
+
+				// we intentionally only support Client Secret auth for tests (since those variables are used all over)
+				SupportsClientSecretAuth: true,
+			}
+
+			config, err = builder.Build()
+			if err != nil {
+				return nil, fmt.Errorf("building ARM Client: %+v", err)
+			}
 		}
 
 		clientBuilder := clients.ClientBuilder{
@@ -48,6 +86,11 @@ func Build() (*clients.Client, error) {
 			TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:                 features.Default(),
 			StorageUseAzureAD:        false,
+			ResourceManagerEndpoint:  emulatorEndpoint,
+			SkipAuthentication:       skipAuthentication,
+		}
+		if recordingMode != recorder.DisabledMode {
+			clientBuilder.Sender = recorder.Sender()
 		}
 		client, err := clients.Build(context.TODO(), clientBuilder)
 		if err != nil {
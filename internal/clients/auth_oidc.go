@@ -0,0 +1,284 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-multierror"
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+	"golang.org/x/oauth2"
+)
+
+// oidcAssertionAudience is the audience Azure Active Directory expects within a federated token
+const oidcAssertionAudience = "api://AzureADTokenExchange"
+
+// oidcDefaultTokenLifetime is used when the token response doesn't specify `expires_in`, so that
+// the cached token is refreshed rather than being treated as never expiring
+const oidcDefaultTokenLifetime = 5 * time.Minute
+
+// OIDCAuthConfig contains the configuration required to authenticate as a Service Principal
+// using a federated token (e.g. from GitHub Actions or a Kubernetes Service Account)
+type OIDCAuthConfig struct {
+	// RequestToken and RequestURL are used to request a federated token from the
+	// OIDC Provider at runtime, for example the token issuer within GitHub Actions
+	RequestToken string
+	RequestURL   string
+
+	// TokenFilePath is the path to a file containing a federated token, for example
+	// the projected Service Account token used by Azure Workload Identity
+	TokenFilePath string
+}
+
+// Validate ensures that the OIDC Configuration can be used to authenticate
+func (o OIDCAuthConfig) Validate(config authentication.Config) error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using OpenID Connect."
+
+	if config.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Subscription ID"))
+	}
+	if config.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+	if config.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+	if o.TokenFilePath == "" && (o.RequestToken == "" || o.RequestURL == "") {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Token File Path or both a Request Token and Request URL"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+// NewAuthorizer returns an autorest.Authorizer which exchanges a federated token for
+// an access token scoped to the specified API
+func (o OIDCAuthConfig) NewAuthorizer(ctx context.Context, environment environments.Environment, config authentication.Config, api environments.Api) (autorest.Authorizer, error) {
+	authorizer := auth.NewCachedAuthorizer(&oidcAuthorizer{
+		ctx:                ctx,
+		conf:               o,
+		environment:        environment,
+		tenantId:           config.TenantID,
+		auxiliaryTenantIds: config.AuxiliaryTenantIDs,
+		clientId:           config.ClientID,
+		scope:              api.DefaultScope(),
+	})
+
+	if authTyped, ok := authorizer.(autorest.Authorizer); ok {
+		return authTyped, nil
+	}
+
+	return nil, fmt.Errorf("returned auth.Authorizer does not implement autorest.Authorizer")
+}
+
+// BearerAuthorizerCallback returns a BearerAuthorizerCallback for use with data plane API's (e.g. KeyVault)
+func (o OIDCAuthConfig) BearerAuthorizerCallback(ctx context.Context, environment environments.Environment, config authentication.Config, api environments.Api) *autorest.BearerAuthorizerCallback {
+	authorizer, err := o.NewAuthorizer(ctx, environment, config, api)
+	if err != nil {
+		return autorest.NewBearerAuthorizerCallback(nil, func(_, _ string) (*autorest.BearerAuthorizer, error) {
+			return nil, fmt.Errorf("failed to acquire OIDC token for %s: %+v", api.Endpoint, err)
+		})
+	}
+
+	cast, ok := authorizer.(*auth.CachedAuthorizer)
+	if !ok {
+		return autorest.NewBearerAuthorizerCallback(nil, func(_, _ string) (*autorest.BearerAuthorizer, error) {
+			return nil, fmt.Errorf("authorizer was not an auth.CachedAuthorizer for %s", api.Endpoint)
+		})
+	}
+
+	return cast.BearerAuthorizerCallback()
+}
+
+// ObjectIDFunc returns a function which determines the Object ID of the Service Principal
+// from the claims within an access token issued for the Resource Manager API
+func (o OIDCAuthConfig) ObjectIDFunc(environment environments.Environment, config authentication.Config) func(context.Context) (*string, error) {
+	return func(ctx context.Context) (*string, error) {
+		authorizer := &oidcAuthorizer{
+			ctx:         ctx,
+			conf:        o,
+			environment: environment,
+			tenantId:    config.TenantID,
+			clientId:    config.ClientID,
+			scope:       environment.ResourceManager.DefaultScope(),
+		}
+		token, err := authorizer.Token()
+		if err != nil {
+			return nil, fmt.Errorf("obtaining access token: %+v", err)
+		}
+
+		claims, err := auth.ParseClaims(token)
+		if err != nil {
+			return nil, fmt.Errorf("parsing claims from access token: %+v", err)
+		}
+		if claims.ObjectId == "" {
+			return nil, fmt.Errorf("the access token did not contain an `oid` claim")
+		}
+
+		return &claims.ObjectId, nil
+	}
+}
+
+// oidcAuthorizer implements auth.Authorizer by exchanging a federated token
+// for an access token using the Client Credentials flow
+type oidcAuthorizer struct {
+	ctx                context.Context
+	conf               OIDCAuthConfig
+	environment        environments.Environment
+	tenantId           string
+	auxiliaryTenantIds []string
+	clientId           string
+	scope              string
+}
+
+func (a *oidcAuthorizer) Token() (*oauth2.Token, error) {
+	return a.tokenForTenant(a.tenantId)
+}
+
+// AuxiliaryTokens returns additional tokens for auxiliary tenant IDs, for use in multi-tenant scenarios
+func (a *oidcAuthorizer) AuxiliaryTokens() ([]*oauth2.Token, error) {
+	tokens := make([]*oauth2.Token, 0)
+	for _, tenantId := range a.auxiliaryTenantIds {
+		token, err := a.tokenForTenant(tenantId)
+		if err != nil {
+			return tokens, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func (a *oidcAuthorizer) tokenForTenant(tenantId string) (*oauth2.Token, error) {
+	assertion, err := a.federatedToken()
+	if err != nil {
+		return nil, fmt.Errorf("obtaining federated token: %+v", err)
+	}
+
+	v := url.Values{
+		"client_assertion":      {assertion},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_id":             {a.clientId},
+		"grant_type":            {"client_credentials"},
+		"scope":                 {a.scope},
+	}
+
+	tokenUrl := auth.TokenEndpoint(a.environment.AzureADEndpoint, tenantId, auth.TokenVersion2)
+	req, err := http.NewRequestWithContext(a.ctx, http.MethodPost, tokenUrl, bytes.NewBufferString(v.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("building token request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := doOIDCRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("exchanging federated token: %+v", err)
+	}
+
+	var tokenRes struct {
+		AccessToken string      `json:"access_token"`
+		TokenType   string      `json:"token_type"`
+		ExpiresIn   interface{} `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenRes); err != nil {
+		return nil, fmt.Errorf("unmarshalling token response: %+v", err)
+	}
+
+	token := &oauth2.Token{
+		AccessToken: tokenRes.AccessToken,
+		TokenType:   tokenRes.TokenType,
+	}
+
+	// the expiry can be returned as either a number or a string
+	var secs int64
+	switch exp := tokenRes.ExpiresIn.(type) {
+	case string:
+		if v, err := strconv.ParseInt(exp, 10, 64); err == nil {
+			secs = v
+		}
+	case float64:
+		secs = int64(exp)
+	}
+	lifetime := oidcDefaultTokenLifetime
+	if secs > 0 {
+		lifetime = time.Duration(secs) * time.Second
+	}
+	token.Expiry = time.Now().Add(lifetime)
+
+	return token, nil
+}
+
+// federatedToken returns the federated token issued by the OIDC Provider - this is sourced each
+// time since these tokens are short-lived and will be rotated by the issuer
+func (a *oidcAuthorizer) federatedToken() (string, error) {
+	if a.conf.TokenFilePath != "" {
+		contents, err := ioutil.ReadFile(a.conf.TokenFilePath)
+		if err != nil {
+			return "", fmt.Errorf("reading token file %q: %+v", a.conf.TokenFilePath, err)
+		}
+		return strings.TrimSpace(string(contents)), nil
+	}
+
+	requestUrl, err := url.Parse(a.conf.RequestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing request URL %q: %+v", a.conf.RequestURL, err)
+	}
+	query := requestUrl.Query()
+	query.Set("audience", oidcAssertionAudience)
+	requestUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(a.ctx, http.MethodGet, requestUrl.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("building federated token request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.conf.RequestToken))
+
+	body, err := doOIDCRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	var tokenRes struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &tokenRes); err != nil {
+		return "", fmt.Errorf("unmarshalling federated token response: %+v", err)
+	}
+	if tokenRes.Value == "" {
+		return "", fmt.Errorf("the federated token response did not contain a token")
+	}
+
+	return tokenRes.Value, nil
+}
+
+func doOIDCRequest(req *http.Request) ([]byte, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request to %q: %+v", req.URL.Host, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	if c := resp.StatusCode; c < 200 || c > 299 {
+		return nil, fmt.Errorf("received HTTP status %d with response: %s", resp.StatusCode, body)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,234 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/manicminer/hamilton/environments"
+)
+
+func TestOIDCAuthConfigValidate(t *testing.T) {
+	config := authentication.Config{
+		ClientID:       "00000000-0000-0000-0000-000000000000",
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		TenantID:       "00000000-0000-0000-0000-000000000000",
+	}
+
+	testData := []struct {
+		Name   string
+		Config authentication.Config
+		OIDC   OIDCAuthConfig
+		Valid  bool
+	}{
+		{
+			Name:   "Empty",
+			Config: authentication.Config{},
+			OIDC:   OIDCAuthConfig{},
+			Valid:  false,
+		},
+		{
+			Name:   "Missing Token",
+			Config: config,
+			OIDC:   OIDCAuthConfig{},
+			Valid:  false,
+		},
+		{
+			Name:   "Request URL without Request Token",
+			Config: config,
+			OIDC: OIDCAuthConfig{
+				RequestURL: "https://example.com/token",
+			},
+			Valid: false,
+		},
+		{
+			Name: "Missing Client ID",
+			Config: authentication.Config{
+				SubscriptionID: config.SubscriptionID,
+				TenantID:       config.TenantID,
+			},
+			OIDC: OIDCAuthConfig{
+				TokenFilePath: "/var/run/secrets/token",
+			},
+			Valid: false,
+		},
+		{
+			Name:   "Request URL and Request Token",
+			Config: config,
+			OIDC: OIDCAuthConfig{
+				RequestToken: "abc123",
+				RequestURL:   "https://example.com/token",
+			},
+			Valid: true,
+		},
+		{
+			Name:   "Token File Path",
+			Config: config,
+			OIDC: OIDCAuthConfig{
+				TokenFilePath: "/var/run/secrets/token",
+			},
+			Valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := v.OIDC.Validate(v.Config)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected %q to be valid but got: %+v", v.Name, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected %q to be invalid but it wasn't", v.Name)
+		}
+	}
+}
+
+func TestOIDCFederatedTokenFromFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "oidc-token")
+	if err != nil {
+		t.Fatalf("creating temp file: %+v", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString("federated-token\n"); err != nil {
+		t.Fatalf("writing temp file: %+v", err)
+	}
+	file.Close()
+
+	authorizer := &oidcAuthorizer{
+		ctx: context.TODO(),
+		conf: OIDCAuthConfig{
+			TokenFilePath: file.Name(),
+		},
+	}
+
+	actual, err := authorizer.federatedToken()
+	if err != nil {
+		t.Fatalf("obtaining federated token: %+v", err)
+	}
+	if actual != "federated-token" {
+		t.Fatalf("Expected the federated token to be %q but got %q", "federated-token", actual)
+	}
+}
+
+func TestOIDCFederatedTokenFromRequestURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("audience") != oidcAssertionAudience {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"value": "federated-token"}`)
+	}))
+	defer server.Close()
+
+	authorizer := &oidcAuthorizer{
+		ctx: context.TODO(),
+		conf: OIDCAuthConfig{
+			RequestToken: "request-token",
+			RequestURL:   fmt.Sprintf("%s/token?api-version=2.0", server.URL),
+		},
+	}
+
+	actual, err := authorizer.federatedToken()
+	if err != nil {
+		t.Fatalf("obtaining federated token: %+v", err)
+	}
+	if actual != "federated-token" {
+		t.Fatalf("Expected the federated token to be %q but got %q", "federated-token", actual)
+	}
+}
+
+func TestOIDCTokenForTenant(t *testing.T) {
+	testData := []struct {
+		Name        string
+		ExpiresIn   string
+		MinLifetime time.Duration
+		MaxLifetime time.Duration
+	}{
+		{
+			Name:        "Expiry as a Number",
+			ExpiresIn:   `, "expires_in": 3599`,
+			MinLifetime: 59 * time.Minute,
+			MaxLifetime: time.Hour,
+		},
+		{
+			Name:        "Expiry as a String",
+			ExpiresIn:   `, "expires_in": "3599"`,
+			MinLifetime: 59 * time.Minute,
+			MaxLifetime: time.Hour,
+		},
+		{
+			Name:        "No Expiry",
+			ExpiresIn:   "",
+			MinLifetime: oidcDefaultTokenLifetime - time.Minute,
+			MaxLifetime: oidcDefaultTokenLifetime,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/tenant1/oauth2/v2.0/token" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if err := r.ParseForm(); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if r.PostForm.Get("client_assertion") != "federated-token" || r.PostForm.Get("client_id") != "client1" || r.PostForm.Get("scope") != "https://management.azure.com/.default" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprintf(w, `{"access_token": "access-token", "token_type": "Bearer"%s}`, v.ExpiresIn)
+		}))
+
+		file, err := ioutil.TempFile("", "oidc-token")
+		if err != nil {
+			t.Fatalf("creating temp file: %+v", err)
+		}
+		if _, err := file.WriteString("federated-token"); err != nil {
+			t.Fatalf("writing temp file: %+v", err)
+		}
+		file.Close()
+
+		authorizer := &oidcAuthorizer{
+			ctx: context.TODO(),
+			conf: OIDCAuthConfig{
+				TokenFilePath: file.Name(),
+			},
+			environment: environments.Environment{
+				AzureADEndpoint: environments.AzureADEndpoint(server.URL),
+			},
+			clientId: "client1",
+			scope:    "https://management.azure.com/.default",
+		}
+
+		token, err := authorizer.tokenForTenant("tenant1")
+		server.Close()
+		os.Remove(file.Name())
+		if err != nil {
+			t.Fatalf("obtaining token for %q: %+v", v.Name, err)
+		}
+
+		if token.AccessToken != "access-token" {
+			t.Fatalf("Expected the access token for %q to be %q but got %q", v.Name, "access-token", token.AccessToken)
+		}
+		lifetime := time.Until(token.Expiry)
+		if lifetime < v.MinLifetime || lifetime > v.MaxLifetime {
+			t.Fatalf("Expected the token for %q to expire in between %s and %s but got %s", v.Name, v.MinLifetime, v.MaxLifetime, lifetime)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/throttling"
 	"github.com/manicminer/hamilton/environments"
 )
 
@@ -28,6 +29,24 @@ type ClientBuilder struct {
 	TerraformVersion            string
 	Features                    features.UserFeatures
 	UseMSAL                     bool
+
+	// OIDC is populated when authenticating as a Service Principal using a federated token
+	OIDC *OIDCAuthConfig
+
+	// ResourceManagerEndpoint optionally overrides the Resource Manager Endpoint for the Azure Environment,
+	// this is used to target an emulated Resource Manager API during testing
+	ResourceManagerEndpoint string
+
+	// SkipAuthentication uses a static token rather than authenticating, this is used in conjunction
+	// with ResourceManagerEndpoint to target an emulated Resource Manager API during testing
+	SkipAuthentication bool
+
+	// Sender optionally overrides the Sender used to send requests to the Azure API's (but not to
+	// authenticate), this is used to record/replay the requests sent during the Acceptance Tests
+	Sender autorest.Sender
+
+	// Throttling is the budget for the requests sent to the Resource Manager API
+	Throttling throttling.Settings
 }
 
 const azureStackEnvironmentError = `
@@ -58,6 +77,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
 	}
+	if builder.ResourceManagerEndpoint != "" {
+		log.Printf("[DEBUG] Overriding the Resource Manager Endpoint with %q", builder.ResourceManagerEndpoint)
+		env.ResourceManagerEndpoint = builder.ResourceManagerEndpoint
+	}
 
 	// Hamilton environment configuration
 	environment, err := environments.EnvironmentFromString(builder.AuthConfig.Environment)
@@ -65,6 +88,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
 	}
 
+	if builder.OIDC != nil {
+		builder.AuthConfig.GetAuthenticatedObjectID = builder.OIDC.ObjectIDFunc(environment, *builder.AuthConfig)
+	}
+
 	// client declarations:
 	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
@@ -93,7 +120,67 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	var tokenFunc common.EndpointTokenFunc
 	var graphAuth autorest.Authorizer // TODO: remove in v3.0
 
-	if builder.UseMSAL {
+	if builder.SkipAuthentication {
+		// an emulated API doesn't validate the token, so a static token is used for each API
+		log.Printf("[DEBUG] Skipping Authentication - using a static token for each API")
+		auth = autorest.NewBearerAuthorizer(staticTokenProvider("emulated"))
+		storageAuth = auth
+		synapseAuth = auth
+		batchManagementAuth = auth
+		keyVaultAuth = autorest.NewBearerAuthorizerCallback(sender, func(_, _ string) (*autorest.BearerAuthorizer, error) {
+			return autorest.NewBearerAuthorizer(staticTokenProvider("emulated")), nil
+		})
+		tokenFunc = func(_ string) (autorest.Authorizer, error) {
+			return auth, nil
+		}
+		if !builder.UseMSAL {
+			graphAuth = auth
+		}
+	} else if builder.OIDC != nil {
+		// federated tokens can only be exchanged for v2 tokens, so OIDC always uses the Microsoft Identity Platform
+		auth, err = builder.OIDC.NewAuthorizer(ctx, environment, *builder.AuthConfig, environment.ResourceManager)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get OIDC authorization token for resource manager API: %+v", err)
+		}
+
+		storageAuth, err = builder.OIDC.NewAuthorizer(ctx, environment, *builder.AuthConfig, environment.Storage)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get OIDC authorization token for storage API: %+v", err)
+		}
+
+		if environment.Synapse.IsAvailable() {
+			synapseAuth, err = builder.OIDC.NewAuthorizer(ctx, environment, *builder.AuthConfig, environment.Synapse)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get OIDC authorization token for synapse API: %+v", err)
+			}
+		} else {
+			log.Printf("[DEBUG] Skipping building the Synapse OIDC Authorizer since this is not supported in the current Azure Environment")
+		}
+
+		batchManagementAuth, err = builder.OIDC.NewAuthorizer(ctx, environment, *builder.AuthConfig, environment.BatchManagement)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get OIDC authorization token for batch management API: %+v", err)
+		}
+
+		keyVaultAuth = builder.OIDC.BearerAuthorizerCallback(ctx, environment, *builder.AuthConfig, environment.KeyVault)
+
+		// Helper for obtaining endpoint-specific tokens
+		tokenFunc = func(endpoint string) (autorest.Authorizer, error) {
+			api := environments.Api{Endpoint: environments.ApiEndpoint(endpoint)}
+			authorizer, err := builder.OIDC.NewAuthorizer(ctx, environment, *builder.AuthConfig, api)
+			if err != nil {
+				return nil, fmt.Errorf("getting OIDC authorization token for endpoint %s: %+v", endpoint, err)
+			}
+			return authorizer, nil
+		}
+
+		if !builder.UseMSAL {
+			graphAuth, err = builder.OIDC.NewAuthorizer(ctx, environment, *builder.AuthConfig, environment.AadGraph)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get OIDC authorization token for aadgraph API: %+v", err)
+			}
+		}
+	} else if builder.UseMSAL {
 		// TODO: remove UseMSAL toggle and make this the default behaviour in v3.0
 		auth, err = builder.AuthConfig.GetMSALToken(ctx, environment.ResourceManager, sender, oauthConfig, string(environment.ResourceManager.Endpoint))
 		if err != nil {
@@ -191,6 +278,8 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 		TokenFunc:                   tokenFunc,
+		Sender:                      builder.Sender,
+		Throttling:                  throttling.NewGovernor(builder.Throttling),
 	}
 
 	// TODO: remove in v3.0
@@ -206,7 +295,15 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	if features.EnhancedValidationEnabled() {
 		location.CacheSupportedLocations(ctx, env.ResourceManagerEndpoint)
 		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
+		resourceproviders.CacheSubscriptionLocations(ctx, client.Subscription.Client, builder.AuthConfig.SubscriptionID)
 	}
 
 	return &client, nil
 }
+
+// staticTokenProvider provides a fixed token, which is used when Authentication is skipped
+type staticTokenProvider string
+
+func (p staticTokenProvider) OAuthToken() string {
+	return string(p)
+}
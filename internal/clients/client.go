@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
 	advisor "github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/client"
 	analysisServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/client"
@@ -116,6 +117,14 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// ResourceProviders registers the Resource Providers required by each Resource, which is nil
+	// when Resource Provider Registration has been disabled
+	ResourceProviders *resourceproviders.Registrar
+
+	// CorrelationRequestID is the value sent in the `x-ms-correlation-request-id` header
+	// for requests to Azure, which is empty when this has been disabled
+	CorrelationRequestID string
+
 	AadB2c                *aadb2c.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
@@ -227,6 +236,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	client.CorrelationRequestID = o.CorrelationRequestID()
 
 	client.AadB2c = aadb2c.NewClient(o)
 	client.Advisor = advisor.NewClient(o)
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/throttling"
 	"github.com/hashicorp/terraform-provider-azurerm/version"
 )
 
@@ -39,6 +41,13 @@ type ClientOptions struct {
 	// Some Dataplane APIs require a token scoped for a specific endpoint
 	TokenFunc EndpointTokenFunc
 
+	// Sender optionally overrides the Sender used to send requests, this is used to record/replay
+	// the requests sent during the Acceptance Tests
+	Sender autorest.Sender
+
+	// Throttling limits the requests sent to the Resource Manager API across all of the clients
+	Throttling *throttling.Governor
+
 	// TODO: remove graph configuration in v3.0
 	GraphAuthorizer autorest.Authorizer
 	GraphEndpoint   string
@@ -48,13 +57,16 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = o.Sender
+	if c.Sender == nil {
+		c.Sender = sender.BuildSender("AzureRM")
+	}
+	if o.Throttling != nil {
+		c.Sender = o.Throttling.Sender(c.Sender)
+	}
+	c.Sender = tags.RetainIgnoredTagsSender(c.Sender)
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
+	if id := o.CorrelationRequestID(); id != "" {
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
 }
@@ -37,3 +37,17 @@ func correlationRequestID() string {
 
 	return msCorrelationRequestID
 }
+
+// CorrelationRequestID returns the value sent in the `x-ms-correlation-request-id` header for
+// requests made using these ClientOptions - or an empty string when this header is disabled.
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	return correlationRequestID()
+}
@@ -29,3 +29,34 @@ func TestWithCorrelationRequestID(t *testing.T) {
 			HeaderCorrelationRequestID, uuid, req.Header.Get(HeaderCorrelationRequestID))
 	}
 }
+
+func TestClientOptionsCorrelationRequestID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Options  ClientOptions
+		Expected string
+	}{
+		{
+			Name:     "Disabled",
+			Options:  ClientOptions{DisableCorrelationRequestID: true, CustomCorrelationRequestID: "abc123"},
+			Expected: "",
+		},
+		{
+			Name:     "Custom",
+			Options:  ClientOptions{CustomCorrelationRequestID: "abc123"},
+			Expected: "abc123",
+		},
+		{
+			Name:     "Generated",
+			Options:  ClientOptions{},
+			Expected: correlationRequestID(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+		if actual := v.Options.CorrelationRequestID(); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
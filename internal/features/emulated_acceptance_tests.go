@@ -0,0 +1,18 @@
+package features
+
+import (
+	"os"
+	"strings"
+)
+
+// UseEmulatedAcceptanceTests returns whether or not the Acceptance Tests should be run
+// against an emulated (in-process) Resource Manager API, rather than Azure
+//
+// This allows the lifecycle of simple resources (such as Resource Groups, Management Locks
+// and DNS Records) to be tested without credentials or network access - however since the
+// emulator doesn't implement the behaviour of each API, most resources aren't supported.
+//
+// It's possible to opt into this by setting `ARM_PROVIDER_EMULATED_TEST` to `true`.
+func UseEmulatedAcceptanceTests() bool {
+	return strings.EqualFold(os.Getenv("ARM_PROVIDER_EMULATED_TEST"), "true")
+}
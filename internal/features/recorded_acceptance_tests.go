@@ -0,0 +1,17 @@
+package features
+
+import (
+	"os"
+	"strings"
+)
+
+// AcceptanceTestRecordingMode returns the mode used to record/replay the requests sent
+// to Azure during the Acceptance Tests - which is either `record`, `replay` or empty
+// when the Acceptance Tests should run against Azure without being recorded.
+//
+// Recorded Acceptance Tests can be replayed without credentials or network access, which
+// allows changes to a resource's request/response handling to be caught without provisioning
+// real resources - it's possible to opt into this by setting `ARM_TEST_RECORDING_MODE`.
+func AcceptanceTestRecordingMode() string {
+	return strings.ToLower(strings.TrimSpace(os.Getenv("ARM_TEST_RECORDING_MODE")))
+}
@@ -10,6 +10,7 @@ type UserFeatures struct {
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
+	SkuAvailability        SkuAvailabilityFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -55,6 +56,10 @@ type ResourceGroupFeatures struct {
 	PreventDeletionIfContainsResources bool
 }
 
+type SkuAvailabilityFeatures struct {
+	ValidateDuringPlan bool
+}
+
 type ApiManagementFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
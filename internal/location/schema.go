@@ -7,7 +7,17 @@ import (
 )
 
 func Schema() *pluginsdk.Schema {
-	return commonschema.Location()
+	s := commonschema.Location()
+	s.ValidateFunc = EnhancedValidate
+	return s
+}
+
+// SchemaForResourceType returns the Schema for a Location field which (when enhanced validation is enabled)
+// validates that the Resource Type (e.g. `Microsoft.Compute/virtualMachines`) is available in that Location
+func SchemaForResourceType(resourceType string) *pluginsdk.Schema {
+	s := commonschema.Location()
+	s.ValidateFunc = EnhancedValidateForResourceType(resourceType)
+	return s
 }
 
 func SchemaOptional() *pluginsdk.Schema {
@@ -19,7 +29,9 @@ func SchemaComputed() *pluginsdk.Schema {
 }
 
 func SchemaWithoutForceNew() *pluginsdk.Schema {
-	return commonschema.LocationWithoutForceNew()
+	s := commonschema.LocationWithoutForceNew()
+	s.ValidateFunc = EnhancedValidate
+	return s
 }
 
 func DiffSuppressFunc(v, old, new string, d *pluginsdk.ResourceData) bool {
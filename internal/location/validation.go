@@ -0,0 +1,136 @@
+package location
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// these are only here to aid testing
+var (
+	supportedLocations                = resourceproviders.SupportedLocations
+	supportedLocationsForResourceType = resourceproviders.SupportedLocationsForResourceType
+)
+
+// EnhancedValidate validates that the Location is one of the Locations available to this Subscription
+//
+// NOTE: this is best-effort - if the users offline, or the API doesn't return it we'll
+// fall back to the original approach
+func EnhancedValidate(i interface{}, k string) ([]string, []error) {
+	locations := supportedLocations()
+	if locations == nil {
+		return location.EnhancedValidate(i, k)
+	}
+
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	normalized := Normalize(v)
+	if normalized == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	// Some resources use a location named "global".
+	if normalized == "global" || containsLocation(*locations, normalized) {
+		return nil, nil
+	}
+
+	return nil, []error{
+		fmt.Errorf("%q was not found in the list of Azure Locations available to this Subscription%s", v, suggestLocation(normalized, *locations)),
+	}
+}
+
+// EnhancedValidateForResourceType returns a validation function which (in addition to EnhancedValidate)
+// validates that the Resource Type (e.g. `Microsoft.Compute/virtualMachines`) is available in the Location
+func EnhancedValidateForResourceType(resourceType string) pluginsdk.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		warnings, errors := EnhancedValidate(i, k)
+		if len(errors) > 0 {
+			return warnings, errors
+		}
+
+		// the Resource Type may not be registered (or available in this Environment) - in which case
+		// the API will return an error during apply, as before
+		locations := supportedLocationsForResourceType(resourceType)
+		if locations == nil {
+			return warnings, nil
+		}
+
+		v := i.(string)
+		normalized := Normalize(v)
+		if normalized == "global" || containsLocation(*locations, normalized) {
+			return warnings, nil
+		}
+
+		available := append([]string{}, *locations...)
+		sort.Strings(available)
+		return warnings, []error{
+			fmt.Errorf("%q is not available in the Location %q - %q is available in the Locations: %s", resourceType, v, resourceType, strings.Join(available, ", ")),
+		}
+	}
+}
+
+func containsLocation(locations []string, normalized string) bool {
+	for _, loc := range locations {
+		if Normalize(loc) == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestLocation returns a suggestion for the closest matching Location, if the input looks like a typo
+func suggestLocation(normalized string, locations []string) string {
+	closest := ""
+	closestDistance := -1
+	for _, loc := range locations {
+		distance := levenshteinDistance(normalized, Normalize(loc))
+		if closestDistance == -1 || distance < closestDistance {
+			closest = loc
+			closestDistance = distance
+		}
+	}
+
+	// only suggest a Location when there's a couple of characters difference, otherwise it's likely unrelated
+	if closest == "" || closestDistance > 3 {
+		return ""
+	}
+
+	return fmt.Sprintf(" - did you mean %q?", closest)
+}
+
+func levenshteinDistance(first, second string) int {
+	previous := make([]int, len(second)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current := make([]int, len(second)+1)
+		current[0] = i
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(second)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
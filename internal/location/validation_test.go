@@ -0,0 +1,123 @@
+package location
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnhancedValidate(t *testing.T) {
+	original := supportedLocations
+	defer func() {
+		supportedLocations = original
+	}()
+
+	locations := []string{"westeurope", "northeurope", "westus"}
+	supportedLocations = func() *[]string {
+		return &locations
+	}
+
+	cases := []struct {
+		input      string
+		valid      bool
+		suggestion string
+	}{
+		{
+			input: "westeurope",
+			valid: true,
+		},
+		{
+			input: "West Europe",
+			valid: true,
+		},
+		{
+			input: "global",
+			valid: true,
+		},
+		{
+			input:      "westeurop",
+			valid:      false,
+			suggestion: `did you mean "westeurope"?`,
+		},
+		{
+			input: "australiacentral",
+			valid: false,
+		},
+		{
+			input: "",
+			valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := EnhancedValidate(v.input, "location")
+		actual := len(errors) == 0
+		if v.valid != actual {
+			t.Fatalf("Expected %t but got %t: %+v", v.valid, actual, errors)
+		}
+		if v.suggestion != "" && !strings.Contains(errors[0].Error(), v.suggestion) {
+			t.Fatalf("Expected the error to contain %q but got %q", v.suggestion, errors[0].Error())
+		}
+	}
+}
+
+func TestEnhancedValidateForResourceType(t *testing.T) {
+	originalLocations, originalResourceTypeLocations := supportedLocations, supportedLocationsForResourceType
+	defer func() {
+		supportedLocations = originalLocations
+		supportedLocationsForResourceType = originalResourceTypeLocations
+	}()
+
+	subscriptionLocations := []string{"westeurope", "northeurope", "westus"}
+	supportedLocations = func() *[]string {
+		return &subscriptionLocations
+	}
+	resourceTypeLocations := map[string][]string{
+		"Microsoft.Compute/virtualMachines": {"westeurope", "westus"},
+	}
+	supportedLocationsForResourceType = func(resourceType string) *[]string {
+		if v, ok := resourceTypeLocations[resourceType]; ok {
+			return &v
+		}
+		return nil
+	}
+
+	cases := []struct {
+		resourceType string
+		input        string
+		valid        bool
+	}{
+		{
+			resourceType: "Microsoft.Compute/virtualMachines",
+			input:        "West Europe",
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.Compute/virtualMachines",
+			input:        "northeurope",
+			valid:        false,
+		},
+		{
+			resourceType: "Microsoft.Compute/virtualMachines",
+			input:        "westeurop",
+			valid:        false,
+		},
+		{
+			// the locations for this resource type aren't known, so only the subscription locations are validated
+			resourceType: "Microsoft.Network/virtualNetworks",
+			input:        "northeurope",
+			valid:        true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q in %q..", v.resourceType, v.input)
+
+		_, errors := EnhancedValidateForResourceType(v.resourceType)(v.input, "location")
+		actual := len(errors) == 0
+		if v.valid != actual {
+			t.Fatalf("Expected %t but got %t: %+v", v.valid, actual, errors)
+		}
+	}
+}
@@ -1,5 +1,14 @@
 package locks
 
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"sync"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
@@ -9,12 +18,13 @@ func ByID(id string) {
 
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	armMutexKV.Lock(NameKey(name, resourceType))
 }
 
 func MultipleByName(names *[]string, resourceType string) {
+	// sorting the names ensures these are always locked in the same order
 	newSlice := removeDuplicatesFromStringArray(*names)
+	sort.Strings(newSlice)
 
 	for _, name := range newSlice {
 		ByName(name, resourceType)
@@ -26,8 +36,7 @@ func UnlockByID(id string) {
 }
 
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armMutexKV.Unlock(NameKey(name, resourceType))
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
@@ -37,3 +46,65 @@ func UnlockMultipleByName(names *[]string, resourceType string) {
 		UnlockByName(name, resourceType)
 	}
 }
+
+// NameKey returns the key used by ByName for the specified name and resource type,
+// allowing these locks to be acquired using Acquire
+func NameKey(name string, resourceType string) string {
+	return resourceType + "." + name
+}
+
+// NameKeys returns the key used by ByName for each of the specified names of the resource type,
+// allowing these locks to be acquired using Acquire
+func NameKeys(names []string, resourceType string) []string {
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, NameKey(name, resourceType))
+	}
+	return keys
+}
+
+// Acquire locks each of the specified keys (e.g. a Resource ID, or the output of NameKey),
+// returning a function which releases all of these locks.
+//
+// The keys are de-duplicated and locked in a canonical (sorted) order, meaning that multiple
+// callers acquiring overlapping sets of keys can't deadlock one another. If the Context is
+// cancelled (or times out) before all of the locks are acquired, any locks which have been
+// acquired are released and an error is returned.
+func Acquire(ctx context.Context, keys ...string) (func(), error) {
+	keys = removeDuplicatesFromStringArray(keys)
+	sort.Strings(keys)
+
+	holder := "unknown"
+	if _, file, line, ok := runtime.Caller(1); ok {
+		holder = fmt.Sprintf("%s:%d", file, line)
+	}
+
+	acquired := make([]string, 0, len(keys))
+	release := func() {
+		// release in the reverse order to acquisition
+		for i := len(acquired) - 1; i >= 0; i-- {
+			armMutexKV.release(acquired[i])
+		}
+	}
+
+	for _, key := range keys {
+		if err := armMutexKV.acquire(ctx, key, holder); err != nil {
+			release()
+			log.Printf("[DEBUG] Current Locks:\n%s", DebugDump())
+			return nil, fmt.Errorf("acquiring lock for %q: %+v", key, err)
+		}
+		acquired = append(acquired, key)
+	}
+
+	once := &sync.Once{}
+	return func() {
+		once.Do(release)
+	}, nil
+}
+
+// DebugDump returns a description of each lock which is currently held (including by whom,
+// and for how long) along with the number of callers waiting on it - intended for
+// diagnosing an apply which is stuck waiting on a lock
+func DebugDump() string {
+	return armMutexKV.dump()
+}
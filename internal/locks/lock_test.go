@@ -0,0 +1,145 @@
+package locks
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAcquireReleasesAllKeys(t *testing.T) {
+	release, err := Acquire(context.TODO(), "b", "a", "b", "c")
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+
+	for _, key := range []string{"a", "b", "c"} {
+		if _, ok := armMutexKV.store[key]; !ok {
+			t.Fatalf("expected %q to be locked", key)
+		}
+	}
+
+	release()
+	// calling release multiple times should be a no-op
+	release()
+
+	if len(armMutexKV.store) != 0 {
+		t.Fatalf("expected the store to be empty after releasing but got %d items", len(armMutexKV.store))
+	}
+}
+
+func TestAcquireOverlappingKeysInDifferentOrders(t *testing.T) {
+	keySets := [][]string{
+		{"nic", "nsg", "subnet", "vnet"},
+		{"vnet", "subnet", "nsg", "nic"},
+		{"subnet", "nic"},
+		{"vnet", "nsg"},
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 100*len(keySets))
+	for i := 0; i < 100; i++ {
+		for _, keys := range keySets {
+			wg.Add(1)
+			go func(keys []string) {
+				defer wg.Done()
+				release, err := Acquire(ctx, keys...)
+				if err != nil {
+					errs <- err
+					return
+				}
+				time.Sleep(time.Microsecond)
+				release()
+			}(keys)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	if len(armMutexKV.store) != 0 {
+		t.Fatalf("expected the store to be empty after releasing but got %d items", len(armMutexKV.store))
+	}
+}
+
+func TestAcquireHonoursContextCancellation(t *testing.T) {
+	release, err := Acquire(context.TODO(), "b")
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := Acquire(ctx, "a", "b"); err == nil {
+		t.Fatalf("expected an error acquiring a held lock but didn't get one")
+	}
+
+	// "a" should have been released when acquiring "b" failed
+	releaseA, err := Acquire(context.TODO(), "a")
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	releaseA()
+
+	if _, ok := armMutexKV.store["a"]; ok {
+		t.Fatalf("expected `a` to have been removed from the store")
+	}
+	if armMutexKV.store["b"].waiters != 0 {
+		t.Fatalf("expected no waiters for `b` but got %d", armMutexKV.store["b"].waiters)
+	}
+}
+
+func TestMutexKVRemovesUnusedEntries(t *testing.T) {
+	ByID("/some/id")
+	ByName("example", "azurerm_example")
+	UnlockByID("/some/id")
+	UnlockByName("example", "azurerm_example")
+
+	if len(armMutexKV.store) != 0 {
+		t.Fatalf("expected the store to be empty after unlocking but got %d items", len(armMutexKV.store))
+	}
+}
+
+func TestDebugDump(t *testing.T) {
+	release, err := Acquire(context.TODO(), NameKey("example", "azurerm_example"))
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+
+	waiting := make(chan struct{})
+	go func() {
+		ByName("example", "azurerm_example")
+		UnlockByName("example", "azurerm_example")
+		close(waiting)
+	}()
+
+	// wait for the goroutine to be waiting on the lock
+	for i := 0; i < 100; i++ {
+		if strings.Contains(DebugDump(), "(1 waiting)") {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	dump := DebugDump()
+	if !strings.HasPrefix(dump, `"azurerm_example.example": held by `) {
+		t.Fatalf("expected the dump to contain the holder but got %q", dump)
+	}
+	if !strings.Contains(dump, "lock_test.go") || !strings.HasSuffix(dump, "(1 waiting)") {
+		t.Fatalf("expected the dump to contain the caller and a waiter but got %q", dump)
+	}
+
+	release()
+	<-waiting
+
+	if dump := DebugDump(); dump != "" {
+		t.Fatalf("expected the dump to be empty but got %q", dump)
+	}
+}
@@ -1,48 +1,162 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Each entry is reference counted (by both the holder and any waiters) so that
+// it can be removed from the store once it's no longer in use.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*mutexEntry
+}
+
+type mutexEntry struct {
+	// semaphore is a buffered channel with a capacity of 1 - which (unlike a sync.Mutex)
+	// allows waiting for the lock to be cancelled via a Context
+	semaphore chan struct{}
+
+	// holder is a description of the caller currently holding this lock, if any
+	holder string
+
+	// heldSince is the time at which the current holder acquired this lock
+	heldSince time.Time
+
+	// waiters is the number of callers currently waiting to acquire this lock
+	waiters int
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	// this can't fail since the Context is never cancelled
+	_ = m.acquire(context.Background(), key, "")
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
+	m.release(key)
+}
+
+// acquire locks the mutex for the given key, returning an error if the Context is
+// cancelled (or times out) before the lock can be acquired
+func (m *mutexKV) acquire(ctx context.Context, key string, holder string) error {
+	log.Printf("[DEBUG] Locking %q", key)
+
+	m.lock.Lock()
+	entry, ok := m.store[key]
+	if !ok {
+		entry = &mutexEntry{
+			semaphore: make(chan struct{}, 1),
+		}
+		m.store[key] = entry
+	}
+	entry.waiters++
+	m.lock.Unlock()
+
+	select {
+	case entry.semaphore <- struct{}{}:
+		m.lock.Lock()
+		entry.waiters--
+		entry.holder = holder
+		entry.heldSince = time.Now()
+		m.lock.Unlock()
+
+		log.Printf("[DEBUG] Locked %q", key)
+		return nil
+
+	case <-ctx.Done():
+		m.lock.Lock()
+		entry.waiters--
+		m.removeIfUnused(key, entry)
+		m.lock.Unlock()
+
+		log.Printf("[DEBUG] Timed out waiting to lock %q: %+v", key, ctx.Err())
+		return ctx.Err()
+	}
+}
+
+// release unlocks the mutex for the given key, removing it from the store if there
+// are no other callers waiting for it
+func (m *mutexKV) release(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entry, ok := m.store[key]
+	if !ok {
+		panic(fmt.Sprintf("unlock of unlocked key %q", key))
+	}
+
+	select {
+	case <-entry.semaphore:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked key %q", key))
+	}
+
+	entry.holder = ""
+	entry.heldSince = time.Time{}
+	m.removeIfUnused(key, entry)
+
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// removeIfUnused removes the entry from the store when it's neither held nor waited on
+// the caller must hold `m.lock`
+func (m *mutexKV) removeIfUnused(key string, entry *mutexEntry) {
+	if entry.waiters > 0 || len(entry.semaphore) > 0 {
+		return
+	}
+
+	if existing, ok := m.store[key]; ok && existing == entry {
+		delete(m.store, key)
+	}
+}
+
+// dump returns a human-readable description of each lock which is currently held
+// or being waited on, sorted by key
+func (m *mutexKV) dump() string {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
-	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+
+	keys := make([]string, 0, len(m.store))
+	for k := range m.store {
+		keys = append(keys, k)
 	}
-	return mutex
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, key := range keys {
+		entry := m.store[key]
+
+		status := "not held"
+		if len(entry.semaphore) > 0 {
+			holder := entry.holder
+			if holder == "" {
+				holder = "unknown"
+			}
+			status = fmt.Sprintf("held by %s for %s", holder, time.Since(entry.heldSince).Round(time.Millisecond))
+		}
+		lines = append(lines, fmt.Sprintf("%q: %s (%d waiting)", key, status, entry.waiters))
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*mutexEntry),
 	}
 }
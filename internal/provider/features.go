@@ -86,6 +86,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"sku_availability": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validate_during_plan": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"template_deployment": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -327,6 +341,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["sku_availability"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			skuAvailabilityRaw := items[0].(map[string]interface{})
+			if v, ok := skuAvailabilityRaw["validate_during_plan"]; ok {
+				featuresMap.SkuAvailability.ValidateDuringPlan = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["template_deployment"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
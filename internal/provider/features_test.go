@@ -56,6 +56,9 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				SkuAvailability: features.SkuAvailabilityFeatures{
+					ValidateDuringPlan: false,
+				},
 			},
 		},
 		{
@@ -99,6 +102,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"sku_availability": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -146,6 +154,9 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				SkuAvailability: features.SkuAvailabilityFeatures{
+					ValidateDuringPlan: true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -202,6 +213,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"sku_availability": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -249,6 +265,9 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				SkuAvailability: features.SkuAvailabilityFeatures{
+					ValidateDuringPlan: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -995,3 +1014,68 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesSkuAvailability(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"sku_availability": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				SkuAvailability: features.SkuAvailabilityFeatures{
+					ValidateDuringPlan: false,
+				},
+			},
+		},
+		{
+			Name: "Validate During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"sku_availability": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				SkuAvailability: features.SkuAvailabilityFeatures{
+					ValidateDuringPlan: true,
+				},
+			},
+		},
+		{
+			Name: "Validate During Plan Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"sku_availability": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				SkuAvailability: features.SkuAvailabilityFeatures{
+					ValidateDuringPlan: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.SkuAvailability, testCase.Expected.SkuAvailability) {
+			t.Fatalf("Expected %+v but got %+v", result.SkuAvailability, testCase.Expected.SkuAvailability)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -26,6 +28,27 @@ func TestAzureProvider() *schema.Provider {
 	return azureProvider(true)
 }
 
+// TestOptions allows the Provider used in the Acceptance Tests to be customised
+type TestOptions struct {
+	// Sender optionally overrides the Sender used to send requests to Azure, which is
+	// used to record/replay the requests sent during the Acceptance Tests
+	Sender autorest.Sender
+
+	// SkipAuthentication uses a static token rather than authenticating, which is used
+	// when replaying the requests from a previous recording
+	SkipAuthentication bool
+
+	// ResourceManagerEndpoint optionally overrides the Resource Manager endpoint, which is used to
+	// run the Acceptance Tests against an emulated Resource Manager API (see `internal/acceptance/emulator`)
+	ResourceManagerEndpoint string
+}
+
+func TestAzureProviderWithOptions(options TestOptions) *schema.Provider {
+	p := azureProvider(true)
+	p.ConfigureContextFunc = providerConfigure(p, options)
+	return p
+}
+
 func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 	// avoids this showing up in test output
 	debugLog := func(f string, v ...interface{}) {
@@ -73,6 +96,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			if err != nil {
 				panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", key, err))
 			}
+			registerResourceProvidersOnCreate(service, resource)
+			applyDefaultTagsOnPlan(resource)
 			resources[key] = resource
 		}
 	}
@@ -94,6 +119,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			registerResourceProvidersOnCreate(service, v)
+			applyDefaultTagsOnPlan(v)
 			resources[k] = v
 		}
 	}
@@ -189,6 +216,33 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The path to a custom endpoint for Managed Service Identity - in most circumstances this should be detected automatically. ",
 			},
 
+			// OIDC specific fields
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+				Description: "Allow OpenID Connect to be used for authentication",
+			},
+			"oidc_request_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, ""),
+				Description: "The bearer token for the request to the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_request_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL"}, ""),
+				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_TOKEN_FILE_PATH", "AZURE_FEDERATED_TOKEN_FILE"}, ""),
+				Description: "The path to a file containing an ID token issued by the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
 			// Managed Tracking GUID for User-agent
 			"partner_id": {
 				Type:         schema.TypeString,
@@ -214,6 +268,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
+			"throttling": schemaThrottling(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -222,6 +282,10 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_provider_registrations": schemaResourceProviderRegistrations(),
+
+			"resource_providers_to_register": schemaResourceProvidersToRegister(),
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -251,12 +315,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	p.ConfigureContextFunc = providerConfigure(p, TestOptions{})
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+func providerConfigure(p *schema.Provider, options TestOptions) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -283,35 +347,71 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			useMsal = true
 		}
 
-		builder := &authentication.Builder{
-			SubscriptionID:     d.Get("subscription_id").(string),
-			ClientID:           d.Get("client_id").(string),
-			ClientSecret:       d.Get("client_secret").(string),
-			TenantID:           d.Get("tenant_id").(string),
-			AuxiliaryTenantIDs: auxTenants,
-			Environment:        d.Get("environment").(string),
-			MetadataHost:       metadataHost,
-			MsiEndpoint:        d.Get("msi_endpoint").(string),
-			ClientCertPassword: d.Get("client_certificate_password").(string),
-			ClientCertPath:     d.Get("client_certificate_path").(string),
-
-			// Feature Toggles
-			SupportsClientCertAuth:         true,
-			SupportsClientSecretAuth:       true,
-			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
-			SupportsAzureCliToken:          true,
-			SupportsAuxiliaryTenants:       len(auxTenants) > 0,
-
-			// Doc Links
-			ClientSecretDocsLink: "https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/service_principal_client_secret",
-
-			// MSAL opt-in
-			UseMicrosoftGraph: useMsal,
-		}
+		var config *authentication.Config
+		var oidcConfig *clients.OIDCAuthConfig
+		if options.SkipAuthentication {
+			// neither the emulator nor a recording require authentication, so we populate the Config directly
+			config = &authentication.Config{
+				ClientID:                         d.Get("client_id").(string),
+				SubscriptionID:                   d.Get("subscription_id").(string),
+				TenantID:                         d.Get("tenant_id").(string),
+				Environment:                      d.Get("environment").(string),
+				MetadataHost:                     metadataHost,
+				AuthenticatedAsAServicePrincipal: true,
+				UseMicrosoftGraph:                useMsal,
+			}
+		} else if d.Get("use_oidc").(bool) {
+			// OpenID Connect isn't supported by the authentication Builder, so we populate the Config directly
+			config = &authentication.Config{
+				ClientID:                         d.Get("client_id").(string),
+				SubscriptionID:                   d.Get("subscription_id").(string),
+				TenantID:                         d.Get("tenant_id").(string),
+				AuxiliaryTenantIDs:               auxTenants,
+				Environment:                      d.Get("environment").(string),
+				MetadataHost:                     metadataHost,
+				AuthenticatedAsAServicePrincipal: true,
+				UseMicrosoftGraph:                useMsal,
+			}
+			oidcConfig = &clients.OIDCAuthConfig{
+				RequestToken:  d.Get("oidc_request_token").(string),
+				RequestURL:    d.Get("oidc_request_url").(string),
+				TokenFilePath: d.Get("oidc_token_file_path").(string),
+			}
+			if err := oidcConfig.Validate(*config); err != nil {
+				return nil, diag.Errorf("building AzureRM Client: %s", err)
+			}
+		} else {
+			builder := &authentication.Builder{
+				SubscriptionID:     d.Get("subscription_id").(string),
+				ClientID:           d.Get("client_id").(string),
+				ClientSecret:       d.Get("client_secret").(string),
+				TenantID:           d.Get("tenant_id").(string),
+				AuxiliaryTenantIDs: auxTenants,
+				Environment:        d.Get("environment").(string),
+				MetadataHost:       metadataHost,
+				MsiEndpoint:        d.Get("msi_endpoint").(string),
+				ClientCertPassword: d.Get("client_certificate_password").(string),
+				ClientCertPath:     d.Get("client_certificate_path").(string),
+
+				// Feature Toggles
+				SupportsClientCertAuth:         true,
+				SupportsClientSecretAuth:       true,
+				SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
+				SupportsAzureCliToken:          true,
+				SupportsAuxiliaryTenants:       len(auxTenants) > 0,
+
+				// Doc Links
+				ClientSecretDocsLink: "https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/service_principal_client_secret",
+
+				// MSAL opt-in
+				UseMicrosoftGraph: useMsal,
+			}
 
-		config, err := builder.Build()
-		if err != nil {
-			return nil, diag.Errorf("building AzureRM Client: %s", err)
+			var err error
+			config, err = builder.Build()
+			if err != nil {
+				return nil, diag.Errorf("building AzureRM Client: %s", err)
+			}
 		}
 
 		terraformVersion := p.TerraformVersion
@@ -321,7 +421,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
-		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+		// the Default Tags are merged into the planned Tags for each Resource (and when these are expanded),
+		// whereas the Ignored Tags are removed when these are flattened
+		tags.SetDefaultTags(expandDefaultTags(d.Get("default_tags").([]interface{})))
+		tags.SetIgnoredTags(expandIgnoreTags(d.Get("ignore_tags").([]interface{})))
+
+		registrationMode := expandResourceProviderRegistrationMode(d.Get("resource_provider_registrations").(string), d.Get("skip_provider_registration").(bool))
+		resourceProvidersToRegister := *utils.ExpandStringSlice(d.Get("resource_providers_to_register").([]interface{}))
+		skipProviderRegistration := registrationMode == resourceproviders.RegistrationModeNone
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
 			SkipProviderRegistration:    skipProviderRegistration,
@@ -332,6 +439,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 			UseMSAL:                     useMsal,
+			OIDC:                        oidcConfig,
+			ResourceManagerEndpoint:     options.ResourceManagerEndpoint,
+			SkipAuthentication:          options.SkipAuthentication,
+			Sender:                      options.Sender,
+			Throttling:                  expandThrottling(d.Get("throttling").([]interface{})),
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
 			// platform level tracing
@@ -351,7 +463,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 
 		client.StopContext = stopCtx
 
-		if !skipProviderRegistration {
+		if !skipProviderRegistration || len(resourceProvidersToRegister) > 0 {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
@@ -361,10 +473,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 					"error: %s", err)
 			}
 
-			availableResourceProviders := providerList.Values()
-			requiredResourceProviders := resourceproviders.Required()
+			// the Resource Providers required by each Resource are also registered prior to it being created
+			client.ResourceProviders = resourceproviders.NewRegistrar(*client.Resource.ProvidersClient, providerList.Values())
 
-			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
+			requiredResourceProviders := resourceproviders.ForRegistrationMode(registrationMode, resourceProvidersToRegister)
+			if err := client.ResourceProviders.EnsureRegistered(ctx, requiredResourceProviders); err != nil {
 				return nil, diag.Errorf(resourceProviderRegistrationErrorFmt, err)
 			}
 		}
@@ -378,8 +491,10 @@ const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers
 Terraform automatically attempts to register the Resource Providers it supports to
 ensure it's able to provision resources.
 
-If you don't have permission to register Resource Providers you may wish to use the
-"skip_provider_registration" flag in the Provider block to disable this functionality.
+If you don't have permission to register Resource Providers you may wish to set the
+"resource_provider_registrations" field in the Provider block to "core" to only register
+the Resource Providers used by the most common resources (in addition to those required
+by the resources being created), or to "none" to disable this functionality.
 
 Please note that if you opt out of Resource Provider Registration and Terraform tries
 to provision a resource from a Resource Provider which is unregistered, then the errors
@@ -390,7 +505,7 @@ may appear misleading - for example:
 Could indicate either that the Resource Provider "Microsoft.Foo" requires registration,
 but this could also indicate that this Azure Region doesn't support this API version.
 
-More information on the "skip_provider_registration" flag can be found here:
-https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#skip_provider_registration
+More information on the "resource_provider_registrations" field can be found here:
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#resource_provider_registrations
 
 Original Error: %s`
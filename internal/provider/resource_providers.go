@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaResourceProviderRegistrations() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", ""),
+		ValidateFunc: validation.StringInSlice(resourceproviders.PossibleRegistrationModes(), false),
+		Description:  "The set of Resource Providers which should be registered when the Provider is configured. Possible values are `all`, `core`, `extended` and `none`. The Resource Providers required by a Resource are also registered prior to it being created, unless this is `none`.",
+	}
+}
+
+func schemaResourceProvidersToRegister() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Description: "A list of additional Resource Providers which should be registered when the Provider is configured.",
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: resourceproviders.ValidateNamespace,
+		},
+	}
+}
+
+// expandResourceProviderRegistrationMode returns the RegistrationMode, which falls back to the (legacy)
+// `skip_provider_registration` field when `resource_provider_registrations` isn't specified
+func expandResourceProviderRegistrationMode(input string, skipProviderRegistration bool) resourceproviders.RegistrationMode {
+	if input != "" {
+		if skipProviderRegistration {
+			log.Printf("[DEBUG] `resource_provider_registrations` is set to %q, ignoring `skip_provider_registration`", input)
+		}
+		return resourceproviders.RegistrationMode(input)
+	}
+
+	if skipProviderRegistration {
+		return resourceproviders.RegistrationModeNone
+	}
+
+	return resourceproviders.RegistrationModeAll
+}
+
+// registerResourceProvidersOnCreate ensures that the Resource Providers required by the Service are registered
+// prior to the Resource being created, when the Service Registration specifies these
+func registerResourceProvidersOnCreate(service interface{}, resource *pluginsdk.Resource) {
+	registration, ok := service.(sdk.ServiceRegistrationWithResourceProviders)
+	if !ok {
+		return
+	}
+
+	namespaces := registration.ResourceProviders()
+	if len(namespaces) == 0 {
+		return
+	}
+
+	ensureRegistered := func(ctx context.Context, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client.ResourceProviders == nil || client.Account.SkipResourceProviderRegistration {
+			return nil
+		}
+
+		if err := client.ResourceProviders.EnsureRegisteredForResource(ctx, namespaces); err != nil {
+			return fmt.Errorf("registering the Resource Providers required by the %q Service: %+v", registration.Name(), err)
+		}
+		return nil
+	}
+
+	if create := resource.CreateContext; create != nil {
+		resource.CreateContext = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := ensureRegistered(ctx, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return create(ctx, d, meta)
+		}
+		return
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if create := resource.Create; create != nil { //nolint:staticcheck
+		resource.Create = func(d *pluginsdk.ResourceData, meta interface{}) error { //nolint:staticcheck
+			ctx := context.Background()
+			if client, ok := meta.(*clients.Client); ok && client.StopContext != nil {
+				ctx = client.StopContext
+			}
+
+			if err := ensureRegistered(ctx, meta); err != nil {
+				return err
+			}
+			return create(d, meta)
+		}
+	}
+}
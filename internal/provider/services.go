@@ -123,7 +123,9 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		loadbalancer.Registration{},
 		loadtest.Registration{},
 		mssql.Registration{},
+		network.Registration{},
 		policy.Registration{},
+		privatedns.Registration{},
 		resource.Registration{},
 		sentinel.Registration{},
 		servicefabricmanaged.Registration{},
@@ -3,6 +3,7 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
@@ -31,3 +32,27 @@ func TestTypedResourcesContainValidModelObjects(t *testing.T) {
 		}
 	}
 }
+
+func TestServicesSpecifyValidResourceProviders(t *testing.T) {
+	services := make([]interface{}, 0)
+	for _, service := range SupportedTypedServices() {
+		services = append(services, service)
+	}
+	for _, service := range SupportedUntypedServices() {
+		services = append(services, service)
+	}
+
+	for _, service := range services {
+		registration, ok := service.(sdk.ServiceRegistrationWithResourceProviders)
+		if !ok {
+			continue
+		}
+
+		t.Logf("Service %q..", registration.Name())
+		for _, namespace := range registration.ResourceProviders() {
+			if _, errs := resourceproviders.ValidateNamespace(namespace, "namespace"); len(errs) > 0 {
+				t.Fatalf("validating Resource Provider %q for Service %q: %+v", namespace, registration.Name(), errs)
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaDefaultTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The Tags which should be assigned to every Resource which supports Tags. Tags defined on a Resource take precedence over these.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:         pluginsdk.TypeMap,
+					Required:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaIgnoreTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The Tags which are managed outside of Terraform (for example by Azure Policy) and which should be ignored on every Resource which supports Tags.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"keys": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{"ignore_tags.0.keys", "ignore_tags.0.key_prefixes"},
+				},
+
+				"key_prefixes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{"ignore_tags.0.keys", "ignore_tags.0.key_prefixes"},
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+	for k, v := range val["tags"].(map[string]interface{}) {
+		// Validate should have ignored this error already
+		value, _ := tags.TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+func expandIgnoreTags(input []interface{}) (keys []string, keyPrefixes []string) {
+	keys = make([]string, 0)
+	keyPrefixes = make([]string, 0)
+	if len(input) == 0 || input[0] == nil {
+		return keys, keyPrefixes
+	}
+
+	val := input[0].(map[string]interface{})
+	for _, v := range val["keys"].(*pluginsdk.Set).List() {
+		keys = append(keys, v.(string))
+	}
+	for _, v := range val["key_prefixes"].(*pluginsdk.Set).List() {
+		keyPrefixes = append(keyPrefixes, v.(string))
+	}
+
+	return keys, keyPrefixes
+}
+
+// applyDefaultTagsOnPlan merges the Default Tags into the planned value for the `tags` field on the Resource,
+// so that changes to the Default Tags are shown in the plan - which requires this field to be Computed
+func applyDefaultTagsOnPlan(resource *pluginsdk.Resource) {
+	existing, ok := resource.Schema["tags"]
+	if !ok || existing.Type != pluginsdk.TypeMap || !existing.Optional || existing.Computed {
+		return
+	}
+
+	// the Schema is copied since Resources can share a Schema
+	field := *existing
+	field.Computed = true
+	resource.Schema["tags"] = &field
+
+	customizeDiff := tags.CustomizeDiffForDefaultTags("tags", field.ForceNew)
+	if resource.CustomizeDiff != nil {
+		customizeDiff = pluginsdk.CustomDiffInSequence(resource.CustomizeDiff, customizeDiff)
+	}
+	resource.CustomizeDiff = customizeDiff
+}
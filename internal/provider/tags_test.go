@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestResourcesMergeDefaultTagsOnPlan(t *testing.T) {
+	provider := TestAzureProvider()
+
+	for _, name := range []string{"azurerm_resource_group", "azurerm_virtual_network"} {
+		resource, ok := provider.ResourcesMap[name]
+		if !ok {
+			t.Fatalf("expected the Resource %q to be registered", name)
+		}
+
+		field, ok := resource.Schema["tags"]
+		if !ok {
+			t.Fatalf("expected the Resource %q to have a `tags` field", name)
+		}
+		if !field.Optional || !field.Computed {
+			t.Fatalf("expected `tags` on %q to be Optional and Computed", name)
+		}
+		if resource.CustomizeDiff == nil {
+			t.Fatalf("expected %q to have a CustomizeDiff to merge the Default Tags", name)
+		}
+	}
+
+	// Data Sources are unaffected, since the Default Tags are only assigned to Resources
+	if field := provider.DataSourcesMap["azurerm_resource_group"].Schema["tags"]; field.Optional {
+		t.Fatalf("expected `tags` on the Data Source `azurerm_resource_group` to be Computed only")
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/throttling"
+)
+
+// these defaults match the rate at which Resource Manager refills the quota for a Subscription
+const (
+	defaultSubscriptionReadsPerSecond  = 25
+	defaultSubscriptionWritesPerSecond = 10
+)
+
+func schemaThrottling() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The budget for the requests sent to the Resource Manager API.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"subscription_reads_per_second": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaultSubscriptionReadsPerSecond,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of read requests per second which can be sent to a Subscription. Setting this to `0` removes the limit.",
+				},
+
+				"subscription_writes_per_second": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      defaultSubscriptionWritesPerSecond,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of write (and delete) requests per second which can be sent to a Subscription. Setting this to `0` removes the limit.",
+				},
+
+				"resource_provider_requests_per_second": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of requests per second which can be sent to each Resource Provider within a Subscription. Setting this to `0` removes the limit.",
+				},
+			},
+		},
+	}
+}
+
+func expandThrottling(input []interface{}) throttling.Settings {
+	// these are the defaults if omitted from the config
+	output := throttling.Settings{
+		SubscriptionReadsPerSecond:  defaultSubscriptionReadsPerSecond,
+		SubscriptionWritesPerSecond: defaultSubscriptionWritesPerSecond,
+	}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+	output.SubscriptionReadsPerSecond = val["subscription_reads_per_second"].(int)
+	output.SubscriptionWritesPerSecond = val["subscription_writes_per_second"].(int)
+	output.ResourceProviderRequestsPerSecond = val["resource_provider_requests_per_second"].(int)
+	return output
+}
@@ -3,26 +3,67 @@ package resourceproviders
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-11-01/subscriptions"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 )
 
-func availableResourceProviders(ctx context.Context, client *resources.ProvidersClient) (*[]string, error) {
+// availableResourceProviders returns the list of Resource Providers available in this Subscription, along with
+// a map of each Resource Type (in lower-case, e.g. `microsoft.compute/virtualmachines`) to the Locations where
+// it's available
+func availableResourceProviders(ctx context.Context, client *resources.ProvidersClient) (*[]string, map[string][]string, error) {
 	providerNames := make([]string, 0)
+	resourceTypeLocations := make(map[string][]string)
 	providers, err := client.ListComplete(ctx, nil, "")
 	if err != nil {
-		return nil, fmt.Errorf("listing Resource Providers: %+v", err)
+		return nil, nil, fmt.Errorf("listing Resource Providers: %+v", err)
 	}
 	for providers.NotDone() {
 		provider := providers.Value()
 		if provider.Namespace != nil {
 			providerNames = append(providerNames, *provider.Namespace)
+
+			if provider.ResourceTypes != nil {
+				for _, resourceType := range *provider.ResourceTypes {
+					if resourceType.ResourceType == nil || resourceType.Locations == nil {
+						continue
+					}
+
+					locations := make([]string, 0)
+					for _, loc := range *resourceType.Locations {
+						locations = append(locations, location.Normalize(loc))
+					}
+					key := strings.ToLower(fmt.Sprintf("%s/%s", *provider.Namespace, *resourceType.ResourceType))
+					resourceTypeLocations[key] = locations
+				}
+			}
 		}
 
 		if err := providers.NextWithContext(ctx); err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+	}
+
+	return &providerNames, resourceTypeLocations, nil
+}
+
+// availableSubscriptionLocations returns the list of (normalized) Locations available to this Subscription
+func availableSubscriptionLocations(ctx context.Context, client *subscriptions.Client, subscriptionId string) (*[]string, error) {
+	resp, err := client.ListLocations(ctx, subscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Locations for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	locations := make([]string, 0)
+	if resp.Value != nil {
+		for _, item := range *resp.Value {
+			if item.Name != nil {
+				locations = append(locations, location.Normalize(*item.Name))
+			}
 		}
 	}
 
-	return &providerNames, nil
+	return &locations, nil
 }
@@ -5,19 +5,40 @@ import (
 	"log"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-11-01/subscriptions"
 )
 
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
 var cachedResourceProviders *[]string
 
-// CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
-// and caches them, for used in enhanced validation
+// cachedResourceTypeLocations is a map of the Resource Type (e.g. `microsoft.compute/virtualmachines`)
+// to the Locations where it's available - which can (validly) be nil, so this shouldn't be relied on
+var cachedResourceTypeLocations map[string][]string
+
+// cachedSubscriptionLocations can be (validly) nil - as such this shouldn't be relied on
+var cachedSubscriptionLocations *[]string
+
+// CacheSupportedProviders attempts to retrieve the supported Resource Providers (and the Locations where each
+// Resource Type is available) from the Resource Manager API and caches them, for used in enhanced validation
 func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient) {
-	providers, err := availableResourceProviders(ctx, client)
+	providers, resourceTypeLocations, err := availableResourceProviders(ctx, client)
 	if err != nil {
 		log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
 		return
 	}
 
 	cachedResourceProviders = providers
+	cachedResourceTypeLocations = resourceTypeLocations
+}
+
+// CacheSubscriptionLocations attempts to retrieve the Locations available to this Subscription from the
+// Resource Manager API and caches them, for use in enhanced validation
+func CacheSubscriptionLocations(ctx context.Context, client *subscriptions.Client, subscriptionId string) {
+	locations, err := availableSubscriptionLocations(ctx, client, subscriptionId)
+	if err != nil {
+		log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will be unavailable", err)
+		return
+	}
+
+	cachedSubscriptionLocations = locations
 }
@@ -0,0 +1,31 @@
+package resourceproviders
+
+import (
+	"strings"
+)
+
+// SupportedLocations returns the (normalized) list of Locations available to this Subscription
+// when enhanced validation is enabled - this can (validly) be nil, so shouldn't be relied on
+func SupportedLocations() *[]string {
+	if !enhancedEnabled {
+		return nil
+	}
+
+	return cachedSubscriptionLocations
+}
+
+// SupportedLocationsForResourceType returns the (normalized) list of Locations where the specified Resource Type
+// (e.g. `Microsoft.Compute/virtualMachines`) is available when enhanced validation is enabled - this can (validly)
+// be nil, so shouldn't be relied on
+func SupportedLocationsForResourceType(resourceType string) *[]string {
+	if !enhancedEnabled || cachedResourceTypeLocations == nil {
+		return nil
+	}
+
+	locations, ok := cachedResourceTypeLocations[strings.ToLower(resourceType)]
+	if !ok || len(locations) == 0 {
+		return nil
+	}
+
+	return &locations
+}
@@ -0,0 +1,107 @@
+package resourceproviders
+
+import "sort"
+
+// RegistrationMode determines which Resource Providers are registered when the Provider is configured
+type RegistrationMode string
+
+const (
+	// RegistrationModeAll registers all of the Resource Providers supported by the Provider (see Required)
+	RegistrationModeAll RegistrationMode = "all"
+
+	// RegistrationModeCore registers the Resource Providers which are commonly used (see Core)
+	RegistrationModeCore RegistrationMode = "core"
+
+	// RegistrationModeExtended registers the Core Resource Providers, in addition to those which are
+	// frequently used (see Extended)
+	RegistrationModeExtended RegistrationMode = "extended"
+
+	// RegistrationModeNone doesn't register any Resource Providers
+	RegistrationModeNone RegistrationMode = "none"
+)
+
+// PossibleRegistrationModes returns the possible values for the RegistrationMode
+func PossibleRegistrationModes() []string {
+	return []string{
+		string(RegistrationModeAll),
+		string(RegistrationModeCore),
+		string(RegistrationModeExtended),
+		string(RegistrationModeNone),
+	}
+}
+
+// Core returns the Resource Providers which are used by the most common Resources
+func Core() map[string]struct{} {
+	// NOTE: Resource Providers in this list are case sensitive
+	return map[string]struct{}{
+		"Microsoft.Authorization":       {},
+		"Microsoft.Compute":             {},
+		"Microsoft.ContainerRegistry":   {},
+		"Microsoft.ContainerService":    {},
+		"Microsoft.KeyVault":            {},
+		"microsoft.insights":            {},
+		"Microsoft.ManagedIdentity":     {},
+		"Microsoft.Network":             {},
+		"Microsoft.OperationalInsights": {},
+		"Microsoft.Resources":           {},
+		"Microsoft.Storage":             {},
+	}
+}
+
+// Extended returns the Core Resource Providers, in addition to those which are frequently used
+func Extended() map[string]struct{} {
+	// NOTE: Resource Providers in this list are case sensitive
+	providers := map[string]struct{}{
+		"Microsoft.ApiManagement":        {},
+		"Microsoft.Cache":                {},
+		"Microsoft.Cdn":                  {},
+		"Microsoft.CognitiveServices":    {},
+		"Microsoft.ContainerInstance":    {},
+		"Microsoft.DBforMySQL":           {},
+		"Microsoft.DBforPostgreSQL":      {},
+		"Microsoft.DocumentDB":           {},
+		"Microsoft.EventGrid":            {},
+		"Microsoft.EventHub":             {},
+		"Microsoft.Logic":                {},
+		"Microsoft.Maintenance":          {},
+		"Microsoft.OperationsManagement": {},
+		"Microsoft.RecoveryServices":     {},
+		"Microsoft.Relay":                {},
+		"Microsoft.Search":               {},
+		"Microsoft.Security":             {},
+		"Microsoft.ServiceBus":           {},
+		"Microsoft.Sql":                  {},
+		"Microsoft.Web":                  {},
+	}
+	for k, v := range Core() {
+		providers[k] = v
+	}
+	return providers
+}
+
+// ForRegistrationMode returns the Resource Providers which should be registered when the Provider is
+// configured for the specified RegistrationMode, in addition to the specified Resource Providers
+func ForRegistrationMode(mode RegistrationMode, additional []string) []string {
+	var providers map[string]struct{}
+	switch mode {
+	case RegistrationModeAll:
+		providers = Required()
+	case RegistrationModeCore:
+		providers = Core()
+	case RegistrationModeExtended:
+		providers = Extended()
+	default:
+		providers = make(map[string]struct{})
+	}
+
+	for _, v := range additional {
+		providers[v] = struct{}{}
+	}
+
+	output := make([]string, 0, len(providers))
+	for k := range providers {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
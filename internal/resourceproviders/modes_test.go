@@ -0,0 +1,83 @@
+package resourceproviders
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestForRegistrationMode(t *testing.T) {
+	testData := []struct {
+		mode       RegistrationMode
+		additional []string
+		expected   []string
+	}{
+		{
+			mode:     RegistrationModeNone,
+			expected: []string{},
+		},
+		{
+			mode:       RegistrationModeNone,
+			additional: []string{"Microsoft.Kusto"},
+			expected:   []string{"Microsoft.Kusto"},
+		},
+		{
+			mode:       RegistrationModeCore,
+			additional: []string{"Microsoft.Compute", "Microsoft.Kusto"},
+			expected: []string{
+				"Microsoft.Authorization",
+				"Microsoft.Compute",
+				"Microsoft.ContainerRegistry",
+				"Microsoft.ContainerService",
+				"Microsoft.KeyVault",
+				"Microsoft.Kusto",
+				"Microsoft.ManagedIdentity",
+				"Microsoft.Network",
+				"Microsoft.OperationalInsights",
+				"Microsoft.Resources",
+				"Microsoft.Storage",
+				"microsoft.insights",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with %+v..", string(v.mode), v.additional)
+
+		actual := ForRegistrationMode(v.mode, v.additional)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+
+	if all, extended := len(ForRegistrationMode(RegistrationModeAll, nil)), len(ForRegistrationMode(RegistrationModeExtended, nil)); all <= extended {
+		t.Fatalf("expected `all` (%d) to contain more Resource Providers than `extended` (%d)", all, extended)
+	}
+	for k := range Core() {
+		if _, ok := Extended()[k]; !ok {
+			t.Fatalf("expected `extended` to contain the Core Resource Provider %q", k)
+		}
+	}
+}
+
+func TestValidateNamespace(t *testing.T) {
+	testData := []struct {
+		input string
+		valid bool
+	}{
+		{input: "", valid: false},
+		{input: "Microsoft", valid: false},
+		{input: "Microsoft.", valid: false},
+		{input: "Microsoft.Compute", valid: true},
+		{input: "microsoft.insights", valid: true},
+		{input: "Dynatrace.Observability", valid: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errs := ValidateNamespace(v.input, "namespace")
+		if valid := len(errs) == 0; valid != v.valid {
+			t.Fatalf("expected %t but got %t", v.valid, valid)
+		}
+	}
+}
@@ -2,24 +2,202 @@ package resourceproviders
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
 )
 
-func EnsureRegistered(ctx context.Context, client resources.ProvidersClient, availableRPs []resources.Provider, requiredRPs map[string]struct{}) error {
+// Registrar registers the Resource Providers within the Subscription, both when the Provider is configured
+// and prior to a Resource being created (for the Resource Providers required by that Resource)
+type Registrar struct {
+	client resources.ProvidersClient
+
+	// register registers the specified Resource Providers within the Subscription
+	register func(ctx context.Context, providers map[string]struct{}) error
+
+	// lock guards access to the maps below - but isn't held whilst registering Resource Providers
+	lock sync.Mutex
+
+	// namespaces is the (correctly cased) namespace for each Resource Provider which is available within
+	// the Subscription, keyed by the lower-cased namespace
+	namespaces map[string]string
+
+	// registered is the lower-cased namespace for each Resource Provider which has been registered
+	registered map[string]struct{}
+
+	// managed is the lower-cased namespace for each Resource Provider which was registered when the Provider
+	// was configured, which therefore can't be managed using the `azurerm_resource_provider_registration` resource
+	managed map[string]struct{}
+
+	// inFlight is the lower-cased namespace for each Resource Provider which is currently being registered,
+	// with the channel being closed once the registration has completed (successfully or otherwise)
+	inFlight map[string]chan struct{}
+}
+
+// NewRegistrar returns a Registrar for the Resource Providers available within the Subscription
+func NewRegistrar(client resources.ProvidersClient, availableResourceProviders []resources.Provider) *Registrar {
+	registrar := &Registrar{
+		client:     client,
+		namespaces: make(map[string]string),
+		registered: make(map[string]struct{}),
+		managed:    make(map[string]struct{}),
+		inFlight:   make(map[string]chan struct{}),
+	}
+	registrar.register = func(ctx context.Context, providers map[string]struct{}) error {
+		return resourceproviders.RegisterForSubscription(ctx, registrar.client, providers)
+	}
+
+	for _, provider := range availableResourceProviders {
+		if provider.Namespace == nil {
+			continue
+		}
+
+		key := strings.ToLower(*provider.Namespace)
+		registrar.namespaces[key] = *provider.Namespace
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "Registered") {
+			registrar.registered[key] = struct{}{}
+		}
+	}
+
+	return registrar
+}
+
+// EnsureRegistered registers the specified Resource Providers when the Provider is configured, which are
+// therefore considered to be managed by Terraform
+func (r *Registrar) EnsureRegistered(ctx context.Context, namespaces []string) error {
+	r.lock.Lock()
+	for _, namespace := range namespaces {
+		r.managed[strings.ToLower(namespace)] = struct{}{}
+	}
+	r.lock.Unlock()
+
+	return r.EnsureRegisteredForResource(ctx, namespaces)
+}
+
+// EnsureRegisteredForResource registers the specified Resource Providers (if they're not already registered),
+// which are required by a Resource. Namespaces which aren't available within the Subscription are ignored.
+//
+// Resource Providers which are already being registered (e.g. for another Resource being created in parallel)
+// are waited on rather than being registered again.
+func (r *Registrar) EnsureRegisteredForResource(ctx context.Context, namespaces []string) error {
 	log.Printf("[DEBUG] Determining which Resource Providers require Registration")
-	providersToRegister := resourceproviders.DetermineResourceProvidersRequiringRegistration(availableRPs, requiredRPs)
+	for {
+		providersToRegister, registering, inFlight := r.claimUnregistered(namespaces)
+		if len(providersToRegister) == 0 && len(inFlight) == 0 {
+			log.Printf("[DEBUG] All required Resource Providers are registered")
+			return nil
+		}
+
+		if len(providersToRegister) > 0 {
+			log.Printf("[DEBUG] Registering %d Resource Providers", len(providersToRegister))
+			err := r.register(ctx, providersToRegister)
+			r.releaseClaimed(registering, err == nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		// once these have completed they're checked again, since the registration may have failed
+		for _, done := range inFlight {
+			select {
+			case <-done:
+			case <-ctx.Done():
+				return fmt.Errorf("waiting for the Resource Providers to be registered: %+v", ctx.Err())
+			}
+		}
+	}
+}
+
+// claimUnregistered returns the (correctly cased) Resource Providers which need to be registered, which are
+// marked as in-flight (using the returned channel, keyed by the lower-cased namespace) - in addition to the
+// channels for the Resource Providers which are already being registered
+func (r *Registrar) claimUnregistered(namespaces []string) (map[string]struct{}, map[string]chan struct{}, []chan struct{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	providersToRegister := make(map[string]struct{})
+	registering := make(map[string]chan struct{})
+	inFlight := make([]chan struct{}, 0)
+	for _, namespace := range namespaces {
+		key := strings.ToLower(namespace)
+		if _, ok := r.registered[key]; ok {
+			continue
+		}
+		if _, ok := registering[key]; ok {
+			continue
+		}
+
+		name, ok := r.namespaces[key]
+		if !ok {
+			log.Printf("[DEBUG] The Resource Provider %q isn't available within this Subscription - skipping registration", namespace)
+			continue
+		}
+
+		if done, ok := r.inFlight[key]; ok {
+			inFlight = append(inFlight, done)
+			continue
+		}
+
+		done := make(chan struct{})
+		r.inFlight[key] = done
+		registering[key] = done
+		providersToRegister[name] = struct{}{}
+	}
+
+	return providersToRegister, registering, inFlight
+}
 
-	if len(providersToRegister) > 0 {
-		log.Printf("[DEBUG] Registering %d Resource Providers", len(providersToRegister))
-		if err := resourceproviders.RegisterForSubscription(ctx, client, providersToRegister); err != nil {
-			return err
+// releaseClaimed marks the Resource Providers claimed by claimUnregistered as no longer being registered,
+// recording them as registered when the registration was successful
+func (r *Registrar) releaseClaimed(registering map[string]chan struct{}, registered bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for key, done := range registering {
+		if registered {
+			r.registered[key] = struct{}{}
+		}
+		delete(r.inFlight, key)
+		close(done)
+	}
+}
+
+// IsManagedByTerraform returns whether the specified Resource Provider is registered when the Provider is configured
+func (r *Registrar) IsManagedByTerraform(namespace string) bool {
+	if r == nil {
+		return false
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	_, ok := r.managed[strings.ToLower(namespace)]
+	return ok
+}
+
+// ValidateNamespace validates that the specified value is a Resource Provider namespace (e.g. `Microsoft.Compute`)
+func ValidateNamespace(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	segments := strings.Split(v, ".")
+	if len(segments) < 2 {
+		errors = append(errors, fmt.Errorf("expected %q to be a Resource Provider namespace in the format `Publisher.Service` (e.g. `Microsoft.Compute`) but got %q", k, v))
+		return
+	}
+	for _, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			errors = append(errors, fmt.Errorf("expected %q to be a Resource Provider namespace in the format `Publisher.Service` (e.g. `Microsoft.Compute`) but got %q", k, v))
+			return
 		}
-	} else {
-		log.Printf("[DEBUG] All required Resource Providers are registered")
 	}
 
-	return nil
+	return
 }
@@ -0,0 +1,125 @@
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestRegistrarEnsureRegisteredForResourceConcurrently(t *testing.T) {
+	registrar := NewRegistrar(resources.ProvidersClient{}, []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Network"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	// registering Microsoft.Compute is blocked until Microsoft.Network has been registered, which
+	// would deadlock were the Registrar locked whilst registering
+	computeBlocked := make(chan struct{})
+	registrationsLock := sync.Mutex{}
+	registrations := make(map[string]int)
+	registrar.register = func(ctx context.Context, providers map[string]struct{}) error {
+		registrationsLock.Lock()
+		for name := range providers {
+			registrations[name]++
+		}
+		registrationsLock.Unlock()
+
+		if _, ok := providers["Microsoft.Compute"]; ok {
+			<-computeBlocked
+		}
+		return nil
+	}
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- registrar.EnsureRegisteredForResource(ctx, []string{"microsoft.compute"})
+		}()
+	}
+	waitForInFlight(t, registrar, "microsoft.compute")
+
+	if err := registrar.EnsureRegisteredForResource(ctx, []string{"Microsoft.Network"}); err != nil {
+		t.Fatalf("registering Microsoft.Network: %+v", err)
+	}
+	close(computeBlocked)
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("registering Microsoft.Compute: %+v", err)
+		}
+	}
+
+	expected := map[string]int{
+		"Microsoft.Compute": 1,
+		"Microsoft.Network": 1,
+	}
+	for name, count := range expected {
+		if registrations[name] != count {
+			t.Fatalf("expected %q to be registered %d times but got %d", name, count, registrations[name])
+		}
+	}
+}
+
+func TestRegistrarEnsureRegisteredForResourceRetriesFailedRegistration(t *testing.T) {
+	registrar := NewRegistrar(resources.ProvidersClient{}, []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+	})
+
+	registrations := 0
+	registrar.register = func(ctx context.Context, providers map[string]struct{}) error {
+		registrations++
+		if registrations == 1 {
+			return fmt.Errorf("registration failed")
+		}
+		return nil
+	}
+
+	ctx := context.TODO()
+	if err := registrar.EnsureRegisteredForResource(ctx, []string{"Microsoft.Compute"}); err == nil {
+		t.Fatalf("expected an error registering Microsoft.Compute but didn't get one")
+	}
+	for i := 0; i < 2; i++ {
+		if err := registrar.EnsureRegisteredForResource(ctx, []string{"Microsoft.Compute", "Microsoft.Unavailable"}); err != nil {
+			t.Fatalf("registering Microsoft.Compute: %+v", err)
+		}
+	}
+	if registrations != 2 {
+		t.Fatalf("expected Microsoft.Compute to be registered twice but got %d", registrations)
+	}
+}
+
+func waitForInFlight(t *testing.T, registrar *Registrar, key string) {
+	for i := 0; i < 1000; i++ {
+		registrar.lock.Lock()
+		_, ok := registrar.inFlight[key]
+		registrar.lock.Unlock()
+		if ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %q to be registered", key)
+}
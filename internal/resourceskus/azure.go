@@ -0,0 +1,137 @@
+package resourceskus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+func availableSkus(ctx context.Context, client *compute.ResourceSkusClient, loc string) (map[string]map[string]sku, error) {
+	output := make(map[string]map[string]sku)
+
+	filter := fmt.Sprintf("location eq '%s'", loc)
+	skus, err := client.ListComplete(ctx, filter, "false")
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource SKUs in %q: %+v", loc, err)
+	}
+	for skus.NotDone() {
+		item := skus.Value()
+		if item.ResourceType != nil && item.Name != nil {
+			resourceType := strings.ToLower(*item.ResourceType)
+			if _, ok := output[resourceType]; !ok {
+				output[resourceType] = make(map[string]sku)
+			}
+
+			output[resourceType][strings.ToLower(*item.Name)] = flattenResourceSku(item, loc)
+		}
+
+		if err := skus.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func availableUsages(ctx context.Context, client *compute.UsageClient, loc string) (map[string]usage, error) {
+	output := make(map[string]usage)
+
+	usages, err := client.ListComplete(ctx, loc)
+	if err != nil {
+		return nil, fmt.Errorf("listing Usages in %q: %+v", loc, err)
+	}
+	for usages.NotDone() {
+		item := usages.Value()
+		if item.Name != nil && item.Name.Value != nil && item.CurrentValue != nil && item.Limit != nil {
+			output[strings.ToLower(*item.Name.Value)] = usage{
+				name:    *item.Name.Value,
+				current: int64(*item.CurrentValue),
+				limit:   *item.Limit,
+			}
+		}
+
+		if err := usages.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func flattenResourceSku(input compute.ResourceSku, loc string) sku {
+	output := sku{
+		zones: make([]string, 0),
+	}
+	if input.Name != nil {
+		output.name = *input.Name
+	}
+	if input.Family != nil {
+		output.family = *input.Family
+	}
+
+	if input.Capabilities != nil {
+		for _, capability := range *input.Capabilities {
+			if capability.Name == nil || capability.Value == nil || !strings.EqualFold(*capability.Name, "vCPUs") {
+				continue
+			}
+
+			if v, err := strconv.ParseInt(*capability.Value, 10, 64); err == nil {
+				output.vCPUs = v
+			}
+		}
+	}
+
+	if input.LocationInfo != nil {
+		for _, info := range *input.LocationInfo {
+			if info.Location == nil || location.Normalize(*info.Location) != loc || info.Zones == nil {
+				continue
+			}
+
+			output.zones = append(output.zones, *info.Zones...)
+		}
+	}
+
+	if input.Restrictions != nil {
+		for _, restriction := range *input.Restrictions {
+			switch restriction.Type {
+			case compute.ResourceSkuRestrictionsTypeLocation:
+				if restriction.Values != nil {
+					for _, v := range *restriction.Values {
+						if location.Normalize(v) == loc {
+							output.restrictedReason = string(restriction.ReasonCode)
+						}
+					}
+				}
+
+			case compute.ResourceSkuRestrictionsTypeZone:
+				if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Zones != nil {
+					output.zones = removeZones(output.zones, *restriction.RestrictionInfo.Zones)
+				}
+			}
+		}
+	}
+
+	return output
+}
+
+func removeZones(input []string, toRemove []string) []string {
+	output := make([]string, 0)
+	for _, zone := range input {
+		remove := false
+		for _, v := range toRemove {
+			if zone == v {
+				remove = true
+				break
+			}
+		}
+
+		if !remove {
+			output = append(output, zone)
+		}
+	}
+	return output
+}
@@ -0,0 +1,68 @@
+package resourceskus
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenResourceSku(t *testing.T) {
+	input := compute.ResourceSku{
+		ResourceType: utils.String("virtualMachines"),
+		Name:         utils.String("Standard_D2s_v3"),
+		Family:       utils.String("standardDSv3Family"),
+		Capabilities: &[]compute.ResourceSkuCapabilities{
+			{
+				Name:  utils.String("MemoryGB"),
+				Value: utils.String("8"),
+			},
+			{
+				Name:  utils.String("vCPUs"),
+				Value: utils.String("2"),
+			},
+		},
+		LocationInfo: &[]compute.ResourceSkuLocationInfo{
+			{
+				Location: utils.String("WestEurope"),
+				Zones:    &[]string{"1", "2", "3"},
+			},
+		},
+		Restrictions: &[]compute.ResourceSkuRestrictions{
+			{
+				Type:   compute.ResourceSkuRestrictionsTypeZone,
+				Values: &[]string{"westeurope"},
+				RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+					Locations: &[]string{"westeurope"},
+					Zones:     &[]string{"2"},
+				},
+				ReasonCode: compute.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription,
+			},
+		},
+	}
+
+	expected := sku{
+		name:   "Standard_D2s_v3",
+		family: "standardDSv3Family",
+		vCPUs:  2,
+		zones:  []string{"1", "3"},
+	}
+	actual := flattenResourceSku(input, "westeurope")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	// a location restriction means this SKU isn't available in this region
+	input.Restrictions = &[]compute.ResourceSkuRestrictions{
+		{
+			Type:       compute.ResourceSkuRestrictionsTypeLocation,
+			Values:     &[]string{"West Europe"},
+			ReasonCode: compute.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription,
+		},
+	}
+	actual = flattenResourceSku(input, "westeurope")
+	if actual.restrictedReason != "NotAvailableForSubscription" {
+		t.Fatalf("expected the SKU to be restricted but got %+v", actual)
+	}
+}
@@ -0,0 +1,112 @@
+package resourceskus
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	computeClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
+)
+
+// regionAvailability is the set of SKUs and the Usage (e.g. quota) available within an Azure Region
+type regionAvailability struct {
+	location string
+
+	// skus is a map of the Resource Type to a map of the SKU Name to the SKU (with both keys in lower-case)
+	skus map[string]map[string]sku
+
+	// usages is a map of the Usage Name (in lower-case) to the Usage
+	usages map[string]usage
+}
+
+type sku struct {
+	name   string
+	family string
+	vCPUs  int64
+
+	// zones is the list of Availability Zones where this SKU is available
+	zones []string
+
+	// restrictedReason is populated when this SKU isn't available within this Azure Region
+	restrictedReason string
+}
+
+type usage struct {
+	name    string
+	current int64
+	limit   int64
+}
+
+var (
+	// cacheLock guards access to cachedRegions - but isn't held whilst the SKUs and Usages are retrieved
+	cacheLock = &sync.Mutex{}
+
+	// cachedRegions is a map of the Azure Region to the SKUs available within it, which can (validly)
+	// be nil where these couldn't be retrieved - as such this shouldn't be relied on
+	cachedRegions = map[string]*cachedRegion{}
+)
+
+// cachedRegion is the (possibly in-flight) retrieval of the SKUs and Usage for an Azure Region
+type cachedRegion struct {
+	// ready is closed once availability has been populated (or couldn't be retrieved)
+	ready chan struct{}
+
+	availability *regionAvailability
+}
+
+// cacheAvailableSkus attempts to retrieve the SKUs and Usage for the specified Azure Region from the
+// Compute API (once per Azure Region) and caches them, for use in validating SKUs during plan
+func cacheAvailableSkus(ctx context.Context, client *computeClient.Client, loc string) *regionAvailability {
+	loc = location.Normalize(loc)
+
+	return cachedAvailability(ctx, loc, func() *regionAvailability {
+		skus, err := availableSkus(ctx, client.ResourceSkusClient, loc)
+		if err != nil {
+			log.Printf("[DEBUG] error retrieving SKUs: %s. SKU validation will be unavailable in %q", err, loc)
+			return nil
+		}
+
+		usages, err := availableUsages(ctx, client.UsageClient, loc)
+		if err != nil {
+			log.Printf("[DEBUG] error retrieving Usages: %s. SKU validation will be unavailable in %q", err, loc)
+			return nil
+		}
+
+		return &regionAvailability{
+			location: loc,
+			skus:     skus,
+			usages:   usages,
+		}
+	})
+}
+
+// cachedAvailability returns the cached availability for the specified (normalized) Azure Region, calling
+// retrieve to populate it the first time it's requested. Concurrent callers for the same Azure Region wait
+// for that retrieval rather than repeating it, whereas other Azure Regions can be retrieved in parallel.
+func cachedAvailability(ctx context.Context, loc string, retrieve func() *regionAvailability) *regionAvailability {
+	cacheLock.Lock()
+	region, exists := cachedRegions[loc]
+	if !exists {
+		region = &cachedRegion{
+			ready: make(chan struct{}),
+		}
+		cachedRegions[loc] = region
+	}
+	cacheLock.Unlock()
+
+	if exists {
+		select {
+		case <-region.ready:
+			return region.availability
+		case <-ctx.Done():
+			log.Printf("[DEBUG] timed out waiting for the SKUs to be retrieved. SKU validation will be unavailable in %q", loc)
+			return nil
+		}
+	}
+
+	// a failure is cached too, to avoid retrieving these for every resource in the configuration
+	defer close(region.ready)
+	region.availability = retrieve()
+	return region.availability
+}
@@ -0,0 +1,87 @@
+package resourceskus
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedAvailabilityRetrievesEachRegionOnce(t *testing.T) {
+	clearCachedRegions("cachetestwesteurope", "cachetestnortheurope")
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	// the first retrieval for West Europe is blocked until North Europe has been retrieved, which
+	// would deadlock were the cache locked whilst retrieving
+	westEuropeBlocked := make(chan struct{})
+	retrievals := int32(0)
+	retrieve := func(loc string) func() *regionAvailability {
+		return func() *regionAvailability {
+			atomic.AddInt32(&retrievals, 1)
+			if loc == "cachetestwesteurope" {
+				<-westEuropeBlocked
+			}
+			return &regionAvailability{
+				location: loc,
+			}
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	results := make(chan *regionAvailability, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results <- cachedAvailability(ctx, "cachetestwesteurope", retrieve("cachetestwesteurope"))
+		}()
+	}
+
+	northEurope := cachedAvailability(ctx, "cachetestnortheurope", retrieve("cachetestnortheurope"))
+	if northEurope == nil || northEurope.location != "cachetestnortheurope" {
+		t.Fatalf("expected the availability for North Europe but got %+v", northEurope)
+	}
+	close(westEuropeBlocked)
+
+	wg.Wait()
+	close(results)
+	for result := range results {
+		if result == nil || result.location != "cachetestwesteurope" {
+			t.Fatalf("expected the availability for West Europe but got %+v", result)
+		}
+	}
+
+	if actual := atomic.LoadInt32(&retrievals); actual != 2 {
+		t.Fatalf("expected each Azure Region to be retrieved once but got %d retrievals", actual)
+	}
+}
+
+func TestCachedAvailabilityCachesFailures(t *testing.T) {
+	clearCachedRegions("cachetestfailure")
+	ctx := context.TODO()
+	retrievals := 0
+	retrieve := func() *regionAvailability {
+		retrievals++
+		return nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if actual := cachedAvailability(ctx, "cachetestfailure", retrieve); actual != nil {
+			t.Fatalf("expected no availability but got %+v", actual)
+		}
+	}
+	if retrievals != 1 {
+		t.Fatalf("expected a single retrieval but got %d", retrievals)
+	}
+}
+
+func clearCachedRegions(locations ...string) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	for _, loc := range locations {
+		delete(cachedRegions, loc)
+	}
+}
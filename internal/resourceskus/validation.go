@@ -0,0 +1,231 @@
+package resourceskus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	ResourceTypeDisks           = "disks"
+	ResourceTypeVirtualMachines = "virtualMachines"
+)
+
+// SkuFields defines the fields within a Resource which specify the SKU (and where it's deployed), which
+// are validated against the SKUs available in the Azure Region
+type SkuFields struct {
+	// ResourceType is the type of Resource the SKU applies to, either `disks` or `virtualMachines`
+	ResourceType string
+
+	// Name is the field containing the name of the SKU, for example `size`
+	Name string
+
+	// Location is the field containing the Azure Region, which defaults to `location` when unset
+	Location string
+
+	// LocationFunc optionally returns the Azure Region for Resources which don't contain a Location
+	// field (for example, Child Resources) - or nil when this isn't known during plan
+	LocationFunc func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) (*string, error)
+
+	// Zones is the (optional) field containing the Availability Zone(s), which is either a string or a list of strings
+	Zones string
+
+	// Capacity is the (optional) field containing the number of instances, which is used to check
+	// there's sufficient quota for Virtual Machine SKUs - this defaults to a single instance when unset
+	Capacity string
+}
+
+// ValidateAvailability returns a CustomizeDiffFunc which (when the `sku_availability` feature is enabled)
+// validates that the SKU is offered in the Azure Region (and the Availability Zones) specified - and for
+// Virtual Machine SKUs, that there's sufficient vCPU quota available to create the instances.
+//
+// NOTE: this is best-effort - if the SKUs can't be retrieved, or the values aren't known until apply, then
+// validation is skipped and any error will be returned from the API during apply, as before
+func ValidateAvailability(fields SkuFields) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || !client.Features.SkuAvailability.ValidateDuringPlan {
+			return nil
+		}
+
+		// there's no need to validate existing resources unless the SKU, Zones or Capacity are changing
+		changing := d.HasChange(fields.Name)
+		if fields.Zones != "" && d.HasChange(fields.Zones) {
+			changing = true
+		}
+		if fields.Capacity != "" && d.HasChange(fields.Capacity) {
+			changing = true
+		}
+		if d.Id() != "" && !changing {
+			return nil
+		}
+
+		for _, field := range []string{fields.Name, fields.Zones, fields.Capacity} {
+			if field != "" && !d.NewValueKnown(field) {
+				return nil
+			}
+		}
+		name := d.Get(fields.Name).(string)
+		if name == "" {
+			return nil
+		}
+
+		loc, err := locationForResource(ctx, fields, d, meta)
+		if err != nil {
+			return err
+		}
+		if loc == nil || *loc == "" {
+			return nil
+		}
+
+		availability := cacheAvailableSkus(ctx, client.Compute, *loc)
+		if availability == nil {
+			return nil
+		}
+
+		zones := make([]string, 0)
+		if fields.Zones != "" {
+			switch v := d.Get(fields.Zones).(type) {
+			case string:
+				if v != "" {
+					zones = append(zones, v)
+				}
+			case []interface{}:
+				for _, zone := range v {
+					if zone != nil {
+						zones = append(zones, zone.(string))
+					}
+				}
+			}
+		}
+		if err := availability.validateSku(fields.ResourceType, fields.Name, name, zones); err != nil {
+			return err
+		}
+
+		if fields.ResourceType != ResourceTypeVirtualMachines {
+			return nil
+		}
+
+		oldNameRaw, _ := d.GetChange(fields.Name)
+		oldName := oldNameRaw.(string)
+		oldCapacity, newCapacity := 0, 1
+		if d.Id() != "" {
+			oldCapacity = 1
+		}
+		if fields.Capacity != "" {
+			oldCapacityRaw, newCapacityRaw := d.GetChange(fields.Capacity)
+			oldCapacity = oldCapacityRaw.(int)
+			newCapacity = newCapacityRaw.(int)
+		}
+		return availability.validateQuota(fields.Name, oldName, oldCapacity, name, newCapacity)
+	}
+}
+
+func locationForResource(ctx context.Context, fields SkuFields, d *pluginsdk.ResourceDiff, meta interface{}) (*string, error) {
+	if fields.LocationFunc != nil {
+		return fields.LocationFunc(ctx, d, meta)
+	}
+
+	field := fields.Location
+	if field == "" {
+		field = "location"
+	}
+	if !d.NewValueKnown(field) {
+		return nil, nil
+	}
+
+	loc := d.Get(field).(string)
+	return &loc, nil
+}
+
+// validateSku validates that the SKU is available within this Azure Region and the specified Availability Zones
+func (r regionAvailability) validateSku(resourceType, field, name string, zones []string) error {
+	skus, ok := r.skus[strings.ToLower(resourceType)]
+	if !ok {
+		// the API didn't return any SKUs for this Resource Type, so we can't validate it
+		return nil
+	}
+
+	sku, ok := skus[strings.ToLower(name)]
+	if !ok {
+		return fmt.Errorf("`%s`: the SKU %q is not offered in the location %q", field, name, r.location)
+	}
+	if sku.restrictedReason != "" {
+		return fmt.Errorf("`%s`: the SKU %q is not available for this Subscription in the location %q (reason: %s)", field, name, r.location, sku.restrictedReason)
+	}
+
+	unavailableZones := make([]string, 0)
+	for _, zone := range zones {
+		found := false
+		for _, v := range sku.zones {
+			if v == zone {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unavailableZones = append(unavailableZones, zone)
+		}
+	}
+	if len(unavailableZones) > 0 {
+		if len(sku.zones) == 0 {
+			return fmt.Errorf("`%s`: the SKU %q doesn't support Availability Zones in the location %q", field, name, r.location)
+		}
+
+		availableZones := append([]string{}, sku.zones...)
+		sort.Strings(availableZones)
+		return fmt.Errorf("`%s`: the SKU %q is not available in the Availability Zone(s) %q in the location %q - this SKU is available in the Availability Zone(s) %q", field, name, strings.Join(unavailableZones, ", "), r.location, strings.Join(availableZones, ", "))
+	}
+
+	return nil
+}
+
+// validateQuota validates that there's sufficient vCPU quota available for the Virtual Machine SKU, taking into
+// account the vCPUs already in use by the existing instances (if any) which are included in the current usage
+func (r regionAvailability) validateQuota(field, oldName string, oldCapacity int, newName string, newCapacity int) error {
+	skus := r.skus[strings.ToLower(ResourceTypeVirtualMachines)]
+	newSku, ok := skus[strings.ToLower(newName)]
+	if !ok || newSku.vCPUs == 0 {
+		return nil
+	}
+	oldSku := skus[strings.ToLower(oldName)]
+
+	required := newSku.vCPUs * int64(newCapacity)
+	existing := oldSku.vCPUs * int64(oldCapacity)
+
+	// the total vCPUs within the Azure Region
+	if err := r.validateUsage(field, "cores", "Total Regional vCPUs", required-existing, newName, newCapacity); err != nil {
+		return err
+	}
+
+	// and the vCPUs available for this family of Virtual Machine SKUs
+	if newSku.family == "" {
+		return nil
+	}
+	if !strings.EqualFold(oldSku.family, newSku.family) {
+		existing = 0
+	}
+	return r.validateUsage(field, newSku.family, newSku.family, required-existing, newName, newCapacity)
+}
+
+func (r regionAvailability) validateUsage(field, usageName, description string, additional int64, skuName string, capacity int) error {
+	if additional <= 0 {
+		return nil
+	}
+
+	usage, ok := r.usages[strings.ToLower(usageName)]
+	if !ok {
+		return nil
+	}
+
+	available := usage.limit - usage.current
+	if additional > available {
+		return fmt.Errorf("`%s`: %d x %q requires %d additional vCPUs but only %d of the %d vCPUs within the %q quota are available in the location %q - a quota increase can be requested from the Azure Portal", field, capacity, skuName, additional, available, usage.limit, description, r.location)
+	}
+
+	return nil
+}
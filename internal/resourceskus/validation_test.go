@@ -0,0 +1,214 @@
+package resourceskus
+
+import (
+	"testing"
+)
+
+func testRegionAvailability() regionAvailability {
+	return regionAvailability{
+		location: "westeurope",
+		skus: map[string]map[string]sku{
+			"virtualmachines": {
+				"standard_d2s_v3": {
+					name:   "Standard_D2s_v3",
+					family: "standardDSv3Family",
+					vCPUs:  2,
+					zones:  []string{"1", "2", "3"},
+				},
+				"standard_d4s_v3": {
+					name:   "Standard_D4s_v3",
+					family: "standardDSv3Family",
+					vCPUs:  4,
+					zones:  []string{"1", "2"},
+				},
+				"standard_f2s_v2": {
+					name:   "Standard_F2s_v2",
+					family: "standardFSv2Family",
+					vCPUs:  2,
+					zones:  []string{},
+				},
+				"standard_m8ms": {
+					name:             "Standard_M8ms",
+					family:           "standardMSFamily",
+					vCPUs:            8,
+					restrictedReason: "NotAvailableForSubscription",
+				},
+			},
+			"disks": {
+				"premium_lrs": {
+					name:  "Premium_LRS",
+					zones: []string{"1", "2", "3"},
+				},
+			},
+		},
+		usages: map[string]usage{
+			"cores": {
+				name:    "cores",
+				current: 10,
+				limit:   20,
+			},
+			"standarddsv3family": {
+				name:    "standardDSv3Family",
+				current: 4,
+				limit:   10,
+			},
+		},
+	}
+}
+
+func TestValidateSku(t *testing.T) {
+	testData := []struct {
+		ResourceType string
+		Name         string
+		Zones        []string
+		Valid        bool
+	}{
+		{
+			ResourceType: ResourceTypeVirtualMachines,
+			Name:         "Standard_D2s_v3",
+			Valid:        true,
+		},
+		{
+			// casing differs
+			ResourceType: ResourceTypeVirtualMachines,
+			Name:         "standard_d2s_v3",
+			Zones:        []string{"3"},
+			Valid:        true,
+		},
+		{
+			ResourceType: ResourceTypeVirtualMachines,
+			Name:         "Standard_D4s_v3",
+			Zones:        []string{"1", "2"},
+			Valid:        true,
+		},
+		{
+			// not available in zone 3
+			ResourceType: ResourceTypeVirtualMachines,
+			Name:         "Standard_D4s_v3",
+			Zones:        []string{"3"},
+			Valid:        false,
+		},
+		{
+			// doesn't support zones
+			ResourceType: ResourceTypeVirtualMachines,
+			Name:         "Standard_F2s_v2",
+			Zones:        []string{"1"},
+			Valid:        false,
+		},
+		{
+			// restricted
+			ResourceType: ResourceTypeVirtualMachines,
+			Name:         "Standard_M8ms",
+			Valid:        false,
+		},
+		{
+			// not offered
+			ResourceType: ResourceTypeVirtualMachines,
+			Name:         "Standard_Z1",
+			Valid:        false,
+		},
+		{
+			ResourceType: ResourceTypeDisks,
+			Name:         "Premium_LRS",
+			Zones:        []string{"2"},
+			Valid:        true,
+		},
+		{
+			ResourceType: ResourceTypeDisks,
+			Name:         "UltraSSD_LRS",
+			Valid:        false,
+		},
+		{
+			// no SKUs were returned for this resource type, so this can't be validated
+			ResourceType: "hostGroups/hosts",
+			Name:         "DSv3-Type1",
+			Valid:        true,
+		},
+	}
+
+	availability := testRegionAvailability()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q (%s) in Zones %q..", v.Name, v.ResourceType, v.Zones)
+
+		err := availability.validateSku(v.ResourceType, "size", v.Name, v.Zones)
+		if v.Valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Name, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected %q to be invalid", v.Name)
+		}
+	}
+}
+
+func TestValidateQuota(t *testing.T) {
+	testData := []struct {
+		Description string
+		OldName     string
+		OldCapacity int
+		NewName     string
+		NewCapacity int
+		Valid       bool
+	}{
+		{
+			Description: "new within the family quota",
+			NewName:     "Standard_D2s_v3",
+			NewCapacity: 3,
+			Valid:       true,
+		},
+		{
+			Description: "new exceeding the family quota",
+			NewName:     "Standard_D2s_v3",
+			NewCapacity: 4,
+			Valid:       false,
+		},
+		{
+			Description: "new exceeding the regional quota",
+			NewName:     "Standard_F2s_v2",
+			NewCapacity: 6,
+			Valid:       false,
+		},
+		{
+			Description: "scaling up within the family quota",
+			OldName:     "Standard_D2s_v3",
+			OldCapacity: 2,
+			NewName:     "Standard_D4s_v3",
+			NewCapacity: 2,
+			Valid:       true,
+		},
+		{
+			Description: "scaling out exceeding the family quota",
+			OldName:     "Standard_D2s_v3",
+			OldCapacity: 2,
+			NewName:     "Standard_D2s_v3",
+			NewCapacity: 6,
+			Valid:       false,
+		},
+		{
+			Description: "scaling in",
+			OldName:     "Standard_D4s_v3",
+			OldCapacity: 10,
+			NewName:     "Standard_D4s_v3",
+			NewCapacity: 5,
+			Valid:       true,
+		},
+		{
+			Description: "unknown SKU",
+			NewName:     "Standard_Z1",
+			NewCapacity: 100,
+			Valid:       true,
+		},
+	}
+
+	availability := testRegionAvailability()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s..", v.Description)
+
+		err := availability.validateQuota("sku", v.OldName, v.OldCapacity, v.NewName, v.NewCapacity)
+		if v.Valid && err != nil {
+			t.Fatalf("expected %s to be valid but got: %+v", v.Description, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected %s to be invalid", v.Description)
+		}
+	}
+}
@@ -13,6 +13,14 @@ type DiagnosticsLogger struct {
 	diagnostics diag.Diagnostics
 }
 
+func (d *DiagnosticsLogger) Debug(message string) {
+	log.Printf("[DEBUG] %s", message)
+}
+
+func (d *DiagnosticsLogger) Debugf(format string, args ...interface{}) {
+	log.Printf("[DEBUG] "+format, args...)
+}
+
 func (d *DiagnosticsLogger) Info(message string) {
 	log.Printf("[INFO] %s", message)
 }
@@ -38,3 +46,14 @@ func (d *DiagnosticsLogger) Warnf(format string, args ...interface{}) {
 		AttributePath: nil,
 	})
 }
+
+// NOTE: Errors are only logged, since an Error Diagnostic would fail the operation - errors which
+// should fail the operation should be returned from the function instead
+
+func (d *DiagnosticsLogger) Error(message string) {
+	log.Printf("[ERROR] %s", message)
+}
+
+func (d *DiagnosticsLogger) Errorf(format string, args ...interface{}) {
+	log.Printf("[ERROR] "+format, args...)
+}
@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const (
+	// LogFieldResourceType is the key used for the Terraform Resource Type (e.g. `azurerm_resource_group`)
+	LogFieldResourceType = "resource_type"
+
+	// LogFieldResourceID is the key used for the Resource ID
+	LogFieldResourceID = "resource_id"
+
+	// LogFieldOperation is the key used for the operation being performed (e.g. `create`)
+	LogFieldOperation = "operation"
+
+	// LogFieldCorrelationRequestID is the key used for the `x-ms-correlation-request-id`
+	// header sent to Azure, which allows the requests to be traced on the Azure side
+	LogFieldCorrelationRequestID = "correlation_request_id"
+)
+
+const (
+	OperationCreate        = "create"
+	OperationRead          = "read"
+	OperationUpdate        = "update"
+	OperationDelete        = "delete"
+	OperationImport        = "import"
+	OperationCustomizeDiff = "customize_diff"
+)
+
+var _ Logger = StructuredLogger{}
+
+type logField struct {
+	key   string
+	value interface{}
+}
+
+// StructuredLogger provides a Logger implementation which appends a set of key/value
+// fields to each message, so that all of the log lines for a single resource (or
+// operation) can be filtered out of the log - for example:
+//
+//	[INFO] creating Resource Group: resource_type="azurerm_resource_group" operation="create"
+//
+// Fields with an empty value are omitted - and a field whose value is a `func() string`
+// is evaluated each time a message is logged, which allows values which change during an
+// operation (such as the Resource ID during a Create) to be included once they're known.
+type StructuredLogger struct {
+	fields []logField
+
+	// parent is an optional Logger which Warnings are also sent to, verbatim, so that
+	// (for example) these can be surfaced to the user as Diagnostics
+	parent Logger
+}
+
+// NewStructuredLogger returns a StructuredLogger which sends any Warnings to the
+// specified parent Logger, which can be nil
+func NewStructuredLogger(parent Logger) StructuredLogger {
+	return StructuredLogger{
+		parent: parent,
+	}
+}
+
+// With returns a copy of this StructuredLogger including the specified key/value field,
+// replacing any existing value for this key
+func (l StructuredLogger) With(key string, value interface{}) StructuredLogger {
+	fields := make([]logField, 0, len(l.fields)+1)
+	for _, v := range l.fields {
+		if v.key != key {
+			fields = append(fields, v)
+		}
+	}
+	fields = append(fields, logField{
+		key:   key,
+		value: value,
+	})
+
+	return StructuredLogger{
+		fields: fields,
+		parent: l.parent,
+	}
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l StructuredLogger) Debug(message string) {
+	l.print("DEBUG", message)
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l StructuredLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
+// Info prints out a message prefixed with `[INFO]` verbatim
+func (l StructuredLogger) Info(message string) {
+	l.print("INFO", message)
+}
+
+// Infof prints out a message prefixed with `[INFO]` formatted
+// with the specified arguments
+func (l StructuredLogger) Infof(format string, args ...interface{}) {
+	l.Info(fmt.Sprintf(format, args...))
+}
+
+// Warn prints out a message prefixed with `[WARN]` formatted verbatim
+func (l StructuredLogger) Warn(message string) {
+	l.print("WARN", message)
+	if l.parent != nil {
+		l.parent.Warn(message)
+	}
+}
+
+// Warnf prints out a message prefixed with `[WARN]` formatted
+// with the specified arguments
+func (l StructuredLogger) Warnf(format string, args ...interface{}) {
+	l.Warn(fmt.Sprintf(format, args...))
+}
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l StructuredLogger) Error(message string) {
+	l.print("ERROR", message)
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l StructuredLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+func (l StructuredLogger) print(level, message string) {
+	log.Print(l.format(level, message))
+}
+
+func (l StructuredLogger) format(level, message string) string {
+	fields := make([]string, 0, len(l.fields))
+	for _, v := range l.fields {
+		value := v.value
+		if f, ok := value.(func() string); ok {
+			value = f()
+		}
+
+		str := fmt.Sprintf("%v", value)
+		if value == nil || str == "" {
+			continue
+		}
+		fields = append(fields, fmt.Sprintf("%s=%q", v.key, str))
+	}
+
+	if len(fields) == 0 {
+		return fmt.Sprintf("[%s] %s", level, message)
+	}
+
+	return fmt.Sprintf("[%s] %s: %s", level, message, strings.Join(fields, " "))
+}
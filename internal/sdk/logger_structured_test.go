@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStructuredLogger(t *testing.T) {
+	resourceId := ""
+	logger := NewStructuredLogger(nil).
+		With(LogFieldResourceType, "azurerm_example").
+		With(LogFieldResourceID, func() string { return resourceId }).
+		With(LogFieldOperation, OperationCreate).
+		With(LogFieldCorrelationRequestID, "")
+
+	testData := []struct {
+		Name     string
+		Log      func()
+		Expected string
+	}{
+		{
+			Name: "Debug without the Resource ID",
+			Log: func() {
+				logger.Debugf("creating %q..", "example")
+			},
+			Expected: `[DEBUG] creating "example"..: resource_type="azurerm_example" operation="create"`,
+		},
+		{
+			Name: "Info with the Resource ID",
+			Log: func() {
+				resourceId = "/subscriptions/1234"
+				logger.Info("created")
+			},
+			Expected: `[INFO] created: resource_type="azurerm_example" resource_id="/subscriptions/1234" operation="create"`,
+		},
+		{
+			Name: "Error with the Operation overridden",
+			Log: func() {
+				logger.With(LogFieldOperation, OperationRead).Errorf("reading %d", 1)
+			},
+			Expected: `[ERROR] reading 1: resource_type="azurerm_example" resource_id="/subscriptions/1234" operation="read"`,
+		},
+		{
+			Name: "No Fields",
+			Log: func() {
+				NewStructuredLogger(nil).Warn("hello")
+			},
+			Expected: `[WARN] hello`,
+		},
+	}
+
+	buf := bytes.Buffer{}
+	log.SetOutput(&buf)
+	flags := log.Flags()
+	log.SetFlags(0)
+	defer func() {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(flags)
+	}()
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		buf.Reset()
+		v.Log()
+		actual := strings.TrimSpace(buf.String())
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestStructuredLoggerWarningsAreSentToTheParent(t *testing.T) {
+	parent := &DiagnosticsLogger{}
+	logger := NewStructuredLogger(parent).With(LogFieldResourceType, "azurerm_example")
+
+	logger.Infof("not a %s", "warning")
+	logger.Warnf("a %s", "warning")
+
+	if len(parent.diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic but got %d", len(parent.diagnostics))
+	}
+	if parent.diagnostics[0].Summary != "a warning" {
+		t.Fatalf("expected the diagnostic to be %q but got %q", "a warning", parent.diagnostics[0].Summary)
+	}
+}
@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	// pendingOperationKey is the key within the Private State containing the Long Running Operation which is in progress
+	pendingOperationKey = "pending_operation"
+
+	// pendingPollerOperationKey is the key within the Private State denoting that a Long Running Operation being polled
+	// for using a poller (rather than a future) is in progress
+	pendingPollerOperationKey = "pending_poller_operation"
+)
+
+// WaitForLongRunningOperation waits for the Long Running Operation to complete. If the timeout is reached (or
+// Terraform is cancelled) before the operation completes, then the Resource is persisted into the State with the
+// operation in the Private State, so that polling can be resumed during the next Terraform run (rather than the
+// Resource needing to be imported) - in which case an OperationInProgressError is returned.
+func WaitForLongRunningOperation(ctx context.Context, d *pluginsdk.ResourceData, id resourceid.Formatter, future azure.FutureAPI, client autorest.Client) error {
+	err := future.WaitForCompletionRef(ctx, client)
+	if err == nil || !timedOut(ctx, err) {
+		pluginsdk.RemovePrivateState(d, pendingOperationKey)
+		return err
+	}
+
+	raw, marshalErr := future.MarshalJSON()
+	if marshalErr != nil {
+		log.Printf("[DEBUG] serializing the pending operation for %s: %+v", id.ID(), marshalErr)
+		return err
+	}
+
+	return persistPendingOperation(d, id, pendingOperationKey, json.RawMessage(raw), fmt.Sprintf("polling %q", future.PollingURL()), err)
+}
+
+// WaitForLongRunningOperationUsingPoller waits for the Long Running Operation to complete using the specified poller,
+// for Resources which poll for completion themselves (e.g. by checking the Provisioning State) rather than using the
+// future returned from the API - and otherwise behaves the same as WaitForLongRunningOperation.
+func WaitForLongRunningOperationUsingPoller(ctx context.Context, d *pluginsdk.ResourceData, id resourceid.Formatter, poller func(ctx context.Context) error) error {
+	err := poller(ctx)
+	if err == nil || !timedOut(ctx, err) {
+		pluginsdk.RemovePrivateState(d, pendingPollerOperationKey)
+		return err
+	}
+
+	return persistPendingOperation(d, id, pendingPollerOperationKey, id.ID(), "polling the Resource", err)
+}
+
+// ResumeLongRunningOperation resumes polling the Long Running Operation which was in progress for this Resource
+// during a previous Terraform run (see WaitForLongRunningOperation), if any. An OperationInProgressError is returned
+// when the operation is still in progress - if the operation failed the error is logged, since retrieving the
+// Resource will reflect its current state.
+func ResumeLongRunningOperation(ctx context.Context, d *pluginsdk.ResourceData, id resourceid.Formatter, client autorest.Client) error {
+	var raw json.RawMessage
+	exists, err := pluginsdk.GetPrivateState(d, pendingOperationKey, &raw)
+	if err != nil {
+		log.Printf("[DEBUG] retrieving the pending operation for %s: %+v", id.ID(), err)
+		pluginsdk.RemovePrivateState(d, pendingOperationKey)
+		return nil
+	}
+	if !exists {
+		return nil
+	}
+
+	var future azure.Future
+	if err := future.UnmarshalJSON(raw); err != nil {
+		log.Printf("[DEBUG] deserializing the pending operation for %s: %+v", id.ID(), err)
+		pluginsdk.RemovePrivateState(d, pendingOperationKey)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Resuming the pending operation for %s (polling %q)..", id.ID(), future.PollingURL())
+	if err := WaitForLongRunningOperation(ctx, d, id, &future, client); err != nil {
+		if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+			return err
+		}
+
+		log.Printf("[DEBUG] the pending operation for %s failed: %+v", id.ID(), err)
+	}
+
+	return nil
+}
+
+// ResumeLongRunningOperationUsingPoller resumes polling for the Long Running Operation which was in progress for this
+// Resource during a previous Terraform run using the specified poller (see WaitForLongRunningOperationUsingPoller),
+// if any - and otherwise behaves the same as ResumeLongRunningOperation.
+func ResumeLongRunningOperationUsingPoller(ctx context.Context, d *pluginsdk.ResourceData, id resourceid.Formatter, poller func(ctx context.Context) error) error {
+	var pendingId string
+	exists, err := pluginsdk.GetPrivateState(d, pendingPollerOperationKey, &pendingId)
+	if err != nil {
+		log.Printf("[DEBUG] retrieving the pending operation for %s: %+v", id.ID(), err)
+		pluginsdk.RemovePrivateState(d, pendingPollerOperationKey)
+		return nil
+	}
+	if !exists {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Resuming the pending operation for %s..", id.ID())
+	if err := WaitForLongRunningOperationUsingPoller(ctx, d, id, poller); err != nil {
+		if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+			return err
+		}
+
+		log.Printf("[DEBUG] the pending operation for %s failed: %+v", id.ID(), err)
+	}
+
+	return nil
+}
+
+// timedOut returns whether the timeout was reached (or Terraform was cancelled) whilst waiting for the Long Running
+// Operation, rather than the operation failing
+func timedOut(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+
+	// pollers using a StateChangeConf can time out marginally before the context does
+	_, ok := err.(*resource.TimeoutError)
+	return ok
+}
+
+// persistPendingOperation persists the pending Long Running Operation into the Private State so that it can be
+// resumed during the next Terraform run, returning an OperationInProgressError - or `err` should the Private State
+// be unavailable
+func persistPendingOperation(d *pluginsdk.ResourceData, id resourceid.Formatter, key string, value interface{}, description string, err error) error {
+	persisted, setErr := pluginsdk.SetPrivateState(d, key, value)
+	if setErr != nil || !persisted {
+		// the Private State is unavailable, so this operation can't be resumed
+		return err
+	}
+
+	d.SetId(id.ID())
+	return pluginsdk.OperationInProgressError{
+		Message: fmt.Sprintf("the operation for %s is still in progress and will be resumed during the next Terraform run (%s): %+v", id.ID(), description, err),
+	}
+}
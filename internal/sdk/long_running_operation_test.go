@@ -0,0 +1,147 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type testLongRunningOperationId struct{}
+
+func (testLongRunningOperationId) ID() string {
+	return "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+}
+
+// testLongRunningOperationServer is a ProviderServer which invokes the specified functions, to exercise
+// the Private State made available by NewProviderServerWithPrivateState - as with the Plugin SDK any error
+// is returned as a Diagnostic, so this is exposed via `err`
+type testLongRunningOperationServer struct {
+	tfprotov5.ProviderServer
+
+	apply func(ctx context.Context, d *pluginsdk.ResourceData) error
+	read  func(ctx context.Context, d *pluginsdk.ResourceData) error
+	err   *error
+}
+
+func (s testLongRunningOperationServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	d := &pluginsdk.ResourceData{}
+	*s.err = pluginsdk.WithPrivateState(ctx, d, func() error {
+		return s.apply(ctx, d)
+	})
+	return &tfprotov5.ApplyResourceChangeResponse{
+		Private: req.PlannedPrivate,
+	}, nil
+}
+
+func (s testLongRunningOperationServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	d := &pluginsdk.ResourceData{}
+	*s.err = pluginsdk.WithPrivateState(ctx, d, func() error {
+		return s.read(ctx, d)
+	})
+	return &tfprotov5.ReadResourceResponse{
+		Private: req.Private,
+	}, nil
+}
+
+func TestResumeLongRunningOperationUsingPoller(t *testing.T) {
+	id := testLongRunningOperationId{}
+	resumed := false
+	var err error
+	server := pluginsdk.NewProviderServerWithPrivateState(testLongRunningOperationServer{
+		err: &err,
+		apply: func(ctx context.Context, d *pluginsdk.ResourceData) error {
+			// the operation is still in progress when the timeout is reached
+			return WaitForLongRunningOperationUsingPoller(ctx, d, id, func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			})
+		},
+		read: func(ctx context.Context, d *pluginsdk.ResourceData) error {
+			return ResumeLongRunningOperationUsingPoller(ctx, d, id, func(ctx context.Context) error {
+				resumed = true
+				return nil
+			})
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	applyResp, _ := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		PlannedPrivate: []byte(`{"schema_version":"0"}`),
+	})
+	if _, ok := err.(pluginsdk.OperationInProgressError); !ok {
+		t.Fatalf("expected an OperationInProgressError but got %+v", err)
+	}
+	if err := expectPendingOperation(applyResp.Private, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// which is then resumed (and completes) during the next Terraform run
+	readResp, _ := server.ReadResource(context.TODO(), &tfprotov5.ReadResourceRequest{
+		Private: applyResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("resuming the operation: %+v", err)
+	}
+	if !resumed {
+		t.Fatalf("expected the operation to be resumed")
+	}
+	if err := expectPendingOperation(readResp.Private, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// after which there's nothing to resume
+	resumed = false
+	_, _ = server.ReadResource(context.TODO(), &tfprotov5.ReadResourceRequest{
+		Private: readResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("retrieving: %+v", err)
+	}
+	if resumed {
+		t.Fatalf("expected the completed operation not to be resumed")
+	}
+}
+
+func TestWaitForLongRunningOperationUsingPollerFailed(t *testing.T) {
+	id := testLongRunningOperationId{}
+	var err error
+	server := pluginsdk.NewProviderServerWithPrivateState(testLongRunningOperationServer{
+		err: &err,
+		apply: func(ctx context.Context, d *pluginsdk.ResourceData) error {
+			return WaitForLongRunningOperationUsingPoller(ctx, d, id, func(ctx context.Context) error {
+				return fmt.Errorf("provisioning failed")
+			})
+		},
+	})
+
+	resp, _ := server.ApplyResourceChange(context.TODO(), &tfprotov5.ApplyResourceChangeRequest{
+		PlannedPrivate: []byte(`{"schema_version":"0","azurerm_pending_poller_operation":"existing"}`),
+	})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+		t.Fatalf("expected the failure to be returned but got %+v", err)
+	}
+	if err := expectPendingOperation(resp.Private, false); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectPendingOperation(raw []byte, expected bool) error {
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return fmt.Errorf("decoding the Private State: %+v", err)
+	}
+
+	if _, exists := values["azurerm_pending_poller_operation"]; exists != expected {
+		return fmt.Errorf("expected the pending operation to exist to be %t but got %+v", expected, values)
+	}
+	return nil
+}
@@ -73,7 +73,7 @@ type StateUpgradeData struct {
 	Upgraders     map[int]pluginsdk.StateUpgrade
 }
 
-// TODO: a generic state migration for updating ID's
+// NOTE: a generic State Upgrade for updating Resource ID's is available as `IDRewriteStateUpgrade`
 
 type ResourceWithCustomImporter interface {
 	Resource
@@ -162,13 +162,16 @@ type ResourceMetaData struct {
 	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
 	ResourceDiff *schema.ResourceDiff
 
+	// resourceSchema is the Schema for this Resource, used to determine which fields can be set via EncodeDiff
+	resourceSchema map[string]*schema.Schema
+
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
 func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceid.Formatter) error {
-	rmd.Logger.Infof("[DEBUG] %s was not found - removing from state", idFormatter)
+	rmd.Logger.Debugf("%s was not found - removing from state", idFormatter)
 	rmd.ResourceData.SetId("")
 	return nil
 }
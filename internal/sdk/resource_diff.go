@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EncodeDiff will encode the specified object into the Terraform Plan, in the same
+// manner as Encode, but using the ResourceDiff as a destination. Intended for use
+// in CustomizeDiff functions.
+//
+// NOTE: the Plugin SDK only allows values to be set during a Plan for Computed fields,
+// as such any fields in the model which aren't Computed are skipped, since these are
+// determined by the users configuration.
+func (rmd ResourceMetaData) EncodeDiff(input interface{}) error {
+	if rmd.ResourceDiff == nil {
+		return fmt.Errorf("ResourceDiff was nil")
+	}
+
+	if reflect.TypeOf(input).Kind() != reflect.Ptr {
+		return fmt.Errorf("need a pointer")
+	}
+
+	objType := reflect.TypeOf(input).Elem()
+	objVal := reflect.ValueOf(input).Elem()
+
+	fieldName := reflect.ValueOf(input).Elem().String()
+	serialized, err := recurse(objType, objVal, fieldName, rmd.serializationDebugLogger)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range serialized {
+		if s, ok := rmd.resourceSchema[k]; !ok || !s.Computed {
+			rmd.serializationDebugLogger.Infof("Skipping %q since it's not a Computed field", k)
+			continue
+		}
+
+		if err := rmd.ResourceDiff.SetNew(k, v); err != nil {
+			return fmt.Errorf("setting %q: %+v", k, err)
+		}
+	}
+	return nil
+}
+
+// ForceNewIfChanged marks the specified field as requiring this resource to be
+// recreated, when the value for this field has changed. Intended for use in
+// CustomizeDiff functions.
+func (rmd ResourceMetaData) ForceNewIfChanged(field string) error {
+	if rmd.ResourceDiff == nil {
+		return fmt.Errorf("ResourceDiff was nil")
+	}
+
+	if !rmd.ResourceDiff.HasChange(field) {
+		return nil
+	}
+
+	if err := rmd.ResourceDiff.ForceNew(field); err != nil {
+		return fmt.Errorf("marking %q as ForceNew: %+v", field, err)
+	}
+	return nil
+}
+
+// SetNewComputed marks the specified Computed field as being known only after
+// the apply. Intended for use in CustomizeDiff functions.
+func (rmd ResourceMetaData) SetNewComputed(field string) error {
+	if rmd.ResourceDiff == nil {
+		return fmt.Errorf("ResourceDiff was nil")
+	}
+
+	if err := rmd.ResourceDiff.SetNewComputed(field); err != nil {
+		return fmt.Errorf("marking %q as Computed: %+v", field, err)
+	}
+	return nil
+}
+
+// ClearDiff removes any pending changes for the specified Computed field from the
+// Plan, meaning that the existing value is retained. Intended for use in CustomizeDiff
+// functions.
+func (rmd ResourceMetaData) ClearDiff(field string) error {
+	if rmd.ResourceDiff == nil {
+		return fmt.Errorf("ResourceDiff was nil")
+	}
+
+	if err := rmd.ResourceDiff.Clear(field); err != nil {
+		return fmt.Errorf("clearing the diff for %q: %+v", field, err)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type diffTestModel struct {
+	Name        string `tfschema:"name"`
+	Size        string `tfschema:"size"`
+	Description string `tfschema:"description"`
+	Endpoint    string `tfschema:"endpoint"`
+	Version     string `tfschema:"version"`
+}
+
+type diffTestResource struct {
+	customizeDiff ResourceRunFunc
+}
+
+var _ ResourceWithCustomizeDiff = diffTestResource{}
+
+func (r diffTestResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"size": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func (r diffTestResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"endpoint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r diffTestResource) ModelObject() interface{} {
+	return &diffTestModel{}
+}
+
+func (r diffTestResource) ResourceType() string {
+	return "validator_diff"
+}
+
+func (r diffTestResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r diffTestResource) Read() ResourceFunc {
+	return r.Create()
+}
+
+func (r diffTestResource) Delete() ResourceFunc {
+	return r.Create()
+}
+
+func (r diffTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+func (r diffTestResource) CustomizeDiff() ResourceFunc {
+	return ResourceFunc{
+		Func:    r.customizeDiff,
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func runDiffTest(t *testing.T, customizeDiff ResourceRunFunc, state map[string]string, config map[string]interface{}) *terraform.InstanceDiff {
+	wrapper := NewResourceWrapper(diffTestResource{
+		customizeDiff: customizeDiff,
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	instanceState := &terraform.InstanceState{
+		ID:         "some-id",
+		Attributes: state,
+	}
+	diff, err := resource.SimpleDiff(context.TODO(), instanceState, terraform.NewResourceConfigRaw(config), &clients.Client{})
+	if err != nil {
+		t.Fatalf("running diff: %+v", err)
+	}
+	return diff
+}
+
+func TestResourceMetaDataEncodeDiff(t *testing.T) {
+	diff := runDiffTest(t, func(ctx context.Context, metadata ResourceMetaData) error {
+		var model diffTestModel
+		if err := metadata.DecodeDiff(&model); err != nil {
+			return err
+		}
+
+		model.Description = fmt.Sprintf("%s (%s)", model.Name, model.Size)
+		model.Endpoint = fmt.Sprintf("https://%s.example.com", model.Name)
+		return metadata.EncodeDiff(&model)
+	}, map[string]string{
+		"id":   "some-id",
+		"name": "hello",
+		"size": "small",
+	}, map[string]interface{}{
+		"name": "hello",
+		"size": "large",
+	})
+
+	expected := map[string]string{
+		"description": "hello (large)",
+		"endpoint":    "https://hello.example.com",
+		"size":        "large",
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok {
+			t.Fatalf("expected a diff for %q but didn't get one", k)
+		}
+		if attr.New != v {
+			t.Fatalf("expected the new value for %q to be %q but got %q", k, v, attr.New)
+		}
+	}
+}
+
+func TestResourceMetaDataForceNewIfChanged(t *testing.T) {
+	customizeDiff := func(ctx context.Context, metadata ResourceMetaData) error {
+		return metadata.ForceNewIfChanged("size")
+	}
+	state := map[string]string{
+		"id":   "some-id",
+		"name": "hello",
+		"size": "small",
+	}
+
+	diff := runDiffTest(t, customizeDiff, state, map[string]interface{}{
+		"name": "hello",
+		"size": "small",
+	})
+	if diff != nil && diff.RequiresNew() {
+		t.Fatalf("expected no replacement when `size` is unchanged")
+	}
+
+	diff = runDiffTest(t, customizeDiff, state, map[string]interface{}{
+		"name": "hello",
+		"size": "large",
+	})
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected a replacement when `size` is changed")
+	}
+}
+
+func TestResourceMetaDataSetNewComputed(t *testing.T) {
+	diff := runDiffTest(t, func(ctx context.Context, metadata ResourceMetaData) error {
+		if metadata.ResourceDiff.HasChange("size") {
+			return metadata.SetNewComputed("version")
+		}
+		return nil
+	}, map[string]string{
+		"id":      "some-id",
+		"name":    "hello",
+		"size":    "small",
+		"version": "1",
+	}, map[string]interface{}{
+		"name": "hello",
+		"size": "large",
+	})
+
+	attr, ok := diff.Attributes["version"]
+	if !ok {
+		t.Fatalf("expected a diff for `version` but didn't get one")
+	}
+	if !attr.NewComputed {
+		t.Fatalf("expected `version` to be marked as computed")
+	}
+}
+
+func TestResourceMetaDataClearDiff(t *testing.T) {
+	diff := runDiffTest(t, func(ctx context.Context, metadata ResourceMetaData) error {
+		return metadata.ClearDiff("description")
+	}, map[string]string{
+		"id":          "some-id",
+		"name":        "hello",
+		"description": "first",
+	}, map[string]interface{}{
+		"name":        "hello",
+		"description": "second",
+	})
+
+	if diff != nil {
+		if _, ok := diff.Attributes["description"]; ok {
+			t.Fatalf("expected the diff for `description` to be cleared")
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// The Schema for a Resource/Data Source can optionally be generated from the Model Object,
+// using the following struct tags in addition to the `tfschema` tag:
+//
+//   required:"true"    - the field must be specified by the user
+//   optional:"true"    - the field can optionally be specified by the user
+//   computed:"true"    - the field is returned by the API (and can be combined with `optional`)
+//   forcenew:"true"    - changing this field requires the resource be recreated
+//   sensitive:"true"   - the value of this field should be hidden in the output
+//   set:"true"         - the slice should be represented as a Set rather than a List
+//   maxitems:"1"       - the maximum number of items within a List/Set
+//   validation:"uuid"  - the name of a validation function registered via RegisterModelValidationFunc
+//
+// Fields which require more complex behaviour (for example a custom DiffSuppressFunc, or the
+// shared `tags` schema) can be overridden in the map returned from ArgumentsFromModel.
+
+var (
+	modelValidationFuncs = map[string]schema.SchemaValidateFunc{
+		"cidr":                   validation.IsCIDR,
+		"ip_address":             validation.IsIPAddress,
+		"json":                   validation.StringIsJSON,
+		"no_zero_values":         validation.NoZeroValues,
+		"port_number":            validation.IsPortNumber,
+		"rfc3339_time":           validation.IsRFC3339Time,
+		"string_is_not_empty":    validation.StringIsNotEmpty,
+		"url_with_http_or_https": validation.IsURLWithHTTPorHTTPS,
+		"url_with_https":         validation.IsURLWithHTTPS,
+		"uuid":                   validation.IsUUID,
+	}
+	modelValidationFuncsLock = &sync.RWMutex{}
+)
+
+// RegisterModelValidationFunc registers a validation function which can be referenced using
+// the `validation` struct tag when generating a Schema from a Model Object
+func RegisterModelValidationFunc(name string, validateFunc schema.SchemaValidateFunc) {
+	modelValidationFuncsLock.Lock()
+	defer modelValidationFuncsLock.Unlock()
+
+	if _, exists := modelValidationFuncs[name]; exists {
+		panic(fmt.Sprintf("a validation function named %q has already been registered", name))
+	}
+	modelValidationFuncs[name] = validateFunc
+}
+
+// ArgumentsFromModel returns the user-configurable (Required, Optional or Optional & Computed)
+// fields for the specified Model Object, generated from the struct tags on the model
+//
+// NOTE: this panics if the model is invalid, since this is a programming error which should
+// be caught when the provider is built
+func ArgumentsFromModel(model interface{}) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema)
+	for k, v := range mustSchemaFromModel(model) {
+		if v.Required || v.Optional {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// AttributesFromModel returns the read-only (Computed) fields for the specified Model Object,
+// generated from the struct tags on the model
+//
+// NOTE: this panics if the model is invalid, since this is a programming error which should
+// be caught when the provider is built
+func AttributesFromModel(model interface{}) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema)
+	for k, v := range mustSchemaFromModel(model) {
+		if !v.Required && !v.Optional {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// SchemaFromModel generates the Schema for the specified Model Object from the struct tags on the model
+func SchemaFromModel(model interface{}) (map[string]*schema.Schema, error) {
+	if model == nil {
+		return nil, fmt.Errorf("model was nil")
+	}
+
+	if reflect.TypeOf(model).Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("need a pointer to the model object")
+	}
+
+	objType := reflect.TypeOf(model).Elem()
+	if objType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("the model object must be a struct but got %s", objType.Kind())
+	}
+
+	return schemaFromModelRecursively("", objType)
+}
+
+func mustSchemaFromModel(model interface{}) map[string]*schema.Schema {
+	out, err := SchemaFromModel(model)
+	if err != nil {
+		panic(fmt.Sprintf("generating Schema from Model %T: %+v", model, err))
+	}
+	return out
+}
+
+func schemaFromModelRecursively(prefix string, objType reflect.Type) (map[string]*schema.Schema, error) {
+	out := make(map[string]*schema.Schema)
+
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		tfschemaTag, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			return nil, fmt.Errorf("field %q is missing an `tfschema` label", field.Name)
+		}
+		fieldName := tfschemaTag
+		if prefix != "" {
+			fieldName = fmt.Sprintf("%s.%s", prefix, tfschemaTag)
+		}
+
+		fieldSchema, err := schemaForModelField(fieldName, field)
+		if err != nil {
+			return nil, err
+		}
+		out[tfschemaTag] = fieldSchema
+	}
+
+	return out, nil
+}
+
+func schemaForModelField(fieldName string, field reflect.StructField) (*schema.Schema, error) {
+	out := schema.Schema{
+		Required:  boolTag(field, "required"),
+		Optional:  boolTag(field, "optional"),
+		Computed:  boolTag(field, "computed"),
+		ForceNew:  boolTag(field, "forcenew"),
+		Sensitive: boolTag(field, "sensitive"),
+	}
+
+	if !out.Required && !out.Optional && !out.Computed {
+		return nil, fmt.Errorf("field %q must be tagged as one of `required`, `optional` or `computed`", fieldName)
+	}
+	if out.Required && (out.Optional || out.Computed) {
+		return nil, fmt.Errorf("field %q is `required` and so cannot be `optional` or `computed`", fieldName)
+	}
+	if out.ForceNew && !out.Required && !out.Optional {
+		return nil, fmt.Errorf("field %q is Computed-only and so cannot be `forcenew`", fieldName)
+	}
+
+	fieldType := field.Type
+	switch fieldType.Kind() {
+	case reflect.Slice:
+		out.Type = schema.TypeList
+		if boolTag(field, "set") {
+			out.Type = schema.TypeSet
+		}
+		if v, ok := field.Tag.Lookup("maxitems"); ok {
+			maxItems, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `maxitems` for field %q: %+v", fieldName, err)
+			}
+			out.MaxItems = maxItems
+		}
+
+		innerType := fieldType.Elem()
+		if innerType.Kind() == reflect.Struct {
+			nested, err := schemaFromModelRecursively(fieldName, innerType)
+			if err != nil {
+				return nil, err
+			}
+			out.Elem = &schema.Resource{
+				Schema: nested,
+			}
+		} else {
+			elemType, err := schemaTypeForKind(innerType.Kind())
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %+v", fieldName, err)
+			}
+			out.Elem = &schema.Schema{
+				Type: elemType,
+			}
+		}
+
+	case reflect.Map:
+		if fieldType.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("field %q must be a map with a string key", fieldName)
+		}
+		out.Type = schema.TypeMap
+
+		elemType, err := schemaTypeForKind(fieldType.Elem().Kind())
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %+v", fieldName, err)
+		}
+		out.Elem = &schema.Schema{
+			Type: elemType,
+		}
+
+	default:
+		schemaType, err := schemaTypeForKind(fieldType.Kind())
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %+v", fieldName, err)
+		}
+		out.Type = schemaType
+	}
+
+	if name, ok := field.Tag.Lookup("validation"); ok {
+		modelValidationFuncsLock.RLock()
+		validateFunc, exists := modelValidationFuncs[name]
+		modelValidationFuncsLock.RUnlock()
+		if !exists {
+			return nil, fmt.Errorf("field %q references the validation function %q which hasn't been registered", fieldName, name)
+		}
+		if out.Type == schema.TypeList || out.Type == schema.TypeSet || out.Type == schema.TypeMap {
+			elem, ok := out.Elem.(*schema.Schema)
+			if !ok {
+				return nil, fmt.Errorf("field %q is a block and so cannot have a `validation` tag", fieldName)
+			}
+			elem.ValidateFunc = validateFunc
+		} else {
+			out.ValidateFunc = validateFunc
+		}
+	}
+
+	return &out, nil
+}
+
+func schemaTypeForKind(kind reflect.Kind) (schema.ValueType, error) {
+	switch kind {
+	case reflect.String:
+		return schema.TypeString, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return schema.TypeInt, nil
+	case reflect.Float32, reflect.Float64:
+		return schema.TypeFloat, nil
+	case reflect.Bool:
+		return schema.TypeBool, nil
+	}
+
+	return schema.TypeInvalid, fmt.Errorf("unsupported type %s", kind)
+}
+
+func boolTag(field reflect.StructField, name string) bool {
+	v, ok := field.Tag.Lookup(name)
+	if !ok {
+		return false
+	}
+	val, _ := strconv.ParseBool(v)
+	return val
+}
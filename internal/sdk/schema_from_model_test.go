@@ -0,0 +1,142 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSchemaFromModel(t *testing.T) {
+	type Rule struct {
+		Name     string   `tfschema:"name" required:"true"`
+		Priority int      `tfschema:"priority" optional:"true"`
+		Ranges   []string `tfschema:"ranges" optional:"true" set:"true" validation:"cidr"`
+	}
+	type Model struct {
+		Name      string            `tfschema:"name" required:"true" forcenew:"true" validation:"string_is_not_empty"`
+		Enabled   bool              `tfschema:"enabled" optional:"true"`
+		Sku       string            `tfschema:"sku" optional:"true" computed:"true"`
+		Secret    string            `tfschema:"secret" optional:"true" sensitive:"true"`
+		Rules     []Rule            `tfschema:"rule" optional:"true" maxitems:"5"`
+		Ratio     float64           `tfschema:"ratio" computed:"true"`
+		Endpoints []string          `tfschema:"endpoints" computed:"true"`
+		Labels    map[string]string `tfschema:"labels" optional:"true"`
+	}
+
+	arguments := ArgumentsFromModel(&Model{})
+	attributes := AttributesFromModel(&Model{})
+
+	expectedArguments := []string{"name", "enabled", "sku", "secret", "rule", "labels"}
+	if len(arguments) != len(expectedArguments) {
+		t.Fatalf("expected %d arguments but got %d", len(expectedArguments), len(arguments))
+	}
+	for _, k := range expectedArguments {
+		if _, ok := arguments[k]; !ok {
+			t.Fatalf("expected %q to be an argument", k)
+		}
+	}
+
+	expectedAttributes := []string{"ratio", "endpoints"}
+	if len(attributes) != len(expectedAttributes) {
+		t.Fatalf("expected %d attributes but got %d", len(expectedAttributes), len(attributes))
+	}
+	for _, k := range expectedAttributes {
+		if _, ok := attributes[k]; !ok {
+			t.Fatalf("expected %q to be an attribute", k)
+		}
+	}
+
+	name := arguments["name"]
+	if name.Type != schema.TypeString || !name.Required || !name.ForceNew || name.ValidateFunc == nil {
+		t.Fatalf("expected `name` to be a Required, ForceNew, validated string but got %+v", name)
+	}
+
+	sku := arguments["sku"]
+	if !sku.Optional || !sku.Computed {
+		t.Fatalf("expected `sku` to be Optional & Computed but got %+v", sku)
+	}
+
+	if !arguments["secret"].Sensitive {
+		t.Fatalf("expected `secret` to be Sensitive")
+	}
+
+	rule := arguments["rule"]
+	if rule.Type != schema.TypeList || rule.MaxItems != 5 {
+		t.Fatalf("expected `rule` to be a List with MaxItems of 5 but got %+v", rule)
+	}
+	ruleSchema := rule.Elem.(*schema.Resource).Schema
+	if ruleSchema["priority"].Type != schema.TypeInt {
+		t.Fatalf("expected `rule.priority` to be an int but got %s", ruleSchema["priority"].Type)
+	}
+	ranges := ruleSchema["ranges"]
+	if ranges.Type != schema.TypeSet || ranges.Elem.(*schema.Schema).Type != schema.TypeString || ranges.Elem.(*schema.Schema).ValidateFunc == nil {
+		t.Fatalf("expected `rule.ranges` to be a Set of validated strings but got %+v", ranges)
+	}
+
+	if attributes["ratio"].Type != schema.TypeFloat {
+		t.Fatalf("expected `ratio` to be a float but got %s", attributes["ratio"].Type)
+	}
+	if arguments["labels"].Type != schema.TypeMap {
+		t.Fatalf("expected `labels` to be a map but got %s", arguments["labels"].Type)
+	}
+
+	// the generated schema should be usable by the wrapper
+	combined, err := combineSchema(arguments, attributes)
+	if err != nil {
+		t.Fatalf("combining schema: %+v", err)
+	}
+	if err := ValidateModelObjectAgainstSchema(&Model{}, *combined); err != nil {
+		t.Fatalf("validating model against generated schema: %+v", err)
+	}
+}
+
+func TestSchemaFromModelInvalid(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Model interface{}
+	}{
+		{
+			Name: "missing mode",
+			Model: &struct {
+				Name string `tfschema:"name"`
+			}{},
+		},
+		{
+			Name: "required and optional",
+			Model: &struct {
+				Name string `tfschema:"name" required:"true" optional:"true"`
+			}{},
+		},
+		{
+			Name: "computed and forcenew",
+			Model: &struct {
+				Name string `tfschema:"name" computed:"true" forcenew:"true"`
+			}{},
+		},
+		{
+			Name: "unknown validation",
+			Model: &struct {
+				Name string `tfschema:"name" required:"true" validation:"does_not_exist"`
+			}{},
+		},
+		{
+			Name: "missing tfschema tag",
+			Model: &struct {
+				Name string `required:"true"`
+			}{},
+		},
+		{
+			Name: "unsupported type",
+			Model: &struct {
+				Name []interface{} `tfschema:"name" required:"true"`
+			}{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+		if _, err := SchemaFromModel(v.Model); err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", v.Name)
+		}
+	}
+}
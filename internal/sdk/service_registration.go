@@ -61,3 +61,16 @@ type UntypedServiceRegistrationWithAGitHubLabel interface {
 
 	AssociatedGitHubLabel() string
 }
+
+// ServiceRegistrationWithResourceProviders is a superset of either a TypedServiceRegistration or an
+// UntypedServiceRegistration, which specifies the Resource Providers (e.g. `Microsoft.Compute`)
+// required by the Resources within this Service Package - which are registered (if necessary)
+// prior to one of these Resources being created.
+//
+// NOTE: this is intentionally an optional interface, since not every Service Package requires
+// a Resource Provider to be registered
+type ServiceRegistrationWithResourceProviders interface {
+	Name() string
+
+	ResourceProviders() []string
+}
@@ -0,0 +1,167 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceIDParserFunc parses a Resource ID (which may be in a legacy format, e.g. using
+// different casing or segment names) into a Formatter which outputs the ID in the current format
+//
+// This is typically a thin wrapper around the `{Name}IDInsensitively` function within a
+// Service's `parse` package, for example:
+//
+//	func(input string) (resourceid.Formatter, error) {
+//	  return parse.ProfileIDInsensitively(input)
+//	}
+type ResourceIDParserFunc func(input string) (resourceid.Formatter, error)
+
+var _ pluginsdk.StateUpgrade = IDRewriteStateUpgrade{}
+
+// IDRewriteStateUpgrade is a generic State Upgrade which rewrites the Resource ID (and
+// optionally any other attributes containing Resource ID's) into the current format
+//
+// This is intended to be used within the `StateUpgraders` function for a Resource implementing
+// the `ResourceWithStateMigration` interface, for example:
+//
+//	func (r ExampleResource) StateUpgraders() sdk.StateUpgradeData {
+//	  return sdk.StateUpgradeData{
+//	    SchemaVersion: 1,
+//	    Upgraders: map[int]pluginsdk.StateUpgrade{
+//	      0: sdk.IDRewriteStateUpgrade{
+//	        OldSchema: migration.ExampleV0Schema(),
+//	        ParseID: func(input string) (resourceid.Formatter, error) {
+//	          return parse.ExampleIDInsensitively(input)
+//	        },
+//	      },
+//	    },
+//	  }
+//	}
+type IDRewriteStateUpgrade struct {
+	// OldSchema is a point-in-time reference to the Schema at the time of the previous version
+	OldSchema map[string]*pluginsdk.Schema
+
+	// ParseID parses the existing `id` field into a Formatter for the current ID format
+	ParseID ResourceIDParserFunc
+
+	// AttributeParsers is an optional map of other attributes containing Resource ID's, where
+	// the key is the path to the attribute and the value is the parser to use for that attribute.
+	//
+	// Nested attributes can be referenced using a `.` separator (e.g. `network_rule.subnet_id`)
+	// which is applied to each item within a List/Set - and where the attribute itself is a
+	// List/Set of strings each item is rewritten.
+	AttributeParsers map[string]ResourceIDParserFunc
+}
+
+func (u IDRewriteStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return u.OldSchema
+}
+
+func (u IDRewriteStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if u.ParseID == nil {
+			return rawState, fmt.Errorf("internal-error: `ParseID` must be specified for an IDRewriteStateUpgrade")
+		}
+
+		oldId, ok := rawState["id"].(string)
+		if !ok || oldId == "" {
+			return rawState, fmt.Errorf("the `id` field was missing from the existing state")
+		}
+		newId, err := rewriteResourceID(oldId, u.ParseID)
+		if err != nil {
+			return rawState, fmt.Errorf("parsing existing ID %q: %+v", oldId, err)
+		}
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
+		rawState["id"] = newId
+
+		for path, parser := range u.AttributeParsers {
+			segments := strings.Split(path, ".")
+			if err := rewriteResourceIDAttribute(rawState, segments, parser); err != nil {
+				return rawState, fmt.Errorf("updating the Resource ID's within %q: %+v", path, err)
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+// rewriteResourceIDAttribute traverses the raw state for the specified path, rewriting
+// any Resource ID's found at the end of that path
+func rewriteResourceIDAttribute(state map[string]interface{}, path []string, parser ResourceIDParserFunc) error {
+	key := path[0]
+	val, ok := state[key]
+	if !ok || val == nil {
+		return nil
+	}
+
+	if len(path) > 1 {
+		items, ok := val.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected %q to be a List or Set of blocks but got %T", key, val)
+		}
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			block, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("expected an item within %q to be a block but got %T", key, item)
+			}
+			if err := rewriteResourceIDAttribute(block, path[1:], parser); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch v := val.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		newId, err := rewriteResourceID(v, parser)
+		if err != nil {
+			return fmt.Errorf("parsing %q: %+v", v, err)
+		}
+		log.Printf("[DEBUG] Updating %q from %q to %q", key, v, newId)
+		state[key] = newId
+
+	case []interface{}:
+		for i, item := range v {
+			raw, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("expected the items within %q to be strings but got %T", key, item)
+			}
+			newId, err := rewriteResourceID(raw, parser)
+			if err != nil {
+				return fmt.Errorf("parsing %q: %+v", raw, err)
+			}
+			v[i] = newId
+		}
+
+	default:
+		return fmt.Errorf("expected %q to be a string or a List/Set of strings but got %T", key, val)
+	}
+
+	return nil
+}
+
+// rewriteResourceID returns the Resource ID in the current format, an empty value is
+// returned as-is since this is used for Optional fields
+func rewriteResourceID(input string, parser ResourceIDParserFunc) (string, error) {
+	if input == "" {
+		return input, nil
+	}
+
+	id, err := parser(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestIDRewriteStateUpgrade(t *testing.T) {
+	upgrade := IDRewriteStateUpgrade{
+		OldSchema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+		ParseID: func(input string) (resourceid.Formatter, error) {
+			return commonids.ParseResourceGroupIDInsensitively(input)
+		},
+		AttributeParsers: map[string]ResourceIDParserFunc{
+			"identity_id": func(input string) (resourceid.Formatter, error) {
+				return commonids.ParseUserAssignedIdentityIDInsensitively(input)
+			},
+			"identity.identity_ids": func(input string) (resourceid.Formatter, error) {
+				return commonids.ParseUserAssignedIdentityIDInsensitively(input)
+			},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+		Error    bool
+	}{
+		{
+			Name:  "missing id",
+			Input: map[string]interface{}{},
+			Error: true,
+		},
+		{
+			Name: "invalid id",
+			Input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012",
+			},
+			Error: true,
+		},
+		{
+			Name: "old id",
+			Input: map[string]interface{}{
+				"id":   "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+				"name": "group1",
+			},
+			Expected: map[string]interface{}{
+				"id":   "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+				"name": "group1",
+			},
+		},
+		{
+			Name: "new id",
+			Input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+			},
+			Expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+			},
+		},
+		{
+			Name: "old id with empty attributes",
+			Input: map[string]interface{}{
+				"id":          "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+				"identity_id": "",
+				"identity":    []interface{}{},
+			},
+			Expected: map[string]interface{}{
+				"id":          "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+				"identity_id": "",
+				"identity":    []interface{}{},
+			},
+		},
+		{
+			Name: "old id with attributes",
+			Input: map[string]interface{}{
+				"id":          "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+				"identity_id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/identity1",
+				"identity": []interface{}{
+					map[string]interface{}{
+						"type": "UserAssigned",
+						"identity_ids": []interface{}{
+							"/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1/providers/microsoft.managedidentity/userassignedidentities/identity1",
+							"/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity2",
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"id":          "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+				"identity_id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+				"identity": []interface{}{
+					map[string]interface{}{
+						"type": "UserAssigned",
+						"identity_ids": []interface{}{
+							"/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+							"/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity2",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid nested attribute",
+			Input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+				"identity": []interface{}{
+					map[string]interface{}{
+						"identity_ids": []interface{}{
+							"/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+						},
+					},
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := upgrade.UpgradeFunc()(context.TODO(), v.Input, nil)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -35,6 +35,9 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 		if err := ValidateModelObject(modelObj); err != nil {
 			return nil, fmt.Errorf("validating model for %q: %+v", dw.dataSource.ResourceType(), err)
 		}
+		if err := ValidateModelObjectAgainstSchema(modelObj, *resourceSchema); err != nil {
+			return nil, fmt.Errorf("validating model against the schema for %q: %+v", dw.dataSource.ResourceType(), err)
+		}
 	}
 
 	d := func(duration time.Duration) *time.Duration {
@@ -44,7 +47,7 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, dw.logger)
+			metaData := runArgs(d, meta, dw.operationLogger(OperationRead, d.Id, meta))
 			return dw.dataSource.Read().Func(ctx, metaData)
 		}),
 		Timeouts: &schema.ResourceTimeout{
@@ -55,6 +58,10 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	return &resource, nil
 }
 
+func (dw *DataSourceWrapper) operationLogger(operation string, resourceId func() string, meta interface{}) Logger {
+	return operationLogger(dw.logger, dw.dataSource.ResourceType(), operation, resourceId, meta)
+}
+
 func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
 	return diagnosticsWrapper(in, dw.logger)
 }
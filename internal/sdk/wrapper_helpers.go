@@ -55,3 +55,19 @@ func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) ResourceMe
 
 	return metaData
 }
+
+// operationLogger returns a Logger which includes the Resource Type, Resource ID, Operation and the
+// Correlation Request ID in each message, so that the lifecycle of a single resource can be filtered
+// out of the log. Any warnings are also sent to the parent Logger.
+func operationLogger(parent Logger, resourceType, operation string, resourceId func() string, meta interface{}) Logger {
+	logger := NewStructuredLogger(parent).
+		With(LogFieldResourceType, resourceType).
+		With(LogFieldResourceID, resourceId).
+		With(LogFieldOperation, operation)
+
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		logger = logger.With(LogFieldCorrelationRequestID, client.CorrelationRequestID)
+	}
+
+	return logger
+}
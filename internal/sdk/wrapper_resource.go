@@ -38,6 +38,9 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		if err := ValidateModelObject(modelObj); err != nil {
 			return nil, fmt.Errorf("validating model for %q: %+v", rw.resource.ResourceType(), err)
 		}
+		if err := ValidateModelObjectAgainstSchema(modelObj, *resourceSchema); err != nil {
+			return nil, fmt.Errorf("validating model against the schema for %q: %+v", rw.resource.ResourceType(), err)
+		}
 	}
 
 	d := func(duration time.Duration) *time.Duration {
@@ -48,7 +51,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.operationLogger(OperationCreate, d.Id, meta))
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
@@ -61,11 +64,11 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.operationLogger(OperationRead, d.Id, meta))
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.operationLogger(OperationDelete, d.Id, meta))
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -93,7 +96,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+				metaData := runArgs(d, meta, rw.operationLogger(OperationImport, d.Id, meta))
 
 				err := v.CustomImporter()(ctx, metaData)
 				if err != nil {
@@ -111,7 +114,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := runArgs(d, meta, rw.operationLogger(OperationUpdate, d.Id, meta))
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
@@ -130,8 +133,9 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			client := meta.(*clients.Client)
 			metaData := ResourceMetaData{
 				Client:                   client,
-				Logger:                   rw.logger,
+				Logger:                   rw.operationLogger(OperationCustomizeDiff, d.Id, meta),
 				ResourceDiff:             d,
+				resourceSchema:           *resourceSchema,
 				serializationDebugLogger: NullLogger{},
 			}
 
@@ -175,16 +179,31 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
+func (rw *ResourceWrapper) operationLogger(operation string, resourceId func() string, meta interface{}) Logger {
+	return operationLogger(rw.logger, rw.resource.ResourceType(), operation, resourceId, meta)
+}
+
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diagnosticsWrapper(in, rw.logger)
+	// the Private State is made available to Resources so that Long Running Operations can be resumed
+	withPrivateState := func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		return pluginsdk.WithPrivateState(ctx, d, func() error {
+			return in(ctx, d, meta)
+		})
+	}
+	return diagnosticsWrapper(withPrivateState, rw.logger)
 }
 
 func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		out := make([]diag.Diagnostic, 0)
 		if err := in(ctx, d, meta); err != nil {
+			severity := diag.Error
+			if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+				severity = diag.Warning
+			}
+
 			out = append(out, diag.Diagnostic{
-				Severity:      diag.Error,
+				Severity:      severity,
 				Summary:       err.Error(),
 				Detail:        err.Error(),
 				AttributePath: nil,
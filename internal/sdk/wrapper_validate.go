@@ -3,7 +3,10 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
@@ -18,8 +21,6 @@ func ValidateModelObject(input interface{}) error {
 		return fmt.Errorf("need a pointer to the model object")
 	}
 
-	// TODO: could we also validate that each `tfschema` tag exists in the schema?
-
 	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
 
@@ -64,3 +65,136 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 
 	return nil
 }
+
+// ValidateModelObjectAgainstSchema validates that each field within the model object (containing
+// a `tfschema` tag) exists within the Schema, that the type of the field matches the Schema
+// and that each field within the Schema exists within the model object
+func ValidateModelObjectAgainstSchema(input interface{}, resourceSchema map[string]*schema.Schema) error {
+	if input == nil {
+		// model not used for this resource
+		return nil
+	}
+
+	if reflect.TypeOf(input).Kind() != reflect.Ptr {
+		return fmt.Errorf("need a pointer to the model object")
+	}
+
+	objType := reflect.TypeOf(input).Elem()
+	if objType.Kind() != reflect.Struct {
+		return fmt.Errorf("the model object must be a struct but got %s", objType.Kind())
+	}
+
+	return validateModelAgainstSchemaRecursively("", objType, resourceSchema)
+}
+
+func validateModelAgainstSchemaRecursively(prefix string, objType reflect.Type, resourceSchema map[string]*schema.Schema) error {
+	fieldsInModel := make(map[string]struct{})
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		tfschemaTag, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			continue
+		}
+		fieldsInModel[tfschemaTag] = struct{}{}
+
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, tfschemaTag), ".")
+		fieldSchema, exists := resourceSchema[tfschemaTag]
+		if !exists {
+			return fmt.Errorf("field %q (%s) exists in the model but not the schema", fieldName, field.Name)
+		}
+
+		if err := validateModelFieldTypeAgainstSchema(fieldName, field.Type, fieldSchema); err != nil {
+			return err
+		}
+	}
+
+	// sort the keys so that the same field is reported each time
+	schemaKeys := make([]string, 0)
+	for k := range resourceSchema {
+		schemaKeys = append(schemaKeys, k)
+	}
+	sort.Strings(schemaKeys)
+
+	for _, k := range schemaKeys {
+		if _, exists := fieldsInModel[k]; !exists {
+			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, k), ".")
+			return fmt.Errorf("field %q exists in the schema but not the model", fieldName)
+		}
+	}
+
+	return nil
+}
+
+func validateModelFieldTypeAgainstSchema(fieldName string, fieldType reflect.Type, fieldSchema *schema.Schema) error {
+	// optional values can be represented using a pointer (e.g. `*string`), so compare the underlying type
+	fieldType = dereferencedType(fieldType)
+
+	switch fieldSchema.Type {
+	case schema.TypeString, schema.TypeInt, schema.TypeFloat, schema.TypeBool:
+		if !kindMatchesSchemaType(fieldType.Kind(), fieldSchema.Type) {
+			return fmt.Errorf("field %q is a %s in the model but a %s in the schema", fieldName, fieldType.Kind(), fieldSchema.Type)
+		}
+
+	case schema.TypeMap:
+		if fieldType.Kind() != reflect.Map {
+			return fmt.Errorf("field %q is a %s in the model but a %s in the schema", fieldName, fieldType.Kind(), fieldSchema.Type)
+		}
+		if elem, ok := fieldSchema.Elem.(*schema.Schema); ok {
+			if !kindMatchesSchemaType(dereferencedType(fieldType.Elem()).Kind(), elem.Type) {
+				return fmt.Errorf("field %q is a map of %s in the model but a map of %s in the schema", fieldName, fieldType.Elem().Kind(), elem.Type)
+			}
+		}
+
+	case schema.TypeList, schema.TypeSet:
+		innerType := fieldType
+		if fieldType.Kind() == reflect.Slice {
+			innerType = dereferencedType(fieldType.Elem())
+		} else if _, isBlock := fieldSchema.Elem.(*schema.Resource); !isBlock || fieldType.Kind() != reflect.Struct {
+			// a single struct can be used to represent a block
+			return fmt.Errorf("field %q is a %s in the model but a %s in the schema", fieldName, fieldType.Kind(), fieldSchema.Type)
+		}
+
+		switch elem := fieldSchema.Elem.(type) {
+		case *schema.Resource:
+			if innerType.Kind() != reflect.Struct {
+				return fmt.Errorf("field %q is a slice of %s in the model but a %s of blocks in the schema", fieldName, innerType.Kind(), fieldSchema.Type)
+			}
+			return validateModelAgainstSchemaRecursively(fieldName, innerType, elem.Schema)
+
+		case *schema.Schema:
+			if !kindMatchesSchemaType(innerType.Kind(), elem.Type) {
+				return fmt.Errorf("field %q is a slice of %s in the model but a %s of %s in the schema", fieldName, innerType.Kind(), fieldSchema.Type, elem.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
+func dereferencedType(input reflect.Type) reflect.Type {
+	for input.Kind() == reflect.Ptr {
+		input = input.Elem()
+	}
+	return input
+}
+
+func kindMatchesSchemaType(kind reflect.Kind, schemaType schema.ValueType) bool {
+	if kind == reflect.Interface {
+		// the value is type-asserted when it's used
+		return true
+	}
+
+	switch schemaType {
+	case schema.TypeString:
+		return kind == reflect.String
+	case schema.TypeInt:
+		return kind == reflect.Int || kind == reflect.Int8 || kind == reflect.Int16 || kind == reflect.Int32 || kind == reflect.Int64
+	case schema.TypeFloat:
+		return kind == reflect.Float32 || kind == reflect.Float64
+	case schema.TypeBool:
+		return kind == reflect.Bool
+	}
+
+	// complex types are validated separately
+	return true
+}
@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -66,3 +70,198 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateModelObjectAgainstSchemaValid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name string            `tfschema:"name"`
+		Age  int               `tfschema:"age"`
+		Pets []Pet             `tfschema:"pets"`
+		Tags map[string]string `tfschema:"tags"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"age": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"pets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaMissingField(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+		Age  int    `tfschema:"age"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaMissingSchemaField(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"age": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaMissingNestedSchemaField(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Pets []Pet `tfschema:"pets"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"pets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"age": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaMismatchedType(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+		Age  string `tfschema:"age"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"age": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaMismatchedNestedType(t *testing.T) {
+	type Pet struct {
+		Name []string `tfschema:"name"`
+	}
+	type Person struct {
+		Pets []Pet `tfschema:"pets"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"pets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaPointerFields(t *testing.T) {
+	type Person struct {
+		Name    *string `tfschema:"name"`
+		Age     *int64  `tfschema:"age"`
+		Enabled *bool   `tfschema:"enabled"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"age": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaMismatchedPointerField(t *testing.T) {
+	type Person struct {
+		Age *string `tfschema:"age"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"age": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
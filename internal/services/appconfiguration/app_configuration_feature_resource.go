@@ -34,6 +34,7 @@ type FeatureResourceModel struct {
 	Description          string                       `tfschema:"description"`
 	Enabled              bool                         `tfschema:"enabled"`
 	Name                 string                       `tfschema:"name"`
+	Etag                 string                       `tfschema:"etag"`
 	Label                string                       `tfschema:"label"`
 	Locked               bool                         `tfschema:"locked"`
 	Tags                 map[string]interface{}       `tfschema:"tags"`
@@ -249,6 +250,7 @@ func (k FeatureResource) Read() sdk.ResourceFunc {
 				Description:          fv.Description,
 				Enabled:              fv.Enabled,
 				Name:                 fv.ID,
+				Etag:                 utils.NormalizeNilableString(kv.Etag),
 				Label:                utils.NormalizeNilableString(kv.Label),
 				Tags:                 tags.Flatten(kv.Tags),
 			}
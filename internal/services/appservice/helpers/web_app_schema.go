@@ -47,6 +47,7 @@ type SiteConfigWindows struct {
 	ScmMinTlsVersion         string                    `tfschema:"scm_minimum_tls_version"`
 	Cors                     []CorsSetting             `tfschema:"cors"`
 	DetailedErrorLogging     bool                      `tfschema:"detailed_error_logging_enabled"`
+	LinuxFxVersion           string                    `tfschema:"linux_fx_version"`
 	WindowsFxVersion         string                    `tfschema:"windows_fx_version"`
 	VnetRouteAllEnabled      bool                      `tfschema:"vnet_route_all_enabled"`
 	// TODO new properties / blocks
@@ -462,6 +463,11 @@ func SiteConfigSchemaWindowsComputed() *pluginsdk.Schema {
 					Computed: true,
 				},
 
+				"linux_fx_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
 				"windows_fx_version": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -3425,6 +3431,7 @@ func FlattenSiteConfigWindows(appSiteConfig *web.SiteConfig, currentStack string
 		HealthCheckEvictionTime:  utils.NormaliseNilableInt(healthCheckCount),
 		Http2Enabled:             utils.NormaliseNilableBool(appSiteConfig.HTTP20Enabled),
 		IpRestriction:            FlattenIpRestrictions(appSiteConfig.IPSecurityRestrictions),
+		LinuxFxVersion:           utils.NormalizeNilableString(appSiteConfig.LinuxFxVersion),
 		LoadBalancing:            string(appSiteConfig.LoadBalancing),
 		LocalMysql:               utils.NormaliseNilableBool(appSiteConfig.LocalMySQLEnabled),
 		ManagedPipelineMode:      string(appSiteConfig.ManagedPipelineMode),
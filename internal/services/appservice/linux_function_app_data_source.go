@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
@@ -55,7 +56,8 @@ type LinuxFunctionAppDataSourceModel struct {
 	PossibleOutboundIPAddresses   string   `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string `tfschema:"possible_outbound_ip_address_list"`
 
-	SiteCredentials []helpers.SiteCredential `tfschema:"site_credential"`
+	SiteCredentials []helpers.SiteCredential  `tfschema:"site_credential"`
+	Identity        []identity.ExpandedConfig `tfschema:"identity"`
 }
 
 func (d LinuxFunctionAppDataSource) ModelObject() interface{} {
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
@@ -59,7 +60,8 @@ type LinuxFunctionAppModel struct {
 	PossibleOutboundIPAddresses   string   `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string `tfschema:"possible_outbound_ip_address_list"`
 
-	SiteCredentials []helpers.SiteCredential `tfschema:"site_credential"`
+	SiteCredentials []helpers.SiteCredential  `tfschema:"site_credential"`
+	Identity        []identity.ExpandedConfig `tfschema:"identity"`
 }
 
 var _ sdk.ResourceWithUpdate = LinuxFunctionAppResource{}
@@ -21,6 +21,13 @@ func (r Registration) Name() string {
 	return "AppService"
 }
 
+// ResourceProviders returns the Resource Providers required by the Resources within this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Web",
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	if features.ThreePointOhAppServiceResources() {
 		return []sdk.DataSource{
@@ -66,8 +66,11 @@ func resourceCognitiveAccountCustomerManagedKeyCreateUpdate(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(id.AccountName, "azurerm_cognitive_account")
-	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+	release, err := locks.Acquire(ctx, locks.NameKey(id.AccountName, "azurerm_cognitive_account"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	resp, err := client.AccountsGet(ctx, *id)
 	if err != nil {
@@ -169,8 +172,11 @@ func resourceCognitiveAccountCustomerManagedKeyDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByName(id.AccountName, "azurerm_cognitive_account")
-	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+	release, err := locks.Acquire(ctx, locks.NameKey(id.AccountName, "azurerm_cognitive_account"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	resp, err := client.AccountsGet(ctx, *id)
 	if err != nil {
@@ -96,8 +96,11 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	publicNetworkAccess := cognitiveservicesaccounts.PublicNetworkAccessEnabled
 	if !d.Get("public_network_access_enabled").(bool) {
@@ -181,8 +184,11 @@ func resourceCognitiveAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	publicNetworkAccess := cognitiveservicesaccounts.PublicNetworkAccessEnabled
 	if !d.Get("public_network_access_enabled").(bool) {
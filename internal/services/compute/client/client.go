@@ -17,6 +17,7 @@ type Client struct {
 	GalleryImagesClient             *compute.GalleryImagesClient
 	GalleryImageVersionsClient      *compute.GalleryImageVersionsClient
 	ProximityPlacementGroupsClient  *compute.ProximityPlacementGroupsClient
+	ResourceSkusClient              *compute.ResourceSkusClient
 	MarketplaceAgreementsClient     *marketplaceordering.MarketplaceAgreementsClient
 	ImagesClient                    *compute.ImagesClient
 	SnapshotsClient                 *compute.SnapshotsClient
@@ -69,6 +70,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	proximityPlacementGroupsClient := compute.NewProximityPlacementGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&proximityPlacementGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&resourceSkusClient.Client, o.ResourceManagerAuthorizer)
+
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -115,6 +119,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ImagesClient:                    &imagesClient,
 		MarketplaceAgreementsClient:     &marketplaceAgreementsClient,
 		ProximityPlacementGroupsClient:  &proximityPlacementGroupsClient,
+		ResourceSkusClient:              &resourceSkusClient,
 		SnapshotsClient:                 &snapshotsClient,
 		UsageClient:                     &usageClient,
 		VMExtensionImageClient:          &vmExtensionImageClient,
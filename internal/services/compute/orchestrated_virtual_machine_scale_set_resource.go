@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -37,6 +38,13 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 			return err
 		}, importOrchestratedVirtualMachineScaleSet),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceskus.ValidateAvailability(resourceskus.SkuFields{
+			ResourceType: resourceskus.ResourceTypeVirtualMachines,
+			Name:         "sku_name",
+			Zones:        "zones",
+			Capacity:     "instances",
+		})),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
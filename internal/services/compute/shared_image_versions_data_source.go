@@ -100,7 +100,7 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 	imageName := d.Get("image_name").(string)
 	galleryName := d.Get("gallery_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
-	filterTags := tags.Expand(d.Get("tags_filter").(map[string]interface{}))
+	filterTags := tags.ExpandWithoutDefaultTags(d.Get("tags_filter").(map[string]interface{}))
 
 	resp, err := client.ListByGalleryImageComplete(ctx, resourceGroup, galleryName, imageName)
 	if err != nil {
@@ -577,8 +577,11 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		networkProfileIDNorm := id.ID()
 		// Avoid parallel provisioning if "network_profile_id" is given.
 		// See: https://github.com/hashicorp/terraform-provider-azurerm/issues/15025
-		locks.ByID(networkProfileIDNorm)
-		defer locks.UnlockByID(networkProfileIDNorm)
+		release, err := locks.Acquire(ctx, networkProfileIDNorm)
+		if err != nil {
+			return fmt.Errorf("acquiring lock: %+v", err)
+		}
+		defer release()
 
 		if strings.ToLower(OSType) != "linux" {
 			return fmt.Errorf("Currently only Linux containers can be deployed to virtual networks")
@@ -748,8 +751,11 @@ func resourceContainerGroupDelete(d *pluginsdk.ResourceData, meta interface{}) e
 			}
 			// Avoid parallel deletion if "network_profile_id" is given. (not sure whether this is necessary)
 			// See: https://github.com/hashicorp/terraform-provider-azurerm/issues/15025
-			locks.ByID(networkProfileId)
-			defer locks.UnlockByID(networkProfileId)
+			release, err := locks.Acquire(ctx, networkProfileId)
+			if err != nil {
+				return fmt.Errorf("acquiring lock: %+v", err)
+			}
+			defer release()
 		}
 	}
 
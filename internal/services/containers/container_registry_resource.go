@@ -931,7 +931,7 @@ func expandReplications(p []interface{}) []containerregistry.Replication {
 	for _, v := range p {
 		value := v.(map[string]interface{})
 		location := azure.NormalizeLocation(value["location"])
-		tags := tags.Expand(value["tags"].(map[string]interface{}))
+		tags := tags.ExpandWithoutDefaultTags(value["tags"].(map[string]interface{}))
 		zoneRedundancy := containerregistry.ZoneRedundancyDisabled
 		if value["zone_redundancy_enabled"].(bool) {
 			zoneRedundancy = containerregistry.ZoneRedundancyEnabled
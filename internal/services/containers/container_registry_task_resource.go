@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	legacyIdentity "github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
@@ -119,23 +120,24 @@ type CustomRegistryCredential struct {
 }
 
 type ContainerRegistryTaskModel struct {
-	Name                string               `tfschema:"name"`
-	ContainerRegistryId string               `tfschema:"container_registry_id"`
-	AgentConfig         []AgentConfig        `tfschema:"agent_setting"`
-	AgentPoolName       string               `tfschema:"agent_pool_name"`
-	IsSystemTask        bool                 `tfschema:"is_system_task"`
-	LogTemplate         string               `tfschema:"log_template"`
-	Platform            []Platform           `tfschema:"platform"`
-	Enabled             bool                 `tfschema:"enabled"`
-	TimeoutInSec        int                  `tfschema:"timeout_in_seconds"`
-	DockerStep          []DockerStep         `tfschema:"docker_step"`
-	FileTaskStep        []FileTaskStep       `tfschema:"file_step"`
-	EncodedTaskStep     []EncodedTaskStep    `tfschema:"encoded_step"`
-	BaseImageTrigger    []BaseImageTrigger   `tfschema:"base_image_trigger"`
-	SourceTrigger       []SourceTrigger      `tfschema:"source_trigger"`
-	TimerTrigger        []TimerTrigger       `tfschema:"timer_trigger"`
-	RegistryCredential  []RegistryCredential `tfschema:"registry_credential"`
-	Tags                map[string]string    `tfschema:"tags"`
+	Name                string                          `tfschema:"name"`
+	ContainerRegistryId string                          `tfschema:"container_registry_id"`
+	AgentConfig         []AgentConfig                   `tfschema:"agent_setting"`
+	AgentPoolName       string                          `tfschema:"agent_pool_name"`
+	IsSystemTask        bool                            `tfschema:"is_system_task"`
+	LogTemplate         string                          `tfschema:"log_template"`
+	Platform            []Platform                      `tfschema:"platform"`
+	Enabled             bool                            `tfschema:"enabled"`
+	TimeoutInSec        int                             `tfschema:"timeout_in_seconds"`
+	DockerStep          []DockerStep                    `tfschema:"docker_step"`
+	FileTaskStep        []FileTaskStep                  `tfschema:"file_step"`
+	EncodedTaskStep     []EncodedTaskStep               `tfschema:"encoded_step"`
+	BaseImageTrigger    []BaseImageTrigger              `tfschema:"base_image_trigger"`
+	SourceTrigger       []SourceTrigger                 `tfschema:"source_trigger"`
+	TimerTrigger        []TimerTrigger                  `tfschema:"timer_trigger"`
+	RegistryCredential  []RegistryCredential            `tfschema:"registry_credential"`
+	Identity            []legacyIdentity.ExpandedConfig `tfschema:"identity"`
+	Tags                map[string]string               `tfschema:"tags"`
 }
 
 func userDataStateFunc(v interface{}) string {
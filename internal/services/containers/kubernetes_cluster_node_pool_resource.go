@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
@@ -34,6 +36,14 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceskus.ValidateAvailability(resourceskus.SkuFields{
+			ResourceType: resourceskus.ResourceTypeVirtualMachines,
+			Name:         "vm_size",
+			LocationFunc: kubernetesClusterNodePoolLocation,
+			Zones:        "availability_zones",
+			Capacity:     "node_count",
+		})),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -920,3 +930,25 @@ func flattenUpgradeSettings(input *containerservice.AgentPoolUpgradeSettings) []
 		},
 	}
 }
+
+// kubernetesClusterNodePoolLocation returns the location of the Kubernetes Cluster this Node Pool belongs to, which
+// is used to validate the `vm_size` during plan - or nil when this isn't known (e.g. the Cluster is yet to be created)
+func kubernetesClusterNodePoolLocation(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) (*string, error) {
+	if !d.NewValueKnown("kubernetes_cluster_id") {
+		return nil, nil
+	}
+
+	clusterId, err := parse.ClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return nil, nil
+	}
+
+	clustersClient := meta.(*clients.Client).Containers.KubernetesClustersClient
+	cluster, err := clustersClient.Get(ctx, clusterId.ResourceGroup, clusterId.ManagedClusterName)
+	if err != nil {
+		log.Printf("[DEBUG] retrieving %s to validate the `vm_size`: %+v", *clusterId, err)
+		return nil, nil
+	}
+
+	return cluster.Location, nil
+}
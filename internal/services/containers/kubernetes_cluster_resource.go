@@ -18,6 +18,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceskus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
@@ -51,6 +54,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("service_principal.0.client_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old == "msi" || old == ""
 			}),
+			resourceskus.ValidateAvailability(resourceskus.SkuFields{
+				ResourceType: resourceskus.ResourceTypeVirtualMachines,
+				Name:         "default_node_pool.0.vm_size",
+				Zones:        "default_node_pool.0.availability_zones",
+				Capacity:     "default_node_pool.0.node_count",
+			}),
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -73,7 +82,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"location": azure.SchemaLocation(),
+			"location": location.SchemaForResourceType("Microsoft.ContainerService/managedClusters"),
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
@@ -1053,7 +1062,9 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			},
 		}
 	}
-	return resource
+
+	// the Private State is used to resume the creation of the Cluster should the timeout be reached
+	return pluginsdk.EnablePrivateState(resource)
 }
 
 func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -1249,7 +1260,10 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err = sdk.WaitForLongRunningOperation(ctx, d, id, future.FutureAPI, client.Client); err != nil {
+		if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+			return err
+		}
 		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 	}
 
@@ -1280,6 +1294,11 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	// the creation of the Cluster may still be in progress from a previous Terraform run
+	if err := sdk.ResumeLongRunningOperation(ctx, d, id, clusterClient.Client); err != nil {
+		return err
+	}
+
 	d.Partial(true)
 
 	// we need to conditionally update the cluster
@@ -1699,6 +1718,11 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	// the creation of the Cluster may still be in progress from a previous Terraform run
+	if err := sdk.ResumeLongRunningOperation(ctx, d, id, client.Client); err != nil {
+		return err
+	}
+
 	resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
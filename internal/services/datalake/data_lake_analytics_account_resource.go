@@ -8,13 +8,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datalake/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datalake/sdk/datalakeanalytics/2016-11-01/accounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datalake/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -77,7 +77,7 @@ func resourceDataLakeAnalyticsAccount() *pluginsdk.Resource {
 				ValidateFunc: validate.AccountName(),
 			},
 
-			"tags": commonschema.Tags(),
+			"tags": tags.Schema(),
 		},
 	}
 }
@@ -110,7 +110,7 @@ func resourceArmDateLakeAnalyticsAccountCreate(d *pluginsdk.ResourceData, meta i
 
 	dateLakeAnalyticsAccount := accounts.CreateDataLakeAnalyticsAccountParameters{
 		Location: location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandPointer(t),
 		Properties: accounts.CreateDataLakeAnalyticsAccountProperties{
 			NewTier:                     &tier,
 			DefaultDataLakeStoreAccount: storeAccountName,
@@ -146,7 +146,7 @@ func resourceArmDateLakeAnalyticsAccountUpdate(d *pluginsdk.ResourceData, meta i
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := accounts.UpdateDataLakeAnalyticsAccountParameters{
-		Tags: tags.Expand(newTags),
+		Tags: tags.ExpandPointer(newTags),
 		Properties: &accounts.UpdateDataLakeAnalyticsAccountProperties{
 			NewTier: &newTier,
 			DataLakeStoreAccounts: &[]accounts.UpdateDataLakeStoreWithAccountParameters{
@@ -199,7 +199,7 @@ func resourceArmDateLakeAnalyticsAccountRead(d *pluginsdk.ResourceData, meta int
 			d.Set("default_store_account_name", properties.DefaultDataLakeStoreAccount)
 		}
 
-		return tags.FlattenAndSet(d, model.Tags)
+		return tags.FlattenPointerAndSet(d, model.Tags)
 	}
 	return nil
 }
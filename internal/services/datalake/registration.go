@@ -18,6 +18,14 @@ func (r Registration) Name() string {
 	return "Data Lake"
 }
 
+// ResourceProviders returns the Resource Providers required by the Resources within this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.DataLakeAnalytics",
+		"Microsoft.DataLakeStore",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -89,7 +89,7 @@ func (d DisksPoolIscsiTargetResource) Attributes() map[string]*schema.Schema {
 }
 
 func (d DisksPoolIscsiTargetResource) ModelObject() interface{} {
-	return &DisksPoolIscsiTargetResource{}
+	return &DiskPoolIscsiTargetModel{}
 }
 
 func (d DisksPoolIscsiTargetResource) ResourceType() string {
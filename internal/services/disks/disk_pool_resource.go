@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -17,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/sdk/2021-08-01/diskpools"
 	disksValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -62,7 +62,7 @@ func (DiskPoolResource) Arguments() map[string]*schema.Schema {
 			ValidateFunc: networkValidate.SubnetID,
 		},
 
-		"tags": commonschema.Tags(),
+		"tags": tags.Schema(),
 
 		"zones": { // TODO: create commonschema.ZonesForceNew
 			Type:     pluginsdk.TypeList,
@@ -118,7 +118,7 @@ func (r DiskPoolResource) Create() sdk.ResourceFunc {
 					SubnetId:          m.SubnetId,
 				},
 				Sku:  expandDisksPoolSku(m.Sku),
-				Tags: tags.Expand(m.Tags),
+				Tags: tags.ExpandPointer(m.Tags),
 			}
 			future, err := client.CreateOrUpdate(ctx, id, createParameter)
 			if err != nil {
@@ -227,7 +227,7 @@ func (r DiskPoolResource) Update() sdk.ResourceFunc {
 				patch.Sku = &sku
 			}
 			if metadata.ResourceData.HasChange("tags") {
-				patch.Tags = tags.Expand(m.Tags)
+				patch.Tags = tags.ExpandPointer(m.Tags)
 			}
 
 			future, err := client.Update(ctx, *id, patch)
@@ -7,13 +7,13 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/sdk/2021-08-01/diskpools"
 	disksValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -71,7 +71,7 @@ func (StorageDisksPoolResource) Arguments() map[string]*pluginsdk.Schema {
 			ForceNew:     true,
 			ValidateFunc: networkValidate.SubnetID,
 		},
-		"tags": commonschema.Tags(),
+		"tags": tags.Schema(),
 	}
 }
 
@@ -108,7 +108,7 @@ func (r StorageDisksPoolResource) Create() sdk.ResourceFunc {
 					SubnetId:          m.SubnetId,
 				},
 				Sku:  expandDisksPoolSku(m.Sku),
-				Tags: tags.Expand(m.Tags),
+				Tags: tags.ExpandPointer(m.Tags),
 			}
 			if err := client.CreateOrUpdateThenPoll(ctx, id, createParameter); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
@@ -206,7 +206,7 @@ func (StorageDisksPoolResource) Update() sdk.ResourceFunc {
 				patch.Sku = &sku
 			}
 			if metadata.ResourceData.HasChange("tags") {
-				patch.Tags = tags.Expand(m.Tags)
+				patch.Tags = tags.ExpandPointer(m.Tags)
 			}
 
 			if err := client.UpdateThenPoll(ctx, *id, patch); err != nil {
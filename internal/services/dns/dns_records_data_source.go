@@ -0,0 +1,210 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceDnsRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsRecordsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"record_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dns.A),
+					string(dns.AAAA),
+					string(dns.CAA),
+					string(dns.CNAME),
+					string(dns.MX),
+					string(dns.NS),
+					string(dns.PTR),
+					string(dns.SOA),
+					string(dns.SRV),
+					string(dns.TXT),
+				}, false),
+			},
+
+			"name_prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"record_sets": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"target_resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDnsRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string))
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	recordType := d.Get("record_type").(string)
+	namePrefix := strings.ToLower(d.Get("name_prefix").(string))
+
+	var iterator dns.RecordSetListResultIterator
+	if recordType != "" {
+		iterator, err = client.ListByTypeComplete(ctx, id.ResourceGroup, id.Name, dns.RecordType(recordType), nil, "")
+	} else {
+		iterator, err = client.ListAllByDNSZoneComplete(ctx, id.ResourceGroup, id.Name, nil, "")
+	}
+	if err != nil {
+		return fmt.Errorf("listing record sets within %s: %+v", id, err)
+	}
+
+	recordSets := make([]dns.RecordSet, 0)
+	for iterator.NotDone() {
+		rs := iterator.Value()
+		if name, _ := dnsZoneRecordSetNameAndType(rs); strings.HasPrefix(name, namePrefix) {
+			recordSets = append(recordSets, rs)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing record sets within %s: %+v", id, err)
+		}
+	}
+
+	flattened, err := flattenDnsRecordSets(recordSets)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("zone_name", id.Name)
+
+	if err := d.Set("record_sets", flattened); err != nil {
+		return fmt.Errorf("setting `record_sets`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDnsRecordSets(input []dns.RecordSet) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+
+	// the record sets are sorted by name and type so that the ordering is consistent between reads
+	sort.SliceStable(input, func(i, j int) bool {
+		iName, iType := dnsZoneRecordSetNameAndType(input[i])
+		jName, jType := dnsZoneRecordSetNameAndType(input[j])
+		if iName != jName {
+			return iName < jName
+		}
+		return iType < jType
+	})
+
+	for _, rs := range input {
+		recordSet, err := flattenDnsZoneFileRecordSet(rs)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]interface{}, 0)
+		for _, record := range recordSet.Records {
+			records = append(records, zonefile.FormatRecordData(recordSet.Type, record))
+		}
+
+		fqdn := ""
+		targetResourceId := ""
+		if props := rs.RecordSetProperties; props != nil {
+			fqdn = utils.NormalizeNilableString(props.Fqdn)
+			if props.TargetResource != nil && props.TargetResource.ID != nil {
+				targetResourceId = *props.TargetResource.ID
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"id":                 utils.NormalizeNilableString(rs.ID),
+			"name":               recordSet.Name,
+			"type":               recordSet.Type,
+			"fqdn":               fqdn,
+			"ttl":                int(recordSet.TTL),
+			"records":            records,
+			"target_resource_id": targetResourceId,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,135 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsRecordsDataSource struct{}
+
+func TestAccDataSourceDnsRecords_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				// the SOA and NS record sets at the apex are created alongside the zone
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("5"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceDnsRecords_recordType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.recordType(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("2"),
+				check.That(data.ResourceName).Key("record_sets.0.name").HasValue("api"),
+				check.That(data.ResourceName).Key("record_sets.0.type").HasValue("A"),
+				check.That(data.ResourceName).Key("record_sets.0.ttl").HasValue("300"),
+				check.That(data.ResourceName).Key("record_sets.0.records.#").HasValue("1"),
+				check.That(data.ResourceName).Key("record_sets.0.records.0").HasValue("10.0.0.3"),
+				check.That(data.ResourceName).Key("record_sets.1.name").HasValue("www"),
+				check.That(data.ResourceName).Key("record_sets.1.records.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceDnsRecords_namePrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.namePrefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("2"),
+				check.That(data.ResourceName).Key("record_sets.0.name").HasValue("www"),
+				check.That(data.ResourceName).Key("record_sets.0.type").HasValue("A"),
+				check.That(data.ResourceName).Key("record_sets.1.name").HasValue("www"),
+				check.That(data.ResourceName).Key("record_sets.1.type").HasValue("TXT"),
+				check.That(data.ResourceName).Key("record_sets.1.records.0").HasValue(`"hello world"`),
+			),
+		},
+	})
+}
+
+func (DnsRecordsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+
+  depends_on = [azurerm_dns_zone_records.test]
+}
+`, DnsRecordsDataSource{}.template(data))
+}
+
+func (DnsRecordsDataSource) recordType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  record_type         = "A"
+
+  depends_on = [azurerm_dns_zone_records.test]
+}
+`, DnsRecordsDataSource{}.template(data))
+}
+
+func (DnsRecordsDataSource) namePrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  name_prefix         = "ww"
+
+  depends_on = [azurerm_dns_zone_records.test]
+}
+`, DnsRecordsDataSource{}.template(data))
+}
+
+func (DnsRecordsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+www 300 IN A   10.0.0.1
+www 300 IN A   10.0.0.2
+www 300 IN TXT "hello world"
+api 300 IN A   10.0.0.3
+ZONE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
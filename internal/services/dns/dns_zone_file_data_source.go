@@ -0,0 +1,85 @@
+package dns
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceDnsZoneFile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsZoneFileRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DnsZoneID,
+			},
+
+			"zone_file": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDnsZoneFileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	existing, err := listDnsZoneRecordSets(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0)
+	for _, rs := range existing {
+		// alias record sets reference an Azure Resource rather than containing records, so can't be exported
+		if rs.RecordSetProperties != nil && rs.TargetResource != nil && rs.TargetResource.ID != nil {
+			name, recordType := dnsZoneRecordSetNameAndType(rs)
+			log.Printf("[DEBUG] Skipping the alias %s record set %q within %s", recordType, name, *id)
+			continue
+		}
+
+		flattened, err := flattenDnsZoneFileRecordSet(rs)
+		if err != nil {
+			return err
+		}
+		recordSets = append(recordSets, *flattened)
+	}
+
+	d.SetId(id.ID())
+	d.Set("dns_zone_id", id.ID())
+	d.Set("zone_file", zonefile.Write(id.Name, recordSets))
+
+	return nil
+}
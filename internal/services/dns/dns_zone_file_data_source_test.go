@@ -0,0 +1,65 @@
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsZoneFileDataSource struct{}
+
+func TestAccDnsZoneFileDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zone_file", "test")
+	r := DnsZoneFileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^\$ORIGIN acctestzone\d+\.com\.$`)),
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^@ +\d+ +IN SOA `)),
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^www +300 +IN A +10\.0\.0\.1$`)),
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^@ +300 +IN TXT +"v=spf1 -all"$`)),
+			),
+		},
+	})
+}
+
+func (DnsZoneFileDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_zone_file" "test" {
+  dns_zone_id = azurerm_dns_zone_records.test.dns_zone_id
+}
+`, DnsZoneFileDataSource{}.template(data))
+}
+
+func (DnsZoneFileDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+www 300 IN A   10.0.0.1
+@   300 IN TXT "v=spf1 -all"
+ZONE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
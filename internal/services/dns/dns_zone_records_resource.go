@@ -0,0 +1,650 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDnsZoneRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsZoneRecordsCreateUpdate,
+		Read:   resourceDnsZoneRecordsRead,
+		Update: resourceDnsZoneRecordsCreateUpdate,
+		Delete: resourceDnsZoneRecordsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsZoneID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsZoneID,
+			},
+
+			"zone_file": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: dnsZoneRecordsZoneFileDiffSuppress,
+			},
+
+			"default_ttl": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(0, math.MaxInt32),
+			},
+
+			"delete_unlisted_record_sets": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"managed_record_sets": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(dnsZoneRecordsCustomizeDiff),
+	}
+}
+
+func resourceDnsZoneRecordsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(zone.Response) {
+				return fmt.Errorf("%s was not found", *id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+	}
+
+	defaultTTL := int64(d.Get("default_ttl").(int))
+	desired, err := parseManagedZoneFile(id.Name, defaultTTL, d.Get("zone_file").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	// any record sets which were previously listed in the configured zone file are managed by this resource, and so
+	// need to be removed when they're no longer listed - these are tracked separately to the `zone_file`, since when
+	// imported this contains every record set within the zone, rather than only those which are managed
+	previouslyManaged := make(map[string]struct{})
+	oldManaged, _ := d.GetChange("managed_record_sets")
+	for _, key := range oldManaged.(*pluginsdk.Set).List() {
+		previouslyManaged[key.(string)] = struct{}{}
+	}
+
+	existing, err := listDnsZoneRecordSets(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	listed := make(map[string]struct{})
+	for _, rs := range desired {
+		listed[rs.Key()] = struct{}{}
+
+		current, exists := existing[rs.Key()]
+		if exists && isDnsZoneRecordSetManageable(current) {
+			flattened, err := flattenDnsZoneFileRecordSet(current)
+			if err != nil {
+				return err
+			}
+			if zonefile.EqualRecordSet(*flattened, rs) {
+				continue
+			}
+		}
+
+		props, err := expandDnsZoneFileRecordSet(rs)
+		if err != nil {
+			return fmt.Errorf("expanding %s record set %q: %+v", rs.Type, rs.Name, err)
+		}
+		// the metadata isn't part of the zone file, so any metadata already assigned is retained
+		if exists && current.RecordSetProperties != nil {
+			props.Metadata = current.Metadata
+		}
+		parameters := dns.RecordSet{
+			Name:                utils.String(rs.Name),
+			RecordSetProperties: props,
+		}
+
+		log.Printf("[DEBUG] Creating/Updating %s record set %q within %s..", rs.Type, rs.Name, *id)
+		if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, rs.Name, dns.RecordType(rs.Type), parameters, "", ""); err != nil {
+			return fmt.Errorf("creating/updating %s record set %q within %s: %+v", rs.Type, rs.Name, *id, err)
+		}
+	}
+
+	deleteUnlisted := d.Get("delete_unlisted_record_sets").(bool)
+	for key, current := range existing {
+		if _, ok := listed[key]; ok {
+			continue
+		}
+		if !isDnsZoneRecordSetManageable(current) {
+			continue
+		}
+		if _, ok := previouslyManaged[key]; !ok && !deleteUnlisted {
+			continue
+		}
+
+		name, recordType := dnsZoneRecordSetNameAndType(current)
+		log.Printf("[DEBUG] Deleting %s record set %q within %s..", recordType, name, *id)
+		if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name, name, dns.RecordType(recordType), ""); err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting %s record set %q within %s: %+v", recordType, name, *id, err)
+			}
+		}
+	}
+
+	d.SetId(id.ID())
+
+	managed := make([]string, 0)
+	for key := range listed {
+		managed = append(managed, key)
+	}
+	if err := d.Set("managed_record_sets", managed); err != nil {
+		return fmt.Errorf("setting `managed_record_sets`: %+v", err)
+	}
+
+	return resourceDnsZoneRecordsRead(d, meta)
+}
+
+func resourceDnsZoneRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	existing, err := listDnsZoneRecordSets(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	configuredZoneFile := d.Get("zone_file").(string)
+
+	// `default_ttl` isn't returned from the API, so needs defaulting on import
+	defaultTTL := int64(d.Get("default_ttl").(int))
+	if configuredZoneFile == "" {
+		defaultTTL = 3600
+	}
+	deleteUnlisted := d.Get("delete_unlisted_record_sets").(bool)
+
+	desired, err := parseManagedZoneFile(id.Name, defaultTTL, configuredZoneFile)
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+	listed := make(map[string]struct{})
+	for _, rs := range desired {
+		listed[rs.Key()] = struct{}{}
+	}
+
+	// when importing (or when unlisted record sets are deleted) all the record sets within the zone are
+	// managed by this resource, otherwise only the record sets listed within the zone file are
+	manageAll := configuredZoneFile == "" || deleteUnlisted
+	actual := make([]zonefile.RecordSet, 0)
+	for key, current := range existing {
+		if !isDnsZoneRecordSetManageable(current) {
+			continue
+		}
+		if _, ok := listed[key]; !ok && !manageAll {
+			continue
+		}
+
+		flattened, err := flattenDnsZoneFileRecordSet(current)
+		if err != nil {
+			return err
+		}
+		actual = append(actual, *flattened)
+	}
+
+	d.Set("dns_zone_id", id.ID())
+	d.Set("default_ttl", defaultTTL)
+	d.Set("delete_unlisted_record_sets", deleteUnlisted)
+
+	// the configured zone file is retained when it matches, so that formatting and comments are preserved
+	if configuredZoneFile == "" || !zonefile.Equal(desired, actual) {
+		d.Set("zone_file", zonefile.Write(id.Name, actual))
+	}
+
+	// the managed record sets which have since been removed from the zone are no longer managed - when
+	// imported there are none, since the record sets within the zone weren't created by this resource
+	managed := make([]string, 0)
+	for _, key := range d.Get("managed_record_sets").(*pluginsdk.Set).List() {
+		if _, ok := existing[key.(string)]; ok {
+			managed = append(managed, key.(string))
+		}
+	}
+	if err := d.Set("managed_record_sets", managed); err != nil {
+		return fmt.Errorf("setting `managed_record_sets`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDnsZoneRecordsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// only the managed record sets are deleted, rather than those within the `zone_file` - which when
+	// imported contains every record set within the zone
+	for _, v := range d.Get("managed_record_sets").(*pluginsdk.Set).List() {
+		key := v.(string)
+		name := key[:strings.LastIndex(key, "/")]
+		recordType := key[strings.LastIndex(key, "/")+1:]
+
+		log.Printf("[DEBUG] Deleting %s record set %q within %s..", recordType, name, *id)
+		if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name, name, dns.RecordType(recordType), ""); err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting %s record set %q within %s: %+v", recordType, name, *id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// dnsZoneRecordsCustomizeDiff plans the record sets listed within the configured zone file as `managed_record_sets`
+func dnsZoneRecordsCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("dns_zone_id") || !diff.NewValueKnown("zone_file") || !diff.NewValueKnown("default_ttl") {
+		return diff.SetNewComputed("managed_record_sets")
+	}
+
+	id, err := parse.DnsZoneID(diff.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := parseManagedZoneFile(id.Name, int64(diff.Get("default_ttl").(int)), diff.Get("zone_file").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	managed := make([]string, 0)
+	for _, rs := range recordSets {
+		managed = append(managed, rs.Key())
+	}
+	return diff.SetNew("managed_record_sets", managed)
+}
+
+func dnsZoneRecordsZoneFileDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	id, err := parse.DnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return false
+	}
+
+	defaultTTL := int64(d.Get("default_ttl").(int))
+	oldRecordSets, err := parseManagedZoneFile(id.Name, defaultTTL, old)
+	if err != nil {
+		return false
+	}
+	newRecordSets, err := parseManagedZoneFile(id.Name, defaultTTL, new)
+	if err != nil {
+		return false
+	}
+
+	return zonefile.Equal(oldRecordSets, newRecordSets)
+}
+
+// parseManagedZoneFile parses the zone file, omitting the SOA and apex NS record sets since these are managed by Azure
+func parseManagedZoneFile(zoneName string, defaultTTL int64, input string) ([]zonefile.RecordSet, error) {
+	recordSets, err := zonefile.Parse(zoneName, defaultTTL, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]zonefile.RecordSet, 0)
+	for _, rs := range recordSets {
+		if isDnsZoneAzureManagedRecordSet(rs.Name, rs.Type) {
+			continue
+		}
+		output = append(output, rs)
+	}
+
+	return output, nil
+}
+
+// listDnsZoneRecordSets returns all of the record sets within the DNS Zone, keyed by `{name}/{type}`
+func listDnsZoneRecordSets(ctx context.Context, client *dns.RecordSetsClient, id parse.DnsZoneId) (map[string]dns.RecordSet, error) {
+	iterator, err := client.ListAllByDNSZoneComplete(ctx, id.ResourceGroup, id.Name, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing record sets within %s: %+v", id, err)
+	}
+
+	output := make(map[string]dns.RecordSet)
+	for iterator.NotDone() {
+		rs := iterator.Value()
+		name, recordType := dnsZoneRecordSetNameAndType(rs)
+		output[zonefile.RecordSet{Name: name, Type: recordType}.Key()] = rs
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing record sets within %s: %+v", id, err)
+		}
+	}
+
+	return output, nil
+}
+
+// dnsZoneRecordSetNameAndType returns the lower-cased relative name and the record type of the record set
+func dnsZoneRecordSetNameAndType(input dns.RecordSet) (string, string) {
+	name := ""
+	if input.Name != nil {
+		name = strings.ToLower(*input.Name)
+	}
+
+	recordType := ""
+	if input.Type != nil {
+		// the type is returned in the form `Microsoft.Network/dnszones/A`
+		segments := strings.Split(*input.Type, "/")
+		recordType = strings.ToUpper(segments[len(segments)-1])
+	}
+
+	return name, recordType
+}
+
+// isDnsZoneAzureManagedRecordSet returns whether the record set is managed by Azure (the SOA and apex NS record sets)
+func isDnsZoneAzureManagedRecordSet(name, recordType string) bool {
+	return recordType == string(dns.SOA) || (name == "@" && recordType == string(dns.NS))
+}
+
+// isDnsZoneRecordSetManageable returns whether the record set can be managed through a zone file - which excludes
+// the record sets managed by Azure and alias record sets, since these reference an Azure Resource
+func isDnsZoneRecordSetManageable(input dns.RecordSet) bool {
+	name, recordType := dnsZoneRecordSetNameAndType(input)
+	if isDnsZoneAzureManagedRecordSet(name, recordType) {
+		return false
+	}
+	return input.RecordSetProperties == nil || input.TargetResource == nil || input.TargetResource.ID == nil
+}
+
+func expandDnsZoneFileRecordSet(input zonefile.RecordSet) (*dns.RecordSetProperties, error) {
+	output := dns.RecordSetProperties{
+		TTL: utils.Int64(input.TTL),
+	}
+
+	// Azure DNS stores fully qualified domain names without the trailing dot
+	trimmed := func(v string) *string {
+		return utils.String(strings.TrimSuffix(v, "."))
+	}
+
+	switch input.Type {
+	case string(dns.A):
+		records := make([]dns.ARecord, 0)
+		for _, r := range input.Records {
+			records = append(records, dns.ARecord{Ipv4Address: utils.String(r[0])})
+		}
+		output.ARecords = &records
+
+	case string(dns.AAAA):
+		records := make([]dns.AaaaRecord, 0)
+		for _, r := range input.Records {
+			records = append(records, dns.AaaaRecord{Ipv6Address: utils.String(r[0])})
+		}
+		output.AaaaRecords = &records
+
+	case string(dns.CAA):
+		records := make([]dns.CaaRecord, 0)
+		for _, r := range input.Records {
+			flags, err := strconv.ParseInt(r[0], 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, dns.CaaRecord{
+				Flags: utils.Int32(int32(flags)),
+				Tag:   utils.String(r[1]),
+				Value: utils.String(r[2]),
+			})
+		}
+		output.CaaRecords = &records
+
+	case string(dns.CNAME):
+		output.CnameRecord = &dns.CnameRecord{
+			Cname: trimmed(input.Records[0][0]),
+		}
+
+	case string(dns.MX):
+		records := make([]dns.MxRecord, 0)
+		for _, r := range input.Records {
+			preference, err := strconv.ParseInt(r[0], 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, dns.MxRecord{
+				Preference: utils.Int32(int32(preference)),
+				Exchange:   trimmed(r[1]),
+			})
+		}
+		output.MxRecords = &records
+
+	case string(dns.NS):
+		records := make([]dns.NsRecord, 0)
+		for _, r := range input.Records {
+			records = append(records, dns.NsRecord{Nsdname: trimmed(r[0])})
+		}
+		output.NsRecords = &records
+
+	case string(dns.PTR):
+		records := make([]dns.PtrRecord, 0)
+		for _, r := range input.Records {
+			records = append(records, dns.PtrRecord{Ptrdname: trimmed(r[0])})
+		}
+		output.PtrRecords = &records
+
+	case string(dns.SRV):
+		records := make([]dns.SrvRecord, 0)
+		for _, r := range input.Records {
+			values := make([]int32, 0)
+			for _, v := range r[0:3] {
+				i, err := strconv.ParseInt(v, 10, 32)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, int32(i))
+			}
+			records = append(records, dns.SrvRecord{
+				Priority: utils.Int32(values[0]),
+				Weight:   utils.Int32(values[1]),
+				Port:     utils.Int32(values[2]),
+				Target:   trimmed(r[3]),
+			})
+		}
+		output.SrvRecords = &records
+
+	case string(dns.TXT):
+		records := make([]dns.TxtRecord, 0)
+		for _, r := range input.Records {
+			value := make([]string, len(r))
+			copy(value, r)
+			records = append(records, dns.TxtRecord{Value: &value})
+		}
+		output.TxtRecords = &records
+
+	default:
+		return nil, fmt.Errorf("the record type %q cannot be managed through a zone file", input.Type)
+	}
+
+	return &output, nil
+}
+
+func flattenDnsZoneFileRecordSet(input dns.RecordSet) (*zonefile.RecordSet, error) {
+	name, recordType := dnsZoneRecordSetNameAndType(input)
+	output := zonefile.RecordSet{
+		Name:    name,
+		Type:    recordType,
+		Records: make([][]string, 0),
+	}
+
+	props := input.RecordSetProperties
+	if props == nil {
+		return &output, nil
+	}
+	if props.TTL != nil {
+		output.TTL = *props.TTL
+	}
+
+	// the domain names returned from Azure DNS are fully qualified, but may omit the trailing dot
+	fqdn := func(v *string) string {
+		return zonefile.Fqdn(utils.NormalizeNilableString(v))
+	}
+	integer := func(v *int32) string {
+		if v == nil {
+			return "0"
+		}
+		return strconv.Itoa(int(*v))
+	}
+
+	switch recordType {
+	case string(dns.A):
+		if props.ARecords != nil {
+			for _, r := range *props.ARecords {
+				output.Records = append(output.Records, []string{utils.NormalizeNilableString(r.Ipv4Address)})
+			}
+		}
+
+	case string(dns.AAAA):
+		if props.AaaaRecords != nil {
+			for _, r := range *props.AaaaRecords {
+				output.Records = append(output.Records, []string{utils.NormalizeNilableString(r.Ipv6Address)})
+			}
+		}
+
+	case string(dns.CAA):
+		if props.CaaRecords != nil {
+			for _, r := range *props.CaaRecords {
+				output.Records = append(output.Records, []string{integer(r.Flags), utils.NormalizeNilableString(r.Tag), utils.NormalizeNilableString(r.Value)})
+			}
+		}
+
+	case string(dns.CNAME):
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			output.Records = append(output.Records, []string{fqdn(props.CnameRecord.Cname)})
+		}
+
+	case string(dns.MX):
+		if props.MxRecords != nil {
+			for _, r := range *props.MxRecords {
+				output.Records = append(output.Records, []string{integer(r.Preference), fqdn(r.Exchange)})
+			}
+		}
+
+	case string(dns.NS):
+		if props.NsRecords != nil {
+			for _, r := range *props.NsRecords {
+				output.Records = append(output.Records, []string{fqdn(r.Nsdname)})
+			}
+		}
+
+	case string(dns.PTR):
+		if props.PtrRecords != nil {
+			for _, r := range *props.PtrRecords {
+				output.Records = append(output.Records, []string{fqdn(r.Ptrdname)})
+			}
+		}
+
+	case string(dns.SOA):
+		if r := props.SoaRecord; r != nil {
+			int64Value := func(v *int64) string {
+				if v == nil {
+					return "0"
+				}
+				return strconv.FormatInt(*v, 10)
+			}
+			output.Records = append(output.Records, []string{
+				fqdn(r.Host),
+				fqdn(r.Email),
+				int64Value(r.SerialNumber),
+				int64Value(r.RefreshTime),
+				int64Value(r.RetryTime),
+				int64Value(r.ExpireTime),
+				int64Value(r.MinimumTTL),
+			})
+		}
+
+	case string(dns.SRV):
+		if props.SrvRecords != nil {
+			for _, r := range *props.SrvRecords {
+				output.Records = append(output.Records, []string{integer(r.Priority), integer(r.Weight), integer(r.Port), fqdn(r.Target)})
+			}
+		}
+
+	case string(dns.TXT):
+		if props.TxtRecords != nil {
+			for _, r := range *props.TxtRecords {
+				value := make([]string, 0)
+				if r.Value != nil {
+					value = append(value, *r.Value...)
+				}
+				output.Records = append(output.Records, value)
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("the %s record set %q cannot be represented in a zone file", recordType, name)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,423 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneRecordsResource struct{}
+
+func TestAccDnsZoneRecords_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("www", dns.A)),
+				data.CheckWithClient(r.recordSetExists("@", dns.MX)),
+			),
+		},
+		data.ImportStep("zone_file", "managed_record_sets"),
+	})
+}
+
+func TestAccDnsZoneRecords_bindImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.bindImport(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("www", dns.A)),
+				data.CheckWithClient(r.recordSetExists("mail", dns.AAAA)),
+				data.CheckWithClient(r.recordSetExists("@", dns.CAA)),
+				data.CheckWithClient(r.recordSetExists("docs", dns.CNAME)),
+				data.CheckWithClient(r.recordSetExists("@", dns.MX)),
+				data.CheckWithClient(r.recordSetExists("sub", dns.NS)),
+				data.CheckWithClient(r.recordSetExists("_sip._tcp", dns.SRV)),
+				data.CheckWithClient(r.recordSetExists("@", dns.TXT)),
+			),
+		},
+		data.ImportStep("zone_file", "managed_record_sets"),
+	})
+}
+
+func TestAccDnsZoneRecords_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("www", dns.A)),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("api", dns.A)),
+				// record sets removed from the zone file are removed from the zone
+				data.CheckWithClient(r.recordSetDoesNotExist("www", dns.A)),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_retainsUnlistedRecordSets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withUnlistedRecordSet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("unlisted", dns.A)),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_deleteUnlistedRecordSets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteUnlisted(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createUnlistedRecordSet),
+			),
+		},
+		{
+			Config: r.deleteUnlisted(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("www", dns.A)),
+				data.CheckWithClient(r.recordSetDoesNotExist("unlisted", dns.A)),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_importRetainsUnlistedRecordSets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteUnlisted(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createUnlistedRecordSet),
+			),
+		},
+		{
+			// when imported the `zone_file` lists every record set within the zone, none of which are managed
+			ResourceName:     data.ResourceName,
+			ImportState:      true,
+			ImportStateCheck: r.importedRecordSetsAreUnmanaged("unlisted"),
+		},
+		{
+			Config: r.partiallyListed(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("www", dns.A)),
+				data.CheckWithClient(r.recordSetExists("unlisted", dns.A)),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_importThenDestroyRetainsRecordSets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteUnlisted(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createUnlistedRecordSet),
+			),
+		},
+		{
+			// destroying the imported resource mustn't delete the record sets listed in the imported `zone_file`
+			ResourceName:     data.ResourceName,
+			ImportState:      true,
+			ImportStateCheck: r.destroyImportedResource,
+		},
+		{
+			Config: r.deleteUnlisted(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetExists("www", dns.A)),
+				data.CheckWithClient(r.recordSetExists("unlisted", dns.A)),
+			),
+		},
+	})
+}
+
+func (DnsZoneRecordsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.ZonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ZoneProperties != nil), nil
+}
+
+func (DnsZoneRecordsResource) recordSetExists(name string, recordType dns.RecordType) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		return checkDnsZoneRecordSet(ctx, clients, state, name, recordType, true)
+	}
+}
+
+func (DnsZoneRecordsResource) recordSetDoesNotExist(name string, recordType dns.RecordType) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		return checkDnsZoneRecordSet(ctx, clients, state, name, recordType, false)
+	}
+}
+
+// createUnlistedRecordSet creates an A record set outside of Terraform, which isn't listed in the zone file
+func (DnsZoneRecordsResource) createUnlistedRecordSet(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.DnsZoneID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	parameters := dns.RecordSet{
+		RecordSetProperties: &dns.RecordSetProperties{
+			TTL: utils.Int64(300),
+			ARecords: &[]dns.ARecord{
+				{Ipv4Address: utils.String("10.0.0.10")},
+			},
+		},
+	}
+	if _, err := clients.Dns.RecordSetsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, "unlisted", dns.A, parameters, "", ""); err != nil {
+		return fmt.Errorf("creating A record set %q within %s: %+v", "unlisted", *id, err)
+	}
+
+	return nil
+}
+
+func (DnsZoneRecordsResource) importedRecordSetsAreUnmanaged(name string) func([]*pluginsdk.InstanceState) error {
+	return func(states []*pluginsdk.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected a single imported resource but got %d", len(states))
+		}
+		attributes := states[0].Attributes
+
+		if !strings.Contains(attributes["zone_file"], name) {
+			return fmt.Errorf("expected the imported `zone_file` to list the %q record set but got %q", name, attributes["zone_file"])
+		}
+		if count := attributes["managed_record_sets.#"]; count != "" && count != "0" {
+			return fmt.Errorf("expected no `managed_record_sets` to be imported but got %s", count)
+		}
+
+		return nil
+	}
+}
+
+// destroyImportedResource destroys the imported resource, as `terraform destroy` would following `terraform import`
+func (DnsZoneRecordsResource) destroyImportedResource(states []*pluginsdk.InstanceState) error {
+	if len(states) != 1 {
+		return fmt.Errorf("expected a single imported resource but got %d", len(states))
+	}
+
+	client, err := testclient.Build()
+	if err != nil {
+		return fmt.Errorf("building client: %+v", err)
+	}
+
+	resource := provider.TestAzureProvider().ResourcesMap["azurerm_dns_zone_records"]
+	if _, diags := resource.Apply(context.TODO(), states[0], &terraform.InstanceDiff{Destroy: true}, client); diags.HasError() {
+		return fmt.Errorf("destroying the imported resource: %+v", diags)
+	}
+
+	return nil
+}
+
+func checkDnsZoneRecordSet(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState, name string, recordType dns.RecordType, shouldExist bool) error {
+	id, err := parse.DnsZoneID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.Name, name, recordType)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			if shouldExist {
+				return fmt.Errorf("%s record set %q was not found within %s", recordType, name, *id)
+			}
+			return nil
+		}
+		return fmt.Errorf("retrieving %s record set %q within %s: %+v", recordType, name, *id, err)
+	}
+
+	if !shouldExist {
+		return fmt.Errorf("%s record set %q still exists within %s", recordType, name, *id)
+	}
+
+	return nil
+}
+
+func (DnsZoneRecordsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsZoneRecordsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+www 300 IN A  10.0.0.1
+www 300 IN A  10.0.0.2
+@   300 IN MX 10 mail.example.com.
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+api 600 IN A  10.0.0.3
+@   300 IN MX 20 mail.example.com.
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) bindImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  default_ttl = 600
+  zone_file   = <<ZONE
+$ORIGIN ${azurerm_dns_zone.test.name}.
+$TTL 1h
+; the SOA and apex NS records are managed by Azure and so are ignored
+@         IN SOA   ns1.example.com. hostmaster.example.com. (
+                   2022010101 ; serial
+                   1h         ; refresh
+                   15m        ; retry
+                   1w         ; expire
+                   5m )       ; minimum
+@         IN NS    ns1.example.com.
+@         IN NS    ns2.example.com.
+
+@         IN MX    10 mail
+@         IN MX    20 mail.example.net.
+@         IN TXT   "v=spf1 mx -all"
+@         IN CAA   0 issue "letsencrypt.org"
+www       IN A     10.0.0.1
+          IN A     10.0.0.2
+mail  300 IN AAAA  2001:db8::1
+docs      IN CNAME www
+sub       IN NS    ns1.example.net.
+_sip._tcp IN SRV   10 60 5060 sip.example.net.
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) withUnlistedRecordSet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_a_record" "unlisted" {
+  name                = "unlisted"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["10.0.0.10"]
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+www 300 IN A 10.0.0.1
+ZONE
+
+  depends_on = [azurerm_dns_a_record.unlisted]
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) deleteUnlisted(data acceptance.TestData, deleteUnlisted bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id                 = azurerm_dns_zone.test.id
+  delete_unlisted_record_sets = %t
+  zone_file                   = <<ZONE
+www 300 IN A 10.0.0.1
+ZONE
+}
+`, r.template(data), deleteUnlisted)
+}
+
+func (r DnsZoneRecordsResource) partiallyListed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+www 300 IN A 10.0.0.1
+www 300 IN A 10.0.0.2
+ZONE
+}
+`, r.template(data))
+}
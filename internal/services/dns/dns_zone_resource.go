@@ -189,7 +189,7 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		rsParameters := dns.RecordSet{
 			RecordSetProperties: &dns.RecordSetProperties{
 				TTL:       utils.Int64(int64(soaRecord["ttl"].(int))),
-				Metadata:  tags.Expand(soaRecord["tags"].(map[string]interface{})),
+				Metadata:  tags.ExpandWithoutDefaultTags(soaRecord["tags"].(map[string]interface{})),
 				SoaRecord: expandArmDNSZoneSOARecord(soaRecord),
 			},
 		}
@@ -71,6 +71,29 @@ func TestAccDnsZone_withTags(t *testing.T) {
 	})
 }
 
+func TestAccDnsZone_ignoredTagsChangedOutsideOfTerraform(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone", "test")
+	r := DnsZoneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withIgnoredTags(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.setTag("ms-resource-usage", "changed-outside-of-terraform")),
+			),
+		},
+		{
+			Config: r.withIgnoredTags(data, "staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				data.CheckWithClient(r.hasTag("ms-resource-usage", "changed-outside-of-terraform")),
+			),
+		},
+	})
+}
+
 func TestAccDnsZone_withSOARecord(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_dns_zone", "test")
 	r := DnsZoneResource{}
@@ -114,6 +137,47 @@ func (DnsZoneResource) Exists(ctx context.Context, clients *clients.Client, stat
 	return utils.Bool(resp.ZoneProperties != nil), nil
 }
 
+func (DnsZoneResource) setTag(key, value string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.DnsZoneID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := clients.Dns.ZonesClient.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if existing.Tags == nil {
+			existing.Tags = make(map[string]*string)
+		}
+		existing.Tags[key] = utils.String(value)
+
+		if _, err := clients.Dns.ZonesClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing, "", ""); err != nil {
+			return fmt.Errorf("updating the Tags for %s: %+v", *id, err)
+		}
+		return nil
+	}
+}
+
+func (DnsZoneResource) hasTag(key, expected string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.DnsZoneID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.Dns.ZonesClient.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if v, ok := resp.Tags[key]; !ok || v == nil || *v != expected {
+			return fmt.Errorf("expected the Tag %q on %s to be %q but got %+v", key, *id, expected, resp.Tags)
+		}
+		return nil
+	}
+}
+
 func (DnsZoneResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -189,6 +253,32 @@ resource "azurerm_dns_zone" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (DnsZoneResource) withIgnoredTags(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  ignore_tags {
+    keys = ["ms-resource-usage"]
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+
+  tags = {
+    environment = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, environment)
+}
+
 func (DnsZoneResource) withBasicSOARecord(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -18,6 +18,13 @@ func (r Registration) Name() string {
 	return "DNS"
 }
 
+// ResourceProviders returns the Resource Providers required by the Resources within this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Network",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -28,7 +35,18 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_dns_zone": dataSourceDnsZone(),
+		"azurerm_dns_a_record":     dataSourceDnsARecord(),
+		"azurerm_dns_aaaa_record":  dataSourceDnsAAAARecord(),
+		"azurerm_dns_caa_record":   dataSourceDnsCaaRecord(),
+		"azurerm_dns_cname_record": dataSourceDnsCNameRecord(),
+		"azurerm_dns_mx_record":    dataSourceDnsMxRecord(),
+		"azurerm_dns_ns_record":    dataSourceDnsNsRecord(),
+		"azurerm_dns_ptr_record":   dataSourceDnsPtrRecord(),
+		"azurerm_dns_records":      dataSourceDnsRecords(),
+		"azurerm_dns_srv_record":   dataSourceDnsSrvRecord(),
+		"azurerm_dns_txt_record":   dataSourceDnsTxtRecord(),
+		"azurerm_dns_zone":         dataSourceDnsZone(),
+		"azurerm_dns_zone_file":    dataSourceDnsZoneFile(),
 	}
 }
 
@@ -45,5 +63,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_dns_srv_record":   resourceDnsSrvRecord(),
 		"azurerm_dns_txt_record":   resourceDnsTxtRecord(),
 		"azurerm_dns_zone":         resourceDnsZone(),
+		"azurerm_dns_zone_records": resourceDnsZoneRecords(),
 	}
 }
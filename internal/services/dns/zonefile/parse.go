@@ -0,0 +1,457 @@
+package zonefile
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+)
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+type logicalLine struct {
+	number          int
+	tokens          []token
+	startsWithBlank bool
+}
+
+// Parse parses the RFC 1035 (BIND) formatted Zone File for the Zone named `origin`, returning the record
+// sets within it. Records without a TTL use the `$TTL` directive, the previously specified TTL, or else `defaultTTL`.
+func Parse(origin string, defaultTTL int64, input string) ([]RecordSet, error) {
+	lines, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneOrigin := Fqdn(strings.ToLower(origin))
+	currentOrigin := zoneOrigin
+	currentTTL := int64(-1)
+	lastTTL := int64(-1)
+	lastOwner := ""
+
+	sets := make([]RecordSet, 0)
+	indexes := make(map[string]int)
+
+	for _, line := range lines {
+		tokens := line.tokens
+		first := tokens[0]
+
+		if !first.quoted && strings.HasPrefix(first.value, "$") {
+			directive := strings.ToUpper(first.value)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$ORIGIN` expects a single domain name", line.number)
+				}
+				currentOrigin = resolveName(tokens[1].value, currentOrigin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$TTL` expects a single value", line.number)
+				}
+				ttl, err := parseTTL(tokens[1].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", line.number, err)
+				}
+				currentTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: the directive %q is not supported", line.number, first.value)
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !line.startsWithBlank {
+			owner = resolveName(first.value, currentOrigin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: the first record must specify an owner name", line.number)
+		}
+		lastOwner = owner
+
+		name, err := relativeName(owner, zoneOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", line.number, err)
+		}
+
+		ttl := int64(-1)
+		recordType := ""
+		for len(tokens) > 0 && recordType == "" {
+			value := tokens[0].value
+			tokens = tokens[1:]
+
+			if v, err := parseTTL(value); err == nil && ttl == -1 {
+				ttl = v
+				continue
+			}
+
+			switch strings.ToUpper(value) {
+			case "IN":
+				continue
+			case "CH", "CS", "HS", "NONE", "ANY":
+				return nil, fmt.Errorf("line %d: only the `IN` class is supported but got %q", line.number, value)
+			}
+
+			recordType = strings.ToUpper(value)
+		}
+		if recordType == "" {
+			return nil, fmt.Errorf("line %d: expected a record type", line.number)
+		}
+
+		if ttl == -1 {
+			switch {
+			case currentTTL != -1:
+				ttl = currentTTL
+			case lastTTL != -1:
+				ttl = lastTTL
+			default:
+				ttl = defaultTTL
+			}
+		} else {
+			lastTTL = ttl
+		}
+
+		record, err := parseRecordData(recordType, tokens, currentOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: parsing %s record for %q: %+v", line.number, recordType, name, err)
+		}
+
+		if recordType == "SOA" && name != "@" {
+			return nil, fmt.Errorf("line %d: the SOA record must be at the apex of the zone but got %q", line.number, name)
+		}
+
+		rs := RecordSet{
+			Name: name,
+			Type: recordType,
+		}
+		index, exists := indexes[rs.Key()]
+		if !exists {
+			rs.TTL = ttl
+			sets = append(sets, rs)
+			index = len(sets) - 1
+			indexes[rs.Key()] = index
+		}
+
+		existing := &sets[index]
+		if existing.TTL != ttl {
+			return nil, fmt.Errorf("line %d: the %s records for %q must all have the same TTL but got %d and %d", line.number, recordType, name, existing.TTL, ttl)
+		}
+		if containsRecord(existing.Records, record) {
+			continue
+		}
+		if len(existing.Records) > 0 && (recordType == "CNAME" || recordType == "SOA") {
+			return nil, fmt.Errorf("line %d: only a single %s record can exist for %q", line.number, recordType, name)
+		}
+		existing.Records = append(existing.Records, record)
+	}
+
+	return sets, nil
+}
+
+func tokenize(input string) ([]logicalLine, error) {
+	lines := make([]logicalLine, 0)
+
+	lineNumber := 1
+	current := logicalLine{number: 1}
+	atLineStart := true
+	depth := 0
+
+	var builder strings.Builder
+	inToken := false
+	flush := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token{value: builder.String()})
+			builder.Reset()
+			inToken = false
+		}
+	}
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		if atLineStart && depth == 0 {
+			current = logicalLine{
+				number:          lineNumber,
+				startsWithBlank: c == ' ' || c == '\t',
+			}
+		}
+		atLineStart = false
+
+		switch {
+		case c == '\n':
+			flush()
+			lineNumber++
+			if depth == 0 {
+				if len(current.tokens) > 0 {
+					lines = append(lines, current)
+				}
+				atLineStart = true
+			}
+
+		case c == ';':
+			flush()
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+
+		case c == '(':
+			flush()
+			depth++
+
+		case c == ')':
+			flush()
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("line %d: unexpected `)`", lineNumber)
+			}
+
+		case c == '"':
+			flush()
+			value, consumed, err := readQuoted(runes[i+1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %+v", lineNumber, err)
+			}
+			current.tokens = append(current.tokens, token{value: value, quoted: true})
+			i += consumed
+
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+
+		case c == '\\' && i+1 < len(runes):
+			// an escaped character within an unquoted token is kept as-is
+			builder.WriteRune(c)
+			builder.WriteRune(runes[i+1])
+			inToken = true
+			i++
+
+		default:
+			builder.WriteRune(c)
+			inToken = true
+		}
+	}
+
+	flush()
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: expected `)` before the end of the zone file", lineNumber)
+	}
+	if len(current.tokens) > 0 {
+		lines = append(lines, current)
+	}
+
+	return lines, nil
+}
+
+// readQuoted reads a quoted character string, returning the unescaped value and the number of runes consumed
+// including the closing quote
+func readQuoted(runes []rune) (string, int, error) {
+	var builder strings.Builder
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch c {
+		case '"':
+			return builder.String(), i + 1, nil
+
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated quoted string")
+
+		case '\\':
+			if i+1 >= len(runes) {
+				return "", 0, fmt.Errorf("unterminated quoted string")
+			}
+			// `\DDD` is a decimal escape, otherwise the next character is used literally
+			if i+3 < len(runes) && isDigit(runes[i+1]) && isDigit(runes[i+2]) && isDigit(runes[i+3]) {
+				v, _ := strconv.Atoi(string(runes[i+1 : i+4]))
+				if v > 255 {
+					return "", 0, fmt.Errorf("invalid escape sequence `\\%s`", string(runes[i+1:i+4]))
+				}
+				builder.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+			builder.WriteRune(runes[i+1])
+			i++
+
+		default:
+			builder.WriteRune(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+func parseRecordData(recordType string, tokens []token, origin string) ([]string, error) {
+	expected, ok := supportedTypes[recordType]
+	if !ok {
+		return nil, fmt.Errorf("the record type %q is not supported by Azure DNS", recordType)
+	}
+
+	if expected == -1 && len(tokens) == 0 {
+		return nil, fmt.Errorf("expected at least one value")
+	}
+	if expected != -1 && len(tokens) != expected {
+		return nil, fmt.Errorf("expected %d values but got %d", expected, len(tokens))
+	}
+
+	fields := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		fields = append(fields, t.value)
+	}
+
+	for _, i := range domainNameFields[recordType] {
+		fields[i] = resolveName(fields[i], origin)
+	}
+
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(fields[0]); ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("%q is not a valid IPv4 address", fields[0])
+		}
+
+	case "AAAA":
+		if ip := net.ParseIP(fields[0]); ip == nil || !strings.Contains(fields[0], ":") {
+			return nil, fmt.Errorf("%q is not a valid IPv6 address", fields[0])
+		}
+
+	case "CAA":
+		if err := validateInteger(fields[0], math.MaxUint8); err != nil {
+			return nil, fmt.Errorf("flags: %+v", err)
+		}
+		if fields[1] == "" {
+			return nil, fmt.Errorf("the tag must not be empty")
+		}
+
+	case "MX":
+		if err := validateInteger(fields[0], math.MaxUint16); err != nil {
+			return nil, fmt.Errorf("preference: %+v", err)
+		}
+
+	case "SOA":
+		if err := validateInteger(fields[2], math.MaxUint32); err != nil {
+			return nil, fmt.Errorf("serial: %+v", err)
+		}
+		// the refresh, retry, expire and minimum values can be specified using TTL units
+		for i := 3; i < 7; i++ {
+			v, err := parseTTL(fields[i])
+			if err != nil {
+				return nil, err
+			}
+			fields[i] = strconv.FormatInt(v, 10)
+		}
+
+	case "SRV":
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateInteger(fields[i], math.MaxUint16); err != nil {
+				return nil, fmt.Errorf("%s: %+v", name, err)
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+// parseTTL parses a TTL in either seconds or using the BIND units, for example `1h30m`
+func parseTTL(input string) (int64, error) {
+	if input == "" {
+		return 0, fmt.Errorf("the TTL must not be empty")
+	}
+
+	if v, err := strconv.ParseInt(input, 10, 64); err == nil {
+		if v < 0 || v > math.MaxInt32 {
+			return 0, fmt.Errorf("the TTL %q must be between 0 and %d", input, math.MaxInt32)
+		}
+		return v, nil
+	}
+
+	units := map[byte]int64{
+		's': 1,
+		'm': 60,
+		'h': 60 * 60,
+		'd': 24 * 60 * 60,
+		'w': 7 * 24 * 60 * 60,
+	}
+
+	total := int64(0)
+	number := ""
+	for _, c := range []byte(strings.ToLower(input)) {
+		if isDigit(rune(c)) {
+			number += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		v, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += v * multiplier
+		number = ""
+	}
+	if number != "" {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+
+	if total > math.MaxInt32 {
+		return 0, fmt.Errorf("the TTL %q must be between 0 and %d", input, math.MaxInt32)
+	}
+
+	return total, nil
+}
+
+// resolveName returns the fully qualified form of the (potentially relative) domain name
+func resolveName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	if origin == "." {
+		return name + "."
+	}
+	return name + "." + origin
+}
+
+// relativeName returns the name relative to the origin, where `@` is the origin itself
+func relativeName(fqdn, origin string) (string, error) {
+	lower := strings.ToLower(fqdn)
+	if lower == origin {
+		return "@", nil
+	}
+
+	if !strings.HasSuffix(lower, "."+origin) {
+		return "", fmt.Errorf("the name %q is outside of the zone %q", fqdn, origin)
+	}
+
+	return lower[:len(lower)-len(origin)-1], nil
+}
+
+func validateInteger(input string, max uint64) error {
+	if _, err := strconv.ParseUint(input, 10, 64); err != nil {
+		return fmt.Errorf("%q is not a valid integer", input)
+	}
+	if v, _ := strconv.ParseUint(input, 10, 64); v > max {
+		return fmt.Errorf("%q must be at most %d", input, max)
+	}
+	return nil
+}
+
+func containsRecord(records [][]string, record []string) bool {
+	for _, existing := range records {
+		if strings.Join(existing, "\x00") == strings.Join(record, "\x00") {
+			return true
+		}
+	}
+	return false
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,298 @@
+package zonefile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected []RecordSet
+		Error    bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: []RecordSet{},
+		},
+		{
+			Name: "Comments Only",
+			Input: `
+; this is a comment
+   ; so is this
+`,
+			Expected: []RecordSet{},
+		},
+		{
+			Name:  "Relative Name with Default TTL",
+			Input: "www IN A 10.0.0.1",
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 3600, Records: [][]string{{"10.0.0.1"}}},
+			},
+		},
+		{
+			Name:  "Absolute Name",
+			Input: "www.example.com. 300 IN A 10.0.0.1",
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+			},
+		},
+		{
+			Name:  "Apex",
+			Input: "@ 300 IN A 10.0.0.1",
+			Expected: []RecordSet{
+				{Name: "@", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+			},
+		},
+		{
+			Name:  "Name outside of the Zone",
+			Input: "www.example.org. 300 IN A 10.0.0.1",
+			Error: true,
+		},
+		{
+			Name:  "Class and TTL in either order",
+			Input: "www IN 300 A 10.0.0.1\napi 300 IN A 10.0.0.2",
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+				{Name: "api", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.2"}}},
+			},
+		},
+		{
+			Name:  "Unsupported Class",
+			Input: "www 300 CH A 10.0.0.1",
+			Error: true,
+		},
+		{
+			Name: "Records grouped into a Record Set",
+			Input: `
+www 300 IN A 10.0.0.1
+www 300 IN A 10.0.0.2
+    300 IN A 10.0.0.3
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}, {"10.0.0.2"}, {"10.0.0.3"}}},
+			},
+		},
+		{
+			Name: "Duplicate Records are ignored",
+			Input: `
+www 300 IN A 10.0.0.1
+www 300 IN A 10.0.0.1
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+			},
+		},
+		{
+			Name: "Differing TTLs within a Record Set",
+			Input: `
+www 300 IN A 10.0.0.1
+www 600 IN A 10.0.0.2
+`,
+			Error: true,
+		},
+		{
+			Name:  "Blank Owner on the first Record",
+			Input: "    300 IN A 10.0.0.1",
+			Error: true,
+		},
+		{
+			Name: "Directives",
+			Input: `
+$ORIGIN example.com.
+$TTL 1h
+www IN A 10.0.0.1
+$ORIGIN internal.example.com.
+api IN A 10.0.0.2
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 3600, Records: [][]string{{"10.0.0.1"}}},
+				{Name: "api.internal", Type: "A", TTL: 3600, Records: [][]string{{"10.0.0.2"}}},
+			},
+		},
+		{
+			Name:  "Unsupported Directive",
+			Input: "$INCLUDE other.zone",
+			Error: true,
+		},
+		{
+			Name: "Previous TTL is used when there's no TTL directive",
+			Input: `
+www 300 IN A 10.0.0.1
+api IN A 10.0.0.2
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+				{Name: "api", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.2"}}},
+			},
+		},
+		{
+			Name: "SOA over multiple lines",
+			Input: `
+@ 3600 IN SOA ns1.example.com. hostmaster.example.com. (
+    2022010101 ; serial
+    1h         ; refresh
+    300        ; retry
+    1w         ; expire
+    300 )      ; minimum
+`,
+			Expected: []RecordSet{
+				{Name: "@", Type: "SOA", TTL: 3600, Records: [][]string{{"ns1.example.com.", "hostmaster.example.com.", "2022010101", "3600", "300", "604800", "300"}}},
+			},
+		},
+		{
+			Name:  "SOA outside of the Apex",
+			Input: "www 3600 IN SOA ns1.example.com. hostmaster.example.com. 1 3600 300 604800 300",
+			Error: true,
+		},
+		{
+			Name:  "Unbalanced Parentheses",
+			Input: "@ 3600 IN SOA ns1.example.com. hostmaster.example.com. ( 1 3600 300 604800 300",
+			Error: true,
+		},
+		{
+			Name: "Domain Names are resolved",
+			Input: `
+www  300 IN CNAME web
+mail 300 IN CNAME mail.example.org.
+@    300 IN MX 10 mail
+@    300 IN NS ns1.example.net.
+_sip._tcp 300 IN SRV 10 60 5060 sip
+1    300 IN PTR host.example.com.
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: "CNAME", TTL: 300, Records: [][]string{{"web.example.com."}}},
+				{Name: "mail", Type: "CNAME", TTL: 300, Records: [][]string{{"mail.example.org."}}},
+				{Name: "@", Type: "MX", TTL: 300, Records: [][]string{{"10", "mail.example.com."}}},
+				{Name: "@", Type: "NS", TTL: 300, Records: [][]string{{"ns1.example.net."}}},
+				{Name: "_sip._tcp", Type: "SRV", TTL: 300, Records: [][]string{{"10", "60", "5060", "sip.example.com."}}},
+				{Name: "1", Type: "PTR", TTL: 300, Records: [][]string{{"host.example.com."}}},
+			},
+		},
+		{
+			Name: "Multiple CNAME Records",
+			Input: `
+www 300 IN CNAME web1
+www 300 IN CNAME web2
+`,
+			Error: true,
+		},
+		{
+			Name: "Quoted Strings",
+			Input: `
+@   300 IN TXT "v=spf1 include:example.net ~all"
+txt 300 IN TXT "first" "second ; not a comment" "quote \" and backslash \\" "\065BC"
+@   300 IN CAA 0 issue "letsencrypt.org"
+`,
+			Expected: []RecordSet{
+				{Name: "@", Type: "TXT", TTL: 300, Records: [][]string{{"v=spf1 include:example.net ~all"}}},
+				{Name: "txt", Type: "TXT", TTL: 300, Records: [][]string{{"first", "second ; not a comment", "quote \" and backslash \\", "ABC"}}},
+				{Name: "@", Type: "CAA", TTL: 300, Records: [][]string{{"0", "issue", "letsencrypt.org"}}},
+			},
+		},
+		{
+			Name:  "Unterminated Quoted String",
+			Input: `@ 300 IN TXT "hello`,
+			Error: true,
+		},
+		{
+			Name:  "Unsupported Record Type",
+			Input: "@ 300 IN DNSKEY 257 3 8 AwEAAa",
+			Error: true,
+		},
+		{
+			Name:  "Invalid IPv4 Address",
+			Input: "www 300 IN A 10.0.0.256",
+			Error: true,
+		},
+		{
+			Name:  "IPv4 Address in an AAAA Record",
+			Input: "www 300 IN AAAA 10.0.0.1",
+			Error: true,
+		},
+		{
+			Name:  "Invalid MX Preference",
+			Input: "@ 300 IN MX 70000 mail",
+			Error: true,
+		},
+		{
+			Name:  "Wrong number of values",
+			Input: "_sip._tcp 300 IN SRV 10 60 sip",
+			Error: true,
+		},
+		{
+			Name:  "Windows Line Endings",
+			Input: "www 300 IN A 10.0.0.1\r\napi 300 IN AAAA 2001:db8::1\r\n",
+			Expected: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+				{Name: "api", Type: "AAAA", TTL: 300, Records: [][]string{{"2001:db8::1"}}},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := Parse("example.com", 3600, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected int64
+		Error    bool
+	}{
+		{Input: "", Error: true},
+		{Input: "0", Expected: 0},
+		{Input: "300", Expected: 300},
+		{Input: "-1", Error: true},
+		{Input: "1h", Expected: 3600},
+		{Input: "1H30M", Expected: 5400},
+		{Input: "1w2d", Expected: 777600},
+		{Input: "10", Expected: 10},
+		{Input: "h", Error: true},
+		{Input: "1x", Error: true},
+		{Input: "1h30", Error: true},
+		{Input: "IN", Error: true},
+		{Input: "4294967296", Error: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseTTL(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
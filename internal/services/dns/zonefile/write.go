@@ -0,0 +1,56 @@
+package zonefile
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// Write renders the record sets as an RFC 1035 (BIND) formatted Zone File for the Zone named `origin`,
+// with the owner names relative to the origin and the domain names within the records fully qualified
+func Write(origin string, sets []RecordSet) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("$ORIGIN %s\n", Fqdn(strings.ToLower(origin))))
+
+	w := tabwriter.NewWriter(&buf, 0, 8, 1, ' ', 0)
+	for _, rs := range Normalize(sets) {
+		for _, record := range rs.Records {
+			fmt.Fprintf(w, "%s\t%d\tIN\t%s\t%s\n", rs.Name, rs.TTL, rs.Type, FormatRecordData(rs.Type, record))
+		}
+	}
+	w.Flush()
+
+	return buf.String()
+}
+
+// FormatRecordData renders the fields of a single record in the presentation format used within a Zone File
+func FormatRecordData(recordType string, fields []string) string {
+	output := make([]string, 0, len(fields))
+	for i, field := range fields {
+		if recordType == "TXT" || (recordType == "CAA" && i == 2) {
+			field = quote(field)
+		}
+		output = append(output, field)
+	}
+	return strings.Join(output, " ")
+}
+
+// quote returns the input as a quoted character string, escaping quotes, backslashes and non-printable characters
+func quote(input string) string {
+	var builder strings.Builder
+	builder.WriteByte('"')
+	for _, c := range []byte(input) {
+		switch {
+		case c == '"' || c == '\\':
+			builder.WriteByte('\\')
+			builder.WriteByte(c)
+		case c < 0x20 || c > 0x7e:
+			builder.WriteString(fmt.Sprintf("\\%03d", c))
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	builder.WriteByte('"')
+	return builder.String()
+}
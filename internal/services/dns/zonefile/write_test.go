@@ -0,0 +1,62 @@
+package zonefile
+
+import (
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	input := []RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.2"}, {"10.0.0.1"}}},
+		{Name: "@", Type: "TXT", TTL: 3600, Records: [][]string{{"v=spf1 -all"}, {"quote \" here", "second"}}},
+		{Name: "@", Type: "SOA", TTL: 3600, Records: [][]string{{"ns1-01.azure-dns.com.", "azuredns-hostmaster.microsoft.com", "1", "3600", "300", "2419200", "300"}}},
+		{Name: "@", Type: "CAA", TTL: 300, Records: [][]string{{"0", "issue", "letsencrypt.org"}}},
+		{Name: "API", Type: "CNAME", TTL: 60, Records: [][]string{{"Web.Example.com"}}},
+	}
+
+	expected := `$ORIGIN example.com.
+@   3600 IN SOA   ns1-01.azure-dns.com. azuredns-hostmaster.microsoft.com. 1 3600 300 2419200 300
+@   300  IN CAA   0 issue "letsencrypt.org"
+@   3600 IN TXT   "quote \" here" "second"
+@   3600 IN TXT   "v=spf1 -all"
+api 60   IN CNAME web.example.com.
+www 300  IN A     10.0.0.1
+www 300  IN A     10.0.0.2
+`
+
+	actual := Write("Example.com", input)
+	if actual != expected {
+		t.Fatalf("Expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+
+	// the rendered Zone File should be parsed back into the same record sets
+	parsed, err := Parse("example.com", 3600, actual)
+	if err != nil {
+		t.Fatalf("parsing the rendered Zone File: %+v", err)
+	}
+	if !Equal(input, parsed) {
+		t.Fatalf("Expected the rendered Zone File to round-trip but got %+v", parsed)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	first := []RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}, {"10.0.0.2"}}},
+		{Name: "@", Type: "MX", TTL: 300, Records: [][]string{{"10", "Mail.Example.com."}}},
+	}
+	second := []RecordSet{
+		{Name: "@", Type: "MX", TTL: 300, Records: [][]string{{"10", "mail.example.com"}}},
+		{Name: "WWW", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.2"}, {"10.0.0.1"}}},
+	}
+	if !Equal(first, second) {
+		t.Fatalf("Expected the record sets to be equal")
+	}
+
+	second[1].TTL = 600
+	if Equal(first, second) {
+		t.Fatalf("Expected the record sets with differing TTLs not to be equal")
+	}
+
+	if Equal(first, first[0:1]) {
+		t.Fatalf("Expected a differing number of record sets not to be equal")
+	}
+}
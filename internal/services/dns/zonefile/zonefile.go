@@ -0,0 +1,162 @@
+package zonefile
+
+import (
+	"sort"
+	"strings"
+)
+
+// RecordSet is a set of records sharing the same owner name and type within a Zone File
+type RecordSet struct {
+	// Name is the owner name relative to the origin, where `@` is the apex of the zone
+	Name string
+
+	// Type is the upper-cased record type, for example `A` or `TXT`
+	Type string
+
+	// TTL is the time-to-live (in seconds) of every record within this record set
+	TTL int64
+
+	// Records contains the RDATA fields of each record, where domain names are fully qualified
+	// and quoted character strings are unquoted
+	Records [][]string
+}
+
+// Key returns a unique identifier for this record set, in the form `{name}/{type}`
+func (rs RecordSet) Key() string {
+	return rs.Name + "/" + rs.Type
+}
+
+// supportedTypes are the record types supported by Azure DNS, mapped to the number of RDATA
+// fields for each record - where -1 means one or more fields
+var supportedTypes = map[string]int{
+	"A":     1,
+	"AAAA":  1,
+	"CAA":   3,
+	"CNAME": 1,
+	"MX":    2,
+	"NS":    1,
+	"PTR":   1,
+	"SOA":   7,
+	"SRV":   4,
+	"TXT":   -1,
+}
+
+// domainNameFields are the indexes of the RDATA fields containing a domain name for each record type
+var domainNameFields = map[string][]int{
+	"CNAME": {0},
+	"MX":    {1},
+	"NS":    {0},
+	"PTR":   {0},
+	"SOA":   {0, 1},
+	"SRV":   {3},
+}
+
+// SupportedTypes returns the record types which can be parsed from and written to a Zone File
+func SupportedTypes() []string {
+	out := make([]string, 0, len(supportedTypes))
+	for k := range supportedTypes {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Normalize returns a copy of the record sets with the domain names lower-cased and with the
+// record sets and the records within them sorted, so that two Zone Files can be compared
+func Normalize(input []RecordSet) []RecordSet {
+	output := make([]RecordSet, 0, len(input))
+	for _, rs := range input {
+		records := make([][]string, 0, len(rs.Records))
+		for _, record := range rs.Records {
+			fields := make([]string, len(record))
+			copy(fields, record)
+			for _, i := range domainNameFields[rs.Type] {
+				if i < len(fields) {
+					fields[i] = Fqdn(strings.ToLower(fields[i]))
+				}
+			}
+			records = append(records, fields)
+		}
+		sort.Slice(records, func(i, j int) bool {
+			return strings.Join(records[i], " ") < strings.Join(records[j], " ")
+		})
+
+		output = append(output, RecordSet{
+			Name:    strings.ToLower(rs.Name),
+			Type:    strings.ToUpper(rs.Type),
+			TTL:     rs.TTL,
+			Records: records,
+		})
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return lessRecordSet(output[i], output[j])
+	})
+
+	return output
+}
+
+// Equal returns whether the two lists of record sets contain the same records, ignoring ordering
+// and the case of domain names
+func Equal(first, second []RecordSet) bool {
+	a := Normalize(first)
+	b := Normalize(second)
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !equalRecordSet(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualRecordSet returns whether the two record sets contain the same records, ignoring ordering
+// and the case of domain names
+func EqualRecordSet(first, second RecordSet) bool {
+	return Equal([]RecordSet{first}, []RecordSet{second})
+}
+
+// Fqdn returns the domain name with a trailing dot
+func Fqdn(input string) string {
+	if strings.HasSuffix(input, ".") {
+		return input
+	}
+	return input + "."
+}
+
+func equalRecordSet(a, b RecordSet) bool {
+	if a.Name != b.Name || a.Type != b.Type || a.TTL != b.TTL || len(a.Records) != len(b.Records) {
+		return false
+	}
+
+	for i := range a.Records {
+		if len(a.Records[i]) != len(b.Records[i]) {
+			return false
+		}
+		for j := range a.Records[i] {
+			if a.Records[i][j] != b.Records[i][j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// lessRecordSet orders the SOA record first, followed by the apex, then the remaining record sets by name and type
+func lessRecordSet(a, b RecordSet) bool {
+	if (a.Type == "SOA") != (b.Type == "SOA") {
+		return a.Type == "SOA"
+	}
+	if (a.Name == "@") != (b.Name == "@") {
+		return a.Name == "@"
+	}
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	return a.Type < b.Type
+}
@@ -152,8 +152,11 @@ func resourceFirewallApplicationRuleCollectionCreateUpdate(d *pluginsdk.Resource
 		return fmt.Errorf("expanding Firewall Application Rules: %+v", err)
 	}
 
-	locks.ByName(firewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(firewallName, azureFirewallResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
@@ -329,8 +332,11 @@ func resourceFirewallApplicationRuleCollectionDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.AzureFirewallName, azureFirewallResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	firewall, err := client.Get(ctx, id.ResourceGroup, id.AzureFirewallName)
 	if err != nil {
@@ -150,8 +150,11 @@ func resourceFirewallNetworkRuleCollectionCreateUpdate(d *pluginsdk.ResourceData
 	firewallName := d.Get("azure_firewall_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(firewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(firewallName, azureFirewallResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
@@ -333,8 +336,11 @@ func resourceFirewallNetworkRuleCollectionDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.AzureFirewallName, azureFirewallResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	firewall, err := client.Get(ctx, id.ResourceGroup, id.AzureFirewallName)
 	if err != nil {
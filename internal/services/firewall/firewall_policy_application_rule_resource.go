@@ -0,0 +1,305 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceFirewallPolicyApplicationRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyApplicationRuleCreateUpdate,
+		Read:   resourceFirewallPolicyApplicationRuleRead,
+		Update: resourceFirewallPolicyApplicationRuleCreateUpdate,
+		Delete: resourceFirewallPolicyApplicationRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleName(),
+			},
+
+			"firewall_policy_rule_collection_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleCollectionGroupID,
+			},
+
+			"rule_collection_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"rule_collection_priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"rule_collection_action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+				}, false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protocols": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
+								string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
+							}, false),
+						},
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 64000),
+						},
+					},
+				},
+			},
+
+			"source_addresses": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						validation.IsIPAddress,
+						validation.IsCIDR,
+						validation.StringInSlice([]string{`*`}, false),
+					),
+				},
+			},
+
+			"source_ip_groups": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_addresses": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						validation.IsIPAddress,
+						validation.IsCIDR,
+						validation.StringInSlice([]string{`*`}, false),
+					),
+				},
+			},
+
+			"destination_fqdns": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_urls": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_fqdn_tags": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"terminate_tls": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"web_categories": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceFirewallPolicyApplicationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.FirewallPolicyRuleCollectionGroupID(d.Get("firewall_policy_rule_collection_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyRuleID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, d.Get("rule_collection_name").(string), d.Get("name").(string))
+
+	rules := expandFirewallPolicyRuleApplication([]interface{}{
+		map[string]interface{}{
+			"name":                  id.RuleName,
+			"description":           d.Get("description"),
+			"protocols":             d.Get("protocols"),
+			"source_addresses":      d.Get("source_addresses"),
+			"source_ip_groups":      d.Get("source_ip_groups"),
+			"destination_addresses": d.Get("destination_addresses"),
+			"destination_fqdns":     d.Get("destination_fqdns"),
+			"destination_urls":      d.Get("destination_urls"),
+			"destination_fqdn_tags": d.Get("destination_fqdn_tags"),
+			"terminate_tls":         d.Get("terminate_tls"),
+			"web_categories":        d.Get("web_categories"),
+		},
+	})
+
+	collection := firewallPolicyRuleCollection{
+		name:     id.RuleCollectionName,
+		priority: int32(d.Get("rule_collection_priority").(int)),
+		action:   d.Get("rule_collection_action").(string),
+	}
+
+	if err := createUpdateFirewallPolicyRule(ctx, client, id, collection, (*rules)[0], "azurerm_firewall_policy_application_rule", d.IsNewResource()); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyApplicationRuleRead(d, meta)
+}
+
+func resourceFirewallPolicyApplicationRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	collection, rule, err := retrieveFirewallPolicyRule(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	applicationRule, ok := rule.AsApplicationRule()
+	if !ok {
+		return fmt.Errorf("%s is not an Application Rule", *id)
+	}
+
+	rules, err := flattenFirewallPolicyRuleApplication(&[]network.BasicFirewallPolicyRule{*applicationRule})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+	props := rules[0].(map[string]interface{})
+
+	d.Set("name", id.RuleName)
+	d.Set("firewall_policy_rule_collection_group_id", parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName).ID())
+	d.Set("rule_collection_name", id.RuleCollectionName)
+	d.Set("rule_collection_priority", collection.priority)
+	d.Set("rule_collection_action", collection.action)
+	d.Set("description", props["description"])
+	d.Set("terminate_tls", props["terminate_tls"])
+
+	if err := d.Set("protocols", props["protocols"]); err != nil {
+		return fmt.Errorf("setting `protocols`: %+v", err)
+	}
+	if err := d.Set("source_addresses", props["source_addresses"]); err != nil {
+		return fmt.Errorf("setting `source_addresses`: %+v", err)
+	}
+	if err := d.Set("source_ip_groups", props["source_ip_groups"]); err != nil {
+		return fmt.Errorf("setting `source_ip_groups`: %+v", err)
+	}
+	if err := d.Set("destination_addresses", props["destination_addresses"]); err != nil {
+		return fmt.Errorf("setting `destination_addresses`: %+v", err)
+	}
+	if err := d.Set("destination_fqdns", props["destination_fqdns"]); err != nil {
+		return fmt.Errorf("setting `destination_fqdns`: %+v", err)
+	}
+	if err := d.Set("destination_urls", props["destination_urls"]); err != nil {
+		return fmt.Errorf("setting `destination_urls`: %+v", err)
+	}
+	if err := d.Set("destination_fqdn_tags", props["destination_fqdn_tags"]); err != nil {
+		return fmt.Errorf("setting `destination_fqdn_tags`: %+v", err)
+	}
+	if err := d.Set("web_categories", props["web_categories"]); err != nil {
+		return fmt.Errorf("setting `web_categories`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyApplicationRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := deleteFirewallPolicyRule(ctx, client, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
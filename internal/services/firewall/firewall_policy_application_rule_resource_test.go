@@ -0,0 +1,333 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyApplicationRuleResource struct{}
+
+func TestAccFirewallPolicyApplicationRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_application_rule.second").ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_network_rule.test").ExistsInAzure(FirewallPolicyNetworkRuleResource{}),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the Rule Collection Group shouldn't remove the Rules managed by the separate resources
+			Config:   r.multiple(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_mismatchedRuleCollection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// the Priority and Action are shared by each of the Rules within the Rule Collection
+			Config:      r.mismatchedRuleCollection(data),
+			ExpectError: regexp.MustCompile("must match these"),
+		},
+	})
+}
+
+func (FirewallPolicyApplicationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleExists(ctx, clients, state.ID)
+}
+
+// firewallPolicyRuleExists checks whether the Rule exists within the Rule Collection Group, which is shared by
+// the tests for each of the separate Firewall Policy Rule resources
+func firewallPolicyRuleExists(ctx context.Context, clients *clients.Client, input string) (*bool, error) {
+	id, err := parse.FirewallPolicyRuleID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyRuleGroupClient.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.FirewallPolicyRuleCollectionGroupProperties == nil || resp.RuleCollections == nil {
+		return utils.Bool(false), nil
+	}
+
+	for _, collection := range *resp.RuleCollections {
+		var collectionName *string
+		var rules *[]network.BasicFirewallPolicyRule
+		if v, ok := collection.AsFirewallPolicyFilterRuleCollection(); ok {
+			collectionName = v.Name
+			rules = v.Rules
+		} else if v, ok := collection.AsFirewallPolicyNatRuleCollection(); ok {
+			collectionName = v.Name
+			rules = v.Rules
+		}
+
+		if collectionName == nil || !strings.EqualFold(*collectionName, id.RuleCollectionName) || rules == nil {
+			continue
+		}
+
+		for _, rule := range *rules {
+			var ruleName *string
+			if v, ok := rule.AsApplicationRule(); ok {
+				ruleName = v.Name
+			} else if v, ok := rule.AsRule(); ok {
+				ruleName = v.Name
+			} else if v, ok := rule.AsNatRule(); ok {
+				ruleName = v.Name
+			}
+
+			if ruleName != nil && strings.EqualFold(*ruleName, id.RuleName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (FirewallPolicyApplicationRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name                             = "acctest-fwpolicy-rule-%[1]d"
+  firewall_policy_id               = azurerm_firewall_policy.test.id
+  priority                         = 500
+  retain_unlisted_rule_collections = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyApplicationRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                                     = "app-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "app-rule-collection-1"
+  rule_collection_priority                 = 500
+  rule_collection_action                   = "Deny"
+  source_addresses                         = ["10.0.0.1"]
+  destination_fqdns                        = ["pluginsdk.io"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyApplicationRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "import" {
+  name                                     = azurerm_firewall_policy_application_rule.test.name
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_application_rule.test.firewall_policy_rule_collection_group_id
+  rule_collection_name                     = azurerm_firewall_policy_application_rule.test.rule_collection_name
+  rule_collection_priority                 = azurerm_firewall_policy_application_rule.test.rule_collection_priority
+  rule_collection_action                   = azurerm_firewall_policy_application_rule.test.rule_collection_action
+  source_addresses                         = ["10.0.0.1"]
+  destination_fqdns                        = ["pluginsdk.io"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, r.basic(data))
+}
+
+func (r FirewallPolicyApplicationRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ip_group" "test" {
+  name                = "acctestIpGroupForFirewallPolicy%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  cidrs               = ["1.2.3.4/32", "12.34.56.0/24"]
+}
+
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                                     = "app-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "app-rule-collection-1"
+  rule_collection_priority                 = 600
+  rule_collection_action                   = "Allow"
+  description                              = "app rule"
+  source_ip_groups                         = [azurerm_ip_group.test.id]
+  destination_fqdns                        = ["pluginsdk.io", "terraform.io"]
+
+  protocols {
+    type = "Http"
+    port = 80
+  }
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r FirewallPolicyApplicationRuleResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "second" {
+  name                                     = "app-rule-2"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "app-rule-collection-1"
+  rule_collection_priority                 = 500
+  rule_collection_action                   = "Deny"
+  source_addresses                         = ["10.0.0.2"]
+  destination_fqdn_tags                    = ["WindowsDiagnostics"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                                     = "network-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "network-rule-collection-1"
+  rule_collection_priority                 = 400
+  rule_collection_action                   = "Deny"
+  protocols                                = ["TCP", "UDP"]
+  source_addresses                         = ["10.0.0.1"]
+  destination_addresses                    = ["192.168.1.1"]
+  destination_ports                        = ["80", "1000-2000"]
+}
+`, r.basic(data))
+}
+
+func (r FirewallPolicyApplicationRuleResource) mismatchedRuleCollection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "second" {
+  name                                     = "app-rule-2"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "app-rule-collection-1"
+  rule_collection_priority                 = 550
+  rule_collection_action                   = "Allow"
+  source_addresses                         = ["10.0.0.2"]
+  destination_fqdn_tags                    = ["WindowsDiagnostics"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, r.basic(data))
+}
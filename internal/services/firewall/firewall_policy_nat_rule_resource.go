@@ -0,0 +1,258 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceFirewallPolicyNatRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyNatRuleCreateUpdate,
+		Read:   resourceFirewallPolicyNatRuleRead,
+		Update: resourceFirewallPolicyNatRuleCreateUpdate,
+		Delete: resourceFirewallPolicyNatRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleName(),
+			},
+
+			"firewall_policy_rule_collection_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleCollectionGroupID,
+			},
+
+			"rule_collection_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"rule_collection_priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"protocols": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.FirewallPolicyRuleNetworkProtocolTCP),
+						string(network.FirewallPolicyRuleNetworkProtocolUDP),
+					}, false),
+				},
+			},
+
+			"translated_port": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"source_addresses": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						validation.IsIPAddress,
+						validation.IsCIDR,
+						validation.StringInSlice([]string{`*`}, false),
+					),
+				},
+			},
+
+			"source_ip_groups": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_address": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validation.IsIPAddress,
+					validation.IsCIDR,
+				),
+			},
+
+			"destination_ports": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
+				},
+			},
+
+			"translated_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{"translated_address", "translated_fqdn"},
+			},
+
+			"translated_fqdn": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"translated_address", "translated_fqdn"},
+			},
+		},
+	}
+}
+
+func resourceFirewallPolicyNatRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.FirewallPolicyRuleCollectionGroupID(d.Get("firewall_policy_rule_collection_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyRuleID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, d.Get("rule_collection_name").(string), d.Get("name").(string))
+
+	rules, err := expandFirewallPolicyRuleNat([]interface{}{
+		map[string]interface{}{
+			"name":                id.RuleName,
+			"protocols":           d.Get("protocols"),
+			"source_addresses":    d.Get("source_addresses"),
+			"source_ip_groups":    d.Get("source_ip_groups"),
+			"destination_address": d.Get("destination_address"),
+			"destination_ports":   d.Get("destination_ports"),
+			"translated_address":  d.Get("translated_address"),
+			"translated_port":     d.Get("translated_port"),
+			"translated_fqdn":     d.Get("translated_fqdn"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("expanding NAT rule: %+v", err)
+	}
+
+	collection := firewallPolicyRuleCollection{
+		name:     id.RuleCollectionName,
+		priority: int32(d.Get("rule_collection_priority").(int)),
+		// Hardcode to using `Dnat` instead of the one defined in Swagger (i.e. network.DNAT) for consistency with
+		// the `azurerm_firewall_policy_rule_collection_group` resource, see: https://github.com/Azure/azure-rest-api-specs/issues/9986
+		action: "Dnat",
+		isNat:  true,
+	}
+
+	if err := createUpdateFirewallPolicyRule(ctx, client, id, collection, (*rules)[0], "azurerm_firewall_policy_nat_rule", d.IsNewResource()); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyNatRuleRead(d, meta)
+}
+
+func resourceFirewallPolicyNatRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	collection, rule, err := retrieveFirewallPolicyRule(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	natRule, ok := rule.AsNatRule()
+	if !ok {
+		return fmt.Errorf("%s is not a NAT Rule", *id)
+	}
+
+	rules, err := flattenFirewallPolicyRuleNat(&[]network.BasicFirewallPolicyRule{*natRule})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+	props := rules[0].(map[string]interface{})
+
+	d.Set("name", id.RuleName)
+	d.Set("firewall_policy_rule_collection_group_id", parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName).ID())
+	d.Set("rule_collection_name", id.RuleCollectionName)
+	d.Set("rule_collection_priority", collection.priority)
+	d.Set("destination_address", props["destination_address"])
+	d.Set("translated_address", props["translated_address"])
+	d.Set("translated_port", props["translated_port"])
+	d.Set("translated_fqdn", props["translated_fqdn"])
+
+	if err := d.Set("protocols", props["protocols"]); err != nil {
+		return fmt.Errorf("setting `protocols`: %+v", err)
+	}
+	if err := d.Set("source_addresses", props["source_addresses"]); err != nil {
+		return fmt.Errorf("setting `source_addresses`: %+v", err)
+	}
+	if err := d.Set("source_ip_groups", props["source_ip_groups"]); err != nil {
+		return fmt.Errorf("setting `source_ip_groups`: %+v", err)
+	}
+	if err := d.Set("destination_ports", props["destination_ports"]); err != nil {
+		return fmt.Errorf("setting `destination_ports`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyNatRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := deleteFirewallPolicyRule(ctx, client, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyNatRuleResource struct{}
+
+func TestAccFirewallPolicyNatRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule", "test")
+	r := FirewallPolicyNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNatRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule", "test")
+	r := FirewallPolicyNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyNatRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule", "test")
+	r := FirewallPolicyNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyNatRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleExists(ctx, clients, state.ID)
+}
+
+func (FirewallPolicyNatRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-fwpolicy-rule-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+`, FirewallPolicyApplicationRuleResource{}.template(data), data.RandomInteger)
+}
+
+func (r FirewallPolicyNatRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule" "test" {
+  name                                     = "nat-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "nat-rule-collection-1"
+  rule_collection_priority                 = 300
+  protocols                                = ["TCP", "UDP"]
+  source_addresses                         = ["10.0.0.1", "10.0.0.2"]
+  destination_address                      = azurerm_public_ip.test.ip_address
+  destination_ports                        = ["80"]
+  translated_address                       = "192.168.1.1"
+  translated_port                          = 8080
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyNatRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule" "import" {
+  name                                     = azurerm_firewall_policy_nat_rule.test.name
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_nat_rule.test.firewall_policy_rule_collection_group_id
+  rule_collection_name                     = azurerm_firewall_policy_nat_rule.test.rule_collection_name
+  rule_collection_priority                 = azurerm_firewall_policy_nat_rule.test.rule_collection_priority
+  protocols                                = ["TCP", "UDP"]
+  source_addresses                         = ["10.0.0.1", "10.0.0.2"]
+  destination_address                      = azurerm_public_ip.test.ip_address
+  destination_ports                        = ["80"]
+  translated_address                       = "192.168.1.1"
+  translated_port                          = 8080
+}
+`, r.basic(data))
+}
+
+func (r FirewallPolicyNatRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule" "test" {
+  name                                     = "nat-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "nat-rule-collection-1"
+  rule_collection_priority                 = 350
+  protocols                                = ["TCP"]
+  source_addresses                         = ["*"]
+  destination_address                      = azurerm_public_ip.test.ip_address
+  destination_ports                        = ["443"]
+  translated_fqdn                          = "time.microsoft.com"
+  translated_port                          = 8443
+}
+`, r.template(data))
+}
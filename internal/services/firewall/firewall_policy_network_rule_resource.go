@@ -0,0 +1,270 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceFirewallPolicyNetworkRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyNetworkRuleCreateUpdate,
+		Read:   resourceFirewallPolicyNetworkRuleRead,
+		Update: resourceFirewallPolicyNetworkRuleCreateUpdate,
+		Delete: resourceFirewallPolicyNetworkRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleName(),
+			},
+
+			"firewall_policy_rule_collection_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleCollectionGroupID,
+			},
+
+			"rule_collection_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"rule_collection_priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"rule_collection_action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+				}, false),
+			},
+
+			"protocols": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.FirewallPolicyRuleNetworkProtocolAny),
+						string(network.FirewallPolicyRuleNetworkProtocolTCP),
+						string(network.FirewallPolicyRuleNetworkProtocolUDP),
+						string(network.FirewallPolicyRuleNetworkProtocolICMP),
+					}, false),
+				},
+			},
+
+			"destination_ports": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						azValidate.PortOrPortRangeWithin(1, 65535),
+						validation.StringInSlice([]string{`*`}, false),
+					),
+				},
+			},
+
+			"source_addresses": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						validation.IsIPAddress,
+						validation.IsCIDR,
+						validation.StringInSlice([]string{`*`}, false),
+					),
+				},
+			},
+
+			"source_ip_groups": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_addresses": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					// Can be IP address, CIDR, "*", or service tag
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_ip_groups": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_fqdns": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceFirewallPolicyNetworkRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.FirewallPolicyRuleCollectionGroupID(d.Get("firewall_policy_rule_collection_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyRuleID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, d.Get("rule_collection_name").(string), d.Get("name").(string))
+
+	rules := expandFirewallPolicyRuleNetwork([]interface{}{
+		map[string]interface{}{
+			"name":                  id.RuleName,
+			"protocols":             d.Get("protocols"),
+			"source_addresses":      d.Get("source_addresses"),
+			"source_ip_groups":      d.Get("source_ip_groups"),
+			"destination_addresses": d.Get("destination_addresses"),
+			"destination_ip_groups": d.Get("destination_ip_groups"),
+			"destination_fqdns":     d.Get("destination_fqdns"),
+			"destination_ports":     d.Get("destination_ports"),
+		},
+	})
+
+	collection := firewallPolicyRuleCollection{
+		name:     id.RuleCollectionName,
+		priority: int32(d.Get("rule_collection_priority").(int)),
+		action:   d.Get("rule_collection_action").(string),
+	}
+
+	if err := createUpdateFirewallPolicyRule(ctx, client, id, collection, (*rules)[0], "azurerm_firewall_policy_network_rule", d.IsNewResource()); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyNetworkRuleRead(d, meta)
+}
+
+func resourceFirewallPolicyNetworkRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	collection, rule, err := retrieveFirewallPolicyRule(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	networkRule, ok := rule.AsRule()
+	if !ok {
+		return fmt.Errorf("%s is not a Network Rule", *id)
+	}
+
+	rules, err := flattenFirewallPolicyRuleNetwork(&[]network.BasicFirewallPolicyRule{*networkRule})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+	props := rules[0].(map[string]interface{})
+
+	d.Set("name", id.RuleName)
+	d.Set("firewall_policy_rule_collection_group_id", parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName).ID())
+	d.Set("rule_collection_name", id.RuleCollectionName)
+	d.Set("rule_collection_priority", collection.priority)
+	d.Set("rule_collection_action", collection.action)
+
+	if err := d.Set("protocols", props["protocols"]); err != nil {
+		return fmt.Errorf("setting `protocols`: %+v", err)
+	}
+	if err := d.Set("source_addresses", props["source_addresses"]); err != nil {
+		return fmt.Errorf("setting `source_addresses`: %+v", err)
+	}
+	if err := d.Set("source_ip_groups", props["source_ip_groups"]); err != nil {
+		return fmt.Errorf("setting `source_ip_groups`: %+v", err)
+	}
+	if err := d.Set("destination_addresses", props["destination_addresses"]); err != nil {
+		return fmt.Errorf("setting `destination_addresses`: %+v", err)
+	}
+	if err := d.Set("destination_ip_groups", props["destination_ip_groups"]); err != nil {
+		return fmt.Errorf("setting `destination_ip_groups`: %+v", err)
+	}
+	if err := d.Set("destination_fqdns", props["destination_fqdns"]); err != nil {
+		return fmt.Errorf("setting `destination_fqdns`: %+v", err)
+	}
+	if err := d.Set("destination_ports", props["destination_ports"]); err != nil {
+		return fmt.Errorf("setting `destination_ports`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyNetworkRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := deleteFirewallPolicyRule(ctx, client, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyNetworkRuleResource struct{}
+
+func TestAccFirewallPolicyNetworkRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyNetworkRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleExists(ctx, clients, state.ID)
+}
+
+func (FirewallPolicyNetworkRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                                     = "network-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "network-rule-collection-1"
+  rule_collection_priority                 = 400
+  rule_collection_action                   = "Deny"
+  protocols                                = ["TCP", "UDP"]
+  source_addresses                         = ["10.0.0.1"]
+  destination_addresses                    = ["192.168.1.1"]
+  destination_ports                        = ["80", "1000-2000"]
+}
+`, FirewallPolicyApplicationRuleResource{}.template(data))
+}
+
+func (r FirewallPolicyNetworkRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "import" {
+  name                                     = azurerm_firewall_policy_network_rule.test.name
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_network_rule.test.firewall_policy_rule_collection_group_id
+  rule_collection_name                     = azurerm_firewall_policy_network_rule.test.rule_collection_name
+  rule_collection_priority                 = azurerm_firewall_policy_network_rule.test.rule_collection_priority
+  rule_collection_action                   = azurerm_firewall_policy_network_rule.test.rule_collection_action
+  protocols                                = ["TCP", "UDP"]
+  source_addresses                         = ["10.0.0.1"]
+  destination_addresses                    = ["192.168.1.1"]
+  destination_ports                        = ["80", "1000-2000"]
+}
+`, r.basic(data))
+}
+
+func (FirewallPolicyNetworkRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ip_group" "test_source" {
+  name                = "acctestIpGroupForFirewallPolicySource%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  cidrs               = ["1.2.3.4/32", "12.34.56.0/24"]
+}
+
+resource "azurerm_ip_group" "test_destination" {
+  name                = "acctestIpGroupForFirewallPolicyDest%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  cidrs               = ["192.168.0.0/25", "192.168.0.192/26"]
+}
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                                     = "network-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "network-rule-collection-1"
+  rule_collection_priority                 = 450
+  rule_collection_action                   = "Allow"
+  protocols                                = ["Any"]
+  source_ip_groups                         = [azurerm_ip_group.test_source.id]
+  destination_ip_groups                    = [azurerm_ip_group.test_destination.id]
+  destination_ports                        = ["*"]
+}
+`, FirewallPolicyApplicationRuleResource{}.template(data), data.RandomInteger)
+}
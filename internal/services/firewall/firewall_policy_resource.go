@@ -107,8 +107,11 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	locks.ByName(id.Name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, azureFirewallPolicyResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, props); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -219,8 +222,11 @@ func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.ByName(id.Name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, azureFirewallPolicyResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -0,0 +1,301 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// firewallPolicyRuleCollection is the subset of a Filter or NAT Rule Collection within a Rule Collection Group
+// which is common to both, allowing the separate Firewall Policy Rule resources to share the read-modify-write logic
+type firewallPolicyRuleCollection struct {
+	name     string
+	priority int32
+	action   string
+	isNat    bool
+	rules    []network.BasicFirewallPolicyRule
+}
+
+// lockFirewallPolicyRuleCollectionGroup acquires the lock used for a read-modify-write of a Rule Collection Group.
+// Since only a single update to a Firewall Policy can be in progress at a time, this is the same lock which is used
+// by the `azurerm_firewall_policy_rule_collection_group` resource, rather than one specific to the Rule Collection Group.
+func lockFirewallPolicyRuleCollectionGroup(ctx context.Context, id parse.FirewallPolicyRuleId) (func(), error) {
+	return locks.Acquire(ctx, locks.NameKey(id.FirewallPolicyName, azureFirewallPolicyResourceName))
+}
+
+// retrieveFirewallPolicyRule returns the Rule Collection and Rule for the specified ID, returning nil for the Rule
+// when either the Rule Collection Group, the Rule Collection or the Rule doesn't exist
+func retrieveFirewallPolicyRule(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleId) (*firewallPolicyRuleCollection, network.BasicFirewallPolicyRule, error) {
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("retrieving Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+	}
+
+	collections, err := flattenFirewallPolicyRuleCollectionsForRule(group.FirewallPolicyRuleCollectionGroupProperties)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collectionIndex := findFirewallPolicyRuleCollection(collections, id.RuleCollectionName)
+	if collectionIndex == -1 {
+		return nil, nil, nil
+	}
+
+	collection := collections[collectionIndex]
+	ruleIndex := findFirewallPolicyRule(collection.rules, id.RuleName)
+	if ruleIndex == -1 {
+		return &collection, nil, nil
+	}
+
+	return &collection, collection.rules[ruleIndex], nil
+}
+
+// createUpdateFirewallPolicyRule adds (or replaces) the Rule within the Rule Collection defined in `template`, creating
+// the Rule Collection when it doesn't exist - otherwise its Priority and Action must match the template, unless this
+// is the only Rule within it
+func createUpdateFirewallPolicyRule(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleId, template firewallPolicyRuleCollection, rule network.BasicFirewallPolicyRule, resourceType string, isNewResource bool) error {
+	unlock, err := lockFirewallPolicyRuleCollectionGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		return fmt.Errorf("retrieving Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+	}
+	if group.FirewallPolicyRuleCollectionGroupProperties == nil {
+		return fmt.Errorf("retrieving Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): `properties` was nil", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName)
+	}
+
+	collections, err := flattenFirewallPolicyRuleCollectionsForRule(group.FirewallPolicyRuleCollectionGroupProperties)
+	if err != nil {
+		return err
+	}
+
+	collectionIndex := findFirewallPolicyRuleCollection(collections, id.RuleCollectionName)
+	if collectionIndex == -1 {
+		template.rules = []network.BasicFirewallPolicyRule{rule}
+		collections = append(collections, template)
+	} else {
+		collection := collections[collectionIndex]
+		if collection.isNat != template.isNat {
+			return fmt.Errorf("the Rule Collection %q within Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q) contains a different type of Rules", id.RuleCollectionName, id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName)
+		}
+		for _, existing := range collection.rules {
+			if firewallPolicyRuleType(existing) != firewallPolicyRuleType(rule) {
+				return fmt.Errorf("the Rule Collection %q within Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q) contains a different type of Rules", id.RuleCollectionName, id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName)
+			}
+		}
+
+		ruleIndex := findFirewallPolicyRule(collection.rules, id.RuleName)
+		if ruleIndex != -1 && isNewResource {
+			return tf.ImportAsExistsError(resourceType, id.ID())
+		}
+
+		// the Priority and Action are shared by every Rule within the Rule Collection, so can only be changed here
+		// when this is the only Rule within it - rather than changing these for the Rules managed elsewhere
+		if collection.priority != template.priority || !strings.EqualFold(collection.action, template.action) {
+			if ruleIndex == -1 || len(collection.rules) > 1 {
+				return fmt.Errorf("the Rule Collection %q within Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q) has a Priority of %d and an Action of %q - the `rule_collection_priority` and `rule_collection_action` must match these, since they're shared by each of the Rules within it", id.RuleCollectionName, id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, collection.priority, collection.action)
+			}
+			collection.priority = template.priority
+			collection.action = template.action
+		}
+
+		if ruleIndex == -1 {
+			collection.rules = append(collection.rules, rule)
+		} else {
+			collection.rules[ruleIndex] = rule
+		}
+
+		collections[collectionIndex] = collection
+	}
+
+	return updateFirewallPolicyRuleCollectionGroup(ctx, client, id, group, collections)
+}
+
+// deleteFirewallPolicyRule removes the Rule from the Rule Collection, removing the Rule Collection when it's empty
+func deleteFirewallPolicyRule(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleId) error {
+	unlock, err := lockFirewallPolicyRuleCollectionGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+	}
+
+	collections, err := flattenFirewallPolicyRuleCollectionsForRule(group.FirewallPolicyRuleCollectionGroupProperties)
+	if err != nil {
+		return err
+	}
+
+	collectionIndex := findFirewallPolicyRuleCollection(collections, id.RuleCollectionName)
+	if collectionIndex == -1 {
+		return nil
+	}
+
+	collection := collections[collectionIndex]
+	ruleIndex := findFirewallPolicyRule(collection.rules, id.RuleName)
+	if ruleIndex == -1 {
+		return nil
+	}
+
+	collection.rules = append(collection.rules[:ruleIndex], collection.rules[ruleIndex+1:]...)
+	if len(collection.rules) == 0 {
+		collections = append(collections[:collectionIndex], collections[collectionIndex+1:]...)
+	} else {
+		collections[collectionIndex] = collection
+	}
+
+	return updateFirewallPolicyRuleCollectionGroup(ctx, client, id, group, collections)
+}
+
+func updateFirewallPolicyRuleCollectionGroup(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleId, group network.FirewallPolicyRuleCollectionGroup, collections []firewallPolicyRuleCollection) error {
+	group.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = expandFirewallPolicyRuleCollectionsForRule(collections)
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName, group)
+	if err != nil {
+		return fmt.Errorf("updating Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for update of Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+		}
+	}
+
+	return nil
+}
+
+func findFirewallPolicyRuleCollection(input []firewallPolicyRuleCollection, name string) int {
+	for i, v := range input {
+		if strings.EqualFold(v.name, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func findFirewallPolicyRule(input []network.BasicFirewallPolicyRule, name string) int {
+	for i, v := range input {
+		if rule, ok := v.AsFirewallPolicyRule(); ok && strings.EqualFold(utils.NormalizeNilableString(rule.Name), name) {
+			return i
+		}
+		if rule, ok := v.AsApplicationRule(); ok && strings.EqualFold(utils.NormalizeNilableString(rule.Name), name) {
+			return i
+		}
+		if rule, ok := v.AsRule(); ok && strings.EqualFold(utils.NormalizeNilableString(rule.Name), name) {
+			return i
+		}
+		if rule, ok := v.AsNatRule(); ok && strings.EqualFold(utils.NormalizeNilableString(rule.Name), name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func firewallPolicyRuleType(input network.BasicFirewallPolicyRule) network.RuleType {
+	if _, ok := input.AsApplicationRule(); ok {
+		return network.RuleTypeApplicationRule
+	}
+	if _, ok := input.AsRule(); ok {
+		return network.RuleTypeNetworkRule
+	}
+	if _, ok := input.AsNatRule(); ok {
+		return network.RuleTypeNatRule
+	}
+	return network.RuleTypeFirewallPolicyRule
+}
+
+func flattenFirewallPolicyRuleCollectionsForRule(input *network.FirewallPolicyRuleCollectionGroupProperties) ([]firewallPolicyRuleCollection, error) {
+	output := make([]firewallPolicyRuleCollection, 0)
+	if input == nil || input.RuleCollections == nil {
+		return output, nil
+	}
+
+	for _, v := range *input.RuleCollections {
+		collection := firewallPolicyRuleCollection{
+			rules: make([]network.BasicFirewallPolicyRule, 0),
+		}
+
+		if filter, ok := v.AsFirewallPolicyFilterRuleCollection(); ok {
+			collection.name = utils.NormalizeNilableString(filter.Name)
+			if filter.Priority != nil {
+				collection.priority = *filter.Priority
+			}
+			if filter.Action != nil {
+				collection.action = string(filter.Action.Type)
+			}
+			if filter.Rules != nil {
+				collection.rules = *filter.Rules
+			}
+		} else if nat, ok := v.AsFirewallPolicyNatRuleCollection(); ok {
+			collection.name = utils.NormalizeNilableString(nat.Name)
+			collection.isNat = true
+			if nat.Priority != nil {
+				collection.priority = *nat.Priority
+			}
+			if nat.Action != nil {
+				collection.action = string(nat.Action.Type)
+			}
+			if nat.Rules != nil {
+				collection.rules = *nat.Rules
+			}
+		} else {
+			return nil, fmt.Errorf("unknown rule collection type %+v", v)
+		}
+
+		output = append(output, collection)
+	}
+
+	return output, nil
+}
+
+func expandFirewallPolicyRuleCollectionsForRule(input []firewallPolicyRuleCollection) *[]network.BasicFirewallPolicyRuleCollection {
+	output := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	for _, v := range input {
+		rules := v.rules
+		if v.isNat {
+			output = append(output, &network.FirewallPolicyNatRuleCollection{
+				RuleCollectionType: network.RuleCollectionTypeFirewallPolicyNatRuleCollection,
+				Name:               utils.String(v.name),
+				Priority:           utils.Int32(v.priority),
+				Action: &network.FirewallPolicyNatRuleCollectionAction{
+					Type: network.FirewallPolicyNatRuleCollectionActionType(v.action),
+				},
+				Rules: &rules,
+			})
+			continue
+		}
+
+		output = append(output, &network.FirewallPolicyFilterRuleCollection{
+			RuleCollectionType: network.RuleCollectionTypeFirewallPolicyFilterRuleCollection,
+			Name:               utils.String(v.name),
+			Priority:           utils.Int32(v.priority),
+			Action: &network.FirewallPolicyFilterRuleCollectionAction{
+				Type: network.FirewallPolicyFilterRuleCollectionActionType(v.action),
+			},
+			Rules: &rules,
+		})
+	}
+
+	return &output
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
@@ -413,6 +414,12 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"retain_unlisted_rule_collections": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -441,14 +448,18 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
-	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(policyId.Name, azureFirewallPolicyResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	param := network.FirewallPolicyRuleCollectionGroup{
 		FirewallPolicyRuleCollectionGroupProperties: &network.FirewallPolicyRuleCollectionGroupProperties{
 			Priority: utils.Int32(int32(d.Get("priority").(int))),
 		},
 	}
+
 	var rulesCollections []network.BasicFirewallPolicyRuleCollection
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
@@ -459,6 +470,32 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	}
 	rulesCollections = append(rulesCollections, natRules...)
 
+	if !d.IsNewResource() && d.Get("retain_unlisted_rule_collections").(bool) {
+		// the Rule Collections which aren't listed in-line are managed using the separate Firewall Policy Rule resources
+		// and so are retained - other than those which were previously listed in-line, which have been removed
+		listed := firewallPolicyRuleCollectionNames(d.Get)
+
+		// when unlisted Rule Collections weren't previously retained every Rule Collection is listed in the state (for
+		// example when imported), so only those listed in the configuration are managed in-line
+		if previouslyRetained, _ := d.GetChange("retain_unlisted_rule_collections"); previouslyRetained.(bool) {
+			previouslyListed := firewallPolicyRuleCollectionNames(func(key string) interface{} {
+				old, _ := d.GetChange(key)
+				return old
+			})
+			for name := range previouslyListed {
+				listed[name] = struct{}{}
+			}
+		}
+
+		existing, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
+		}
+		if existing.FirewallPolicyRuleCollectionGroupProperties != nil {
+			rulesCollections = append(rulesCollections, filterFirewallPolicyRuleCollections(existing.RuleCollections, listed, false)...)
+		}
+	}
+
 	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
 
 	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
@@ -511,7 +548,16 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 	d.Set("priority", resp.Priority)
 	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(subscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
 
-	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections)
+	ruleCollections := resp.RuleCollections
+	retainUnlisted := d.Get("retain_unlisted_rule_collections").(bool)
+	if retainUnlisted && ruleCollections != nil {
+		// only the Rule Collections listed in-line are managed by this resource
+		listed := filterFirewallPolicyRuleCollections(ruleCollections, firewallPolicyRuleCollectionNames(d.Get), true)
+		ruleCollections = &listed
+	}
+	d.Set("retain_unlisted_rule_collections", retainUnlisted)
+
+	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(ruleCollections)
 	if err != nil {
 		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
 	}
@@ -529,6 +575,42 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 	return nil
 }
 
+// firewallPolicyRuleCollectionNames returns the lower-cased names of the Rule Collections listed in-line, retrieved using `get`
+func firewallPolicyRuleCollectionNames(get func(key string) interface{}) map[string]struct{} {
+	output := make(map[string]struct{})
+	for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		for _, raw := range get(key).(*pluginsdk.Set).List() {
+			v := raw.(map[string]interface{})
+			output[strings.ToLower(v["name"].(string))] = struct{}{}
+		}
+	}
+
+	return output
+}
+
+// filterFirewallPolicyRuleCollections returns the Rule Collections which are (or when `listed` is false, aren't) within `names`
+func filterFirewallPolicyRuleCollections(input *[]network.BasicFirewallPolicyRuleCollection, names map[string]struct{}, listed bool) []network.BasicFirewallPolicyRuleCollection {
+	output := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		name := ""
+		if filter, ok := v.AsFirewallPolicyFilterRuleCollection(); ok {
+			name = utils.NormalizeNilableString(filter.Name)
+		} else if nat, ok := v.AsFirewallPolicyNatRuleCollection(); ok {
+			name = utils.NormalizeNilableString(nat.Name)
+		}
+
+		if _, ok := names[strings.ToLower(name)]; ok == listed {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
 func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -539,8 +621,11 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.FirewallPolicyName, azureFirewallPolicyResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
 	if err != nil {
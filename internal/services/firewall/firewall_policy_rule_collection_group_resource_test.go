@@ -133,6 +133,31 @@ func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_retainUnlistedRuleCollections(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.retainUnlistedRuleCollections(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_rule_collection.#").HasValue("1"),
+				check.That("azurerm_firewall_policy_application_rule.test").ExistsInAzure(FirewallPolicyApplicationRuleResource{}),
+			),
+		},
+		{
+			// the in-line Rule Collection is removed, whereas the Rule Collection managed by the separate resource is retained
+			Config: r.retainUnlistedRuleCollections(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_rule_collection.#").HasValue("0"),
+				check.That("azurerm_firewall_policy_application_rule.test").ExistsInAzure(FirewallPolicyApplicationRuleResource{}),
+			),
+		},
+	})
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FirewallPolicyRuleCollectionGroupID(state.ID)
 	if err != nil {
@@ -740,3 +765,59 @@ resource "azurerm_firewall_policy_rule_collection_group" "import" {
 }
 `, template)
 }
+
+func (FirewallPolicyRuleCollectionGroupResource) retainUnlistedRuleCollections(data acceptance.TestData, inline bool) string {
+	networkRuleCollection := ""
+	if inline {
+		networkRuleCollection = `
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name                             = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id               = azurerm_firewall_policy.test.id
+  priority                         = 500
+  retain_unlisted_rule_collections = true
+%[3]s
+}
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                                     = "app-rule-1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name                     = "app-rule-collection-1"
+  rule_collection_priority                 = 500
+  rule_collection_action                   = "Deny"
+  source_addresses                         = ["10.0.0.1"]
+  destination_fqdns                        = ["pluginsdk.io"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, networkRuleCollection)
+}
@@ -353,14 +353,14 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
-
-	locks.MultipleByName(vnetToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetToLock, SubnetResourceName)
+	lockKeys := []string{locks.NameKey(id.AzureFirewallName, azureFirewallResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(*subnetToLock, SubnetResourceName)...)
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	if !d.IsNewResource() {
 		exists, err2 := client.Get(ctx, id.ResourceGroup, id.AzureFirewallName)
@@ -527,14 +527,14 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.ByName(id.AzureFirewallName, azureFirewallResourceName)
-	defer locks.UnlockByName(id.AzureFirewallName, azureFirewallResourceName)
-
-	locks.MultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(&subnetNamesToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(&subnetNamesToLock, SubnetResourceName)
+	lockKeys := []string{locks.NameKey(id.AzureFirewallName, azureFirewallResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(virtualNetworkNamesToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(subnetNamesToLock, SubnetResourceName)...)
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	// Change this back to using the SDK method once https://github.com/Azure/azure-sdk-for-go/issues/17013 is addressed.
 	future, err := azuresdkhacks.DeleteFirewall(ctx, client, id.ResourceGroup, id.AzureFirewallName)
@@ -18,6 +18,13 @@ func (r Registration) Name() string {
 	return "Firewall"
 }
 
+// ResourceProviders returns the Resource Providers required by the Resources within this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Network",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -38,6 +45,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_firewall_application_rule_collection":  resourceFirewallApplicationRuleCollection(),
 		"azurerm_firewall_policy":                       resourceFirewallPolicy(),
+		"azurerm_firewall_policy_application_rule":      resourceFirewallPolicyApplicationRule(),
+		"azurerm_firewall_policy_nat_rule":              resourceFirewallPolicyNatRule(),
+		"azurerm_firewall_policy_network_rule":          resourceFirewallPolicyNetworkRule(),
 		"azurerm_firewall_policy_rule_collection_group": resourceFirewallPolicyRuleCollectionGroup(),
 		"azurerm_firewall_nat_rule_collection":          resourceFirewallNatRuleCollection(),
 		"azurerm_firewall_network_rule_collection":      resourceFirewallNetworkRuleCollection(),
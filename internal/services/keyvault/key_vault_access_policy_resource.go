@@ -127,8 +127,11 @@ func resourceKeyVaultAccessPolicyCreateOrDelete(d *pluginsdk.ResourceData, meta
 	}
 
 	// Locking to prevent parallel changes causing issues
-	locks.ByName(vaultName, keyVaultResourceName)
-	defer locks.UnlockByName(vaultName, keyVaultResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(vaultName, keyVaultResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	if d.IsNewResource() {
 		props := keyVault.Properties
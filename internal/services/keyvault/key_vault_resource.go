@@ -252,10 +252,29 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	id := parse.NewVaultID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	location := azure.NormalizeLocation(d.Get("location").(string))
 
+	networkAclsRaw := d.Get("network_acls").([]interface{})
+	networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
+
+	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
+	virtualNetworkNames := make([]string, 0)
+	for _, v := range subnetIds {
+		id, err := networkParse.SubnetIDInsensitively(v)
+		if err != nil {
+			return err
+		}
+		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
+			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
+		}
+	}
+
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
-	locks.ByName(id.Name, keyVaultResourceName)
-	defer locks.UnlockByName(id.Name, keyVaultResourceName)
+	lockKeys := append(locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName), locks.NameKey(id.Name, keyVaultResourceName))
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	// check for the presence of an existing, live one which should be imported into the state
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
@@ -300,9 +319,6 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	policies := d.Get("access_policy").([]interface{})
 	accessPolicies := expandAccessPolicies(policies)
 
-	networkAclsRaw := d.Get("network_acls").([]interface{})
-	networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
-
 	sku := keyvault.Sku{
 		Family: &armKeyVaultSkuFamily,
 		Name:   keyvault.SkuName(d.Get("sku_name").(string)),
@@ -340,21 +356,6 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		parameters.Properties.CreateMode = keyvault.CreateModeRecover
 	}
 
-	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-	virtualNetworkNames := make([]string, 0)
-	for _, v := range subnetIds {
-		id, err := networkParse.SubnetIDInsensitively(v)
-		if err != nil {
-			return err
-		}
-		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
-		}
-	}
-
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -414,10 +415,30 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
+	virtualNetworkNames := make([]string, 0)
+	if d.HasChange("network_acls") {
+		_, subnetIds := expandKeyVaultNetworkAcls(d.Get("network_acls").([]interface{}))
+		for _, v := range subnetIds {
+			id, err := networkParse.SubnetIDInsensitively(v)
+			if err != nil {
+				return err
+			}
+
+			if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
+				virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
+			}
+		}
+	}
+
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
-	locks.ByName(id.Name, keyVaultResourceName)
-	defer locks.UnlockByName(id.Name, keyVaultResourceName)
+	lockKeys := append(locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName), locks.NameKey(id.Name, keyVaultResourceName))
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	d.Partial(true)
 
@@ -480,24 +501,7 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 
 		networkAclsRaw := d.Get("network_acls").([]interface{})
-		networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
-
-		// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-		virtualNetworkNames := make([]string, 0)
-		for _, v := range subnetIds {
-			id, err := networkParse.SubnetIDInsensitively(v)
-			if err != nil {
-				return err
-			}
-
-			if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-				virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
-			}
-		}
-
-		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-
+		networkAcls, _ := expandKeyVaultNetworkAcls(networkAclsRaw)
 		update.Properties.NetworkAcls = networkAcls
 	}
 
@@ -698,9 +702,6 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.Name, keyVaultResourceName)
-	defer locks.UnlockByName(id.Name, keyVaultResourceName)
-
 	read, err := client.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
@@ -750,8 +751,12 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	lockKeys := append(locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName), locks.NameKey(id.Name, keyVaultResourceName))
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
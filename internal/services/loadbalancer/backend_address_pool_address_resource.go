@@ -88,8 +88,11 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
-			defer locks.UnlockByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
+			release, err := locks.Acquire(ctx, locks.NameKey(poolId.BackendAddressPoolName, backendAddressPoolResourceName))
+			if err != nil {
+				return fmt.Errorf("acquiring lock: %+v", err)
+			}
+			defer release()
 
 			// Backend Addresses can not be created for Basic sku, so we have to check
 			lb, err := metadata.Client.LoadBalancers.LoadBalancersClient.Get(ctx, poolId.ResourceGroup, poolId.LoadBalancerName, "")
@@ -221,8 +224,11 @@ func (r BackendAddressPoolAddressResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
-			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			release, err := locks.Acquire(ctx, locks.NameKey(id.BackendAddressPoolName, backendAddressPoolResourceName))
+			if err != nil {
+				return fmt.Errorf("acquiring lock: %+v", err)
+			}
+			defer release()
 
 			pool, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 			if err != nil {
@@ -276,8 +282,11 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
-			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			release, err := locks.Acquire(ctx, locks.NameKey(id.BackendAddressPoolName, backendAddressPoolResourceName))
+			if err != nil {
+				return fmt.Errorf("acquiring lock: %+v", err)
+			}
+			defer release()
 
 			var model BackendAddressPoolAddressModel
 			if err := metadata.Decode(&model); err != nil {
@@ -195,11 +195,11 @@ func resourceArmLoadBalancerBackendAddressPoolCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
-	locks.ByName(name, backendAddressPoolResourceName)
-	defer locks.UnlockByName(name, backendAddressPoolResourceName)
-
-	locks.ByID(loadBalancerId.ID())
-	defer locks.UnlockByID(loadBalancerId.ID())
+	release, err := locks.Acquire(ctx, locks.NameKey(name, backendAddressPoolResourceName), loadBalancerId.ID())
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	lb, err := lbClient.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
 	if err != nil {
@@ -385,11 +385,11 @@ func resourceArmLoadBalancerBackendAddressPoolDelete(d *pluginsdk.ResourceData,
 
 	loadBalancerId := parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName)
 	loadBalancerID := loadBalancerId.ID()
-	locks.ByID(loadBalancerID)
-	defer locks.UnlockByID(loadBalancerID)
-
-	locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
-	defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+	release, err := locks.Acquire(ctx, loadBalancerID, locks.NameKey(id.BackendAddressPoolName, backendAddressPoolResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	lb, err := lbClient.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
 	if err != nil {
@@ -163,8 +163,11 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 
 	id := parse.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.Name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.Name, expressRouteCircuitResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, expressRouteCircuitResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
@@ -334,8 +337,11 @@ func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("parsing Azure Resource ID -: %+v", err)
 	}
 
-	locks.ByName(id.Name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.Name, expressRouteCircuitResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, expressRouteCircuitResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -112,8 +112,11 @@ func resourceNatGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	id := parse.NewNatGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, natGatewayResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -170,8 +173,11 @@ func resourceNatGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, natGatewayResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -298,8 +304,11 @@ func resourceNatGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, natGatewayResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -93,11 +93,12 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	lockKeys := append([]string{locks.NameKey(id.Name, azureNetworkDDoSProtectionPlanResourceName)}, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
@@ -181,11 +182,12 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	lockKeys := append([]string{locks.NameKey(id.Name, azureNetworkDDoSProtectionPlanResourceName)}, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -1,6 +1,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -12,14 +14,19 @@ type networkInterfaceIPConfigurationLockingDetails struct {
 	virtualNetworkNamesToLock []string
 }
 
-func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
-}
+// lock acquires the locks for each of the Subnets and Virtual Networks (along with any additional
+// keys, such as the Network Interface itself) in a consistent order, returning a function which
+// releases these locks
+func (details networkInterfaceIPConfigurationLockingDetails) lock(ctx context.Context, additionalKeys ...string) (func(), error) {
+	keys := append([]string{}, additionalKeys...)
+	for _, name := range details.subnetNamesToLock {
+		keys = append(keys, locks.NameKey(name, SubnetResourceName))
+	}
+	for _, name := range details.virtualNetworkNamesToLock {
+		keys = append(keys, locks.NameKey(name, VirtualNetworkResourceName))
+	}
 
-func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	return locks.Acquire(ctx, keys...)
 }
 
 func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
@@ -0,0 +1,109 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestNetworkInterfaceAndSubnetNetworkSecurityGroupAssociationLockConcurrently(t *testing.T) {
+	subnetId := parse.NewSubnetID("00000000-0000-0000-0000-000000000000", "group1", "network1", "subnet1")
+	ipConfigs := &[]network.InterfaceIPConfiguration{
+		{
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				Subnet: &network.Subnet{
+					ID: utils.String(subnetId.ID()),
+				},
+			},
+		},
+	}
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+	if err != nil {
+		t.Fatalf("determining locking details: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	lockers := []func() (func(), error){
+		func() (func(), error) {
+			return lockingDetails.lock(ctx, locks.NameKey("nic1", networkInterfaceResourceName))
+		},
+		func() (func(), error) {
+			return lockSubnetNetworkSecurityGroupAssociation(ctx, subnetId, "nsg1")
+		},
+	}
+
+	// the Subnet is locked up-front so that both callers are waiting on it at the same time - were the
+	// Subnet and Virtual Network locked in a different order by each caller, then whichever is granted
+	// the Subnet could wait on the Virtual Network held by the other, deadlocking them
+	subnetKey := locks.NameKey(subnetId.Name, SubnetResourceName)
+	for i := 0; i < 2*len(lockers); i++ {
+		releaseSubnet, err := locks.Acquire(ctx, subnetKey)
+		if err != nil {
+			t.Fatalf("acquiring lock for the Subnet: %+v", err)
+		}
+
+		// waiters are granted the lock in the order they're queued, so alternate which caller is first
+		wg := sync.WaitGroup{}
+		errs := make(chan error, len(lockers))
+		for j := range lockers {
+			lock := lockers[(i+j)%len(lockers)]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				release, err := lock()
+				if err != nil {
+					errs <- err
+					return
+				}
+				release()
+			}()
+			waitForLockWaiters(t, subnetKey, j+1)
+		}
+
+		releaseSubnet()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for the locks to be released, current locks:\n%s", locks.DebugDump())
+		}
+
+		close(errs)
+		for err := range errs {
+			t.Fatalf("acquiring locks: %+v", err)
+		}
+	}
+
+	if dump := locks.DebugDump(); dump != "" {
+		t.Fatalf("expected no locks to be held but got:\n%s", dump)
+	}
+}
+
+func waitForLockWaiters(t *testing.T, key string, waiters int) {
+	expected := fmt.Sprintf("(%d waiting)", waiters)
+	for i := 0; i < 1000; i++ {
+		for _, line := range strings.Split(locks.DebugDump(), "\n") {
+			if strings.HasPrefix(line, fmt.Sprintf("%q:", key)) && strings.HasSuffix(line, expected) {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %d callers to wait on %q, current locks:\n%s", waiters, key, locks.DebugDump())
+}
@@ -77,16 +77,16 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(nicId.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
-
 	nsgId, err := parse.NetworkSecurityGroupID(networkSecurityGroupId)
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(nsgId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(nsgId.Name, networkSecurityGroupResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(nicId.Name, networkInterfaceResourceName), locks.NameKey(nsgId.Name, networkSecurityGroupResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	read, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 	if err != nil {
@@ -186,8 +186,11 @@ func resourceNetworkInterfaceSecurityGroupAssociationDelete(d *pluginsdk.Resourc
 		return err
 	}
 
-	locks.ByName(nicID.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicID.Name, networkInterfaceResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(nicID.Name, networkInterfaceResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	read, err := client.Get(ctx, nicID.ResourceGroup, nicID.Name, "")
 	if err != nil {
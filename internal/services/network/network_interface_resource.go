@@ -221,9 +221,6 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		EnableAcceleratedNetworking: &enableAcceleratedNetworking,
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
-
 	dns, hasDns := d.GetOk("dns_servers")
 	nameLabel, hasNameLabel := d.GetOk("internal_dns_name_label")
 	if hasDns || hasNameLabel {
@@ -252,8 +249,11 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
+	release, err := lockingDetails.lock(ctx, locks.NameKey(id.Name, networkInterfaceResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	if len(*ipConfigs) > 0 {
 		properties.IPConfigurations = ipConfigs
@@ -289,8 +289,27 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	// the Subnets and Virtual Networks to lock on are determined up-front, so that they're locked
+	// along with the Network Interface in a consistent order
+	var ipConfigs *[]network.InterfaceIPConfiguration
+	lockingDetails := &networkInterfaceIPConfigurationLockingDetails{}
+	if d.HasChange("ip_configuration") {
+		ipConfigsRaw := d.Get("ip_configuration").([]interface{})
+		ipConfigs, err = expandNetworkInterfaceIPConfigurations(ipConfigsRaw)
+		if err != nil {
+			return fmt.Errorf("expanding `ip_configuration`: %+v", err)
+		}
+		lockingDetails, err = determineResourcesToLockFromIPConfiguration(ipConfigs)
+		if err != nil {
+			return fmt.Errorf("determining locking details: %+v", err)
+		}
+	}
+
+	release, err := lockingDetails.lock(ctx, locks.NameKey(id.Name, networkInterfaceResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	// first get the existing one so that we can pull things as needed
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
@@ -337,19 +356,6 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("ip_configuration") {
-		ipConfigsRaw := d.Get("ip_configuration").([]interface{})
-		ipConfigs, err := expandNetworkInterfaceIPConfigurations(ipConfigsRaw)
-		if err != nil {
-			return fmt.Errorf("expanding `ip_configuration`: %+v", err)
-		}
-		lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
-		if err != nil {
-			return fmt.Errorf("determining locking details: %+v", err)
-		}
-
-		lockingDetails.lock()
-		defer lockingDetails.unlock()
-
 		// then map the fields managed in other resources back
 		ipConfigs = mapFieldsToNetworkInterface(ipConfigs, info)
 
@@ -484,8 +490,22 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	// the Subnets and Virtual Networks to lock on are determined from the state, so that they're
+	// locked along with the Network Interface in a consistent order
+	ipConfigs, err := expandNetworkInterfaceIPConfigurations(d.Get("ip_configuration").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `ip_configuration`: %+v", err)
+	}
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+	if err != nil {
+		return fmt.Errorf("determining locking details: %+v", err)
+	}
+
+	release, err := lockingDetails.lock(ctx, locks.NameKey(id.Name, networkInterfaceResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -501,15 +521,6 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 	if existing.InterfacePropertiesFormat == nil {
 		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
 	}
-	props := *existing.InterfacePropertiesFormat
-
-	lockingDetails, err := determineResourcesToLockFromIPConfiguration(props.IPConfigurations)
-	if err != nil {
-		return fmt.Errorf("determining locking details: %+v", err)
-	}
-
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -0,0 +1,341 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+
+type ManagerAdminRuleResource struct{}
+
+type ManagerAdminRuleModel struct {
+	Name                  string                               `tfschema:"name"`
+	AdminRuleCollectionId string                               `tfschema:"admin_rule_collection_id"`
+	Action                string                               `tfschema:"action"`
+	Description           string                               `tfschema:"description"`
+	DestinationPortRanges []string                             `tfschema:"destination_port_ranges"`
+	Destinations          []ManagerAdminRuleAddressPrefixModel `tfschema:"destination"`
+	Direction             string                               `tfschema:"direction"`
+	Priority              int64                                `tfschema:"priority"`
+	Protocol              string                               `tfschema:"protocol"`
+	SourcePortRanges      []string                             `tfschema:"source_port_ranges"`
+	Sources               []ManagerAdminRuleAddressPrefixModel `tfschema:"source"`
+}
+
+type ManagerAdminRuleAddressPrefixModel struct {
+	AddressPrefix     string `tfschema:"address_prefix"`
+	AddressPrefixType string `tfschema:"address_prefix_type"`
+}
+
+func (r ManagerAdminRuleResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule"
+}
+
+func (r ManagerAdminRuleResource) ModelObject() interface{} {
+	return &ManagerAdminRuleModel{}
+}
+
+func (r ManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerAdminRuleID
+}
+
+func (r ManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"admin_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerAdminRuleCollectionID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForSecurityConfigurationRuleAccess(), false),
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 4096),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination": networkManagerAdminRuleAddressPrefixSchema(),
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"source": networkManagerAdminRuleAddressPrefixSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerAdminRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagersClient
+			collectionId, err := parse.NetworkManagerAdminRuleCollectionID(model.AdminRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerAdminRuleID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.NetworkManagerName, collectionId.SecurityAdminConfigurationName, collectionId.RuleCollectionName, model.Name)
+			existing, err := client.AdminRulesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := networkmanagers.AdminRule{
+				Kind:       networkmanagers.AdminRuleKindCustom,
+				Properties: expandNetworkManagerAdminRule(model),
+			}
+			if _, err := client.AdminRulesCreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerAdminRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AdminRulesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerAdminRuleModel{
+				Name:                  id.RuleName,
+				AdminRuleCollectionId: parse.NewNetworkManagerAdminRuleCollectionID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Kind != networkmanagers.AdminRuleKindCustom {
+					return fmt.Errorf("retrieving %s: expected the `kind` to be %q but got %q", *id, string(networkmanagers.AdminRuleKindCustom), string(model.Kind))
+				}
+
+				if props := model.Properties; props != nil {
+					state.Action = string(props.Access)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Direction = string(props.Direction)
+					state.Priority = props.Priority
+					state.Protocol = string(props.Protocol)
+					state.Destinations = flattenNetworkManagerAddressPrefixItems(props.Destinations)
+					state.DestinationPortRanges = flattenNetworkManagerPortRanges(props.DestinationPortRanges)
+					state.Sources = flattenNetworkManagerAddressPrefixItems(props.Sources)
+					state.SourcePortRanges = flattenNetworkManagerPortRanges(props.SourcePortRanges)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerAdminRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.AdminRulesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			payload.Properties = expandNetworkManagerAdminRule(model)
+			if _, err := client.AdminRulesCreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerAdminRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.AdminRulesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func networkManagerAdminRuleAddressPrefixSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForAddressPrefixType(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkManagerAdminRule(input ManagerAdminRuleModel) *networkmanagers.AdminPropertiesFormat {
+	output := networkmanagers.AdminPropertiesFormat{
+		Access:                networkmanagers.SecurityConfigurationRuleAccess(input.Action),
+		DestinationPortRanges: &input.DestinationPortRanges,
+		Destinations:          expandNetworkManagerAddressPrefixItems(input.Destinations),
+		Direction:             networkmanagers.SecurityConfigurationRuleDirection(input.Direction),
+		Priority:              input.Priority,
+		Protocol:              networkmanagers.SecurityConfigurationRuleProtocol(input.Protocol),
+		SourcePortRanges:      &input.SourcePortRanges,
+		Sources:               expandNetworkManagerAddressPrefixItems(input.Sources),
+	}
+	if input.Description != "" {
+		output.Description = utils.String(input.Description)
+	}
+	return &output
+}
+
+func expandNetworkManagerAddressPrefixItems(input []ManagerAdminRuleAddressPrefixModel) *[]networkmanagers.AddressPrefixItem {
+	output := make([]networkmanagers.AddressPrefixItem, 0)
+	for _, v := range input {
+		prefixType := networkmanagers.AddressPrefixType(v.AddressPrefixType)
+		output = append(output, networkmanagers.AddressPrefixItem{
+			AddressPrefix:     utils.String(v.AddressPrefix),
+			AddressPrefixType: &prefixType,
+		})
+	}
+	return &output
+}
+
+func flattenNetworkManagerAddressPrefixItems(input *[]networkmanagers.AddressPrefixItem) []ManagerAdminRuleAddressPrefixModel {
+	output := make([]ManagerAdminRuleAddressPrefixModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		prefixType := ""
+		if v.AddressPrefixType != nil {
+			prefixType = string(*v.AddressPrefixType)
+		}
+
+		output = append(output, ManagerAdminRuleAddressPrefixModel{
+			AddressPrefix:     utils.NormalizeNilableString(v.AddressPrefix),
+			AddressPrefixType: prefixType,
+		})
+	}
+	return output
+}
+
+func flattenNetworkManagerPortRanges(input *[]string) []string {
+	if input == nil {
+		return make([]string, 0)
+	}
+	return *input
+}
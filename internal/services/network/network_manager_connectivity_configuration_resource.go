@@ -0,0 +1,382 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerConnectivityConfigurationResource{}
+
+type ManagerConnectivityConfigurationResource struct{}
+
+type ManagerConnectivityConfigurationModel struct {
+	Name                         string                                     `tfschema:"name"`
+	NetworkManagerId             string                                     `tfschema:"network_manager_id"`
+	AppliesToGroups              []ManagerConnectivityGroupItemModel        `tfschema:"applies_to_group"`
+	ConnectivityTopology         string                                     `tfschema:"connectivity_topology"`
+	DeleteExistingPeeringEnabled bool                                       `tfschema:"delete_existing_peering_enabled"`
+	Description                  string                                     `tfschema:"description"`
+	GlobalMeshEnabled            bool                                       `tfschema:"global_mesh_enabled"`
+	Hub                          []ManagerConnectivityConfigurationHubModel `tfschema:"hub"`
+}
+
+type ManagerConnectivityGroupItemModel struct {
+	NetworkGroupId    string `tfschema:"network_group_id"`
+	GroupConnectivity string `tfschema:"group_connectivity"`
+	GlobalMeshEnabled bool   `tfschema:"global_mesh_enabled"`
+	UseHubGateway     bool   `tfschema:"use_hub_gateway"`
+}
+
+type ManagerConnectivityConfigurationHubModel struct {
+	ResourceId   string `tfschema:"resource_id"`
+	ResourceType string `tfschema:"resource_type"`
+}
+
+func (r ManagerConnectivityConfigurationResource) ResourceType() string {
+	return "azurerm_network_manager_connectivity_configuration"
+}
+
+func (r ManagerConnectivityConfigurationResource) ModelObject() interface{} {
+	return &ManagerConnectivityConfigurationModel{}
+}
+
+func (r ManagerConnectivityConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerConnectivityConfigurationID
+}
+
+func (r ManagerConnectivityConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerID,
+		},
+
+		"applies_to_group": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_group_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.NetworkManagerNetworkGroupID,
+					},
+
+					"group_connectivity": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(networkmanagers.GroupConnectivityNone),
+						ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForGroupConnectivity(), false),
+					},
+
+					"global_mesh_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"use_hub_gateway": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"connectivity_topology": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConnectivityTopology(), false),
+		},
+
+		"delete_existing_peering_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"global_mesh_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"hub": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerConnectivityConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagersClient
+			networkManagerId, err := parse.NetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, model.Name)
+			existing, err := client.ConnectivityConfigurationsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandNetworkManagerConnectivityConfiguration(model)
+			if err != nil {
+				return err
+			}
+
+			input := networkmanagers.ConnectivityConfiguration{
+				Properties: props,
+			}
+			if _, err := client.ConnectivityConfigurationsCreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ConnectivityConfigurationsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerConnectivityConfigurationModel{
+				Name:             id.ConnectivityConfigurationName,
+				NetworkManagerId: parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AppliesToGroups = flattenNetworkManagerConnectivityGroupItems(props.AppliesToGroups)
+					state.ConnectivityTopology = string(props.ConnectivityTopology)
+					state.DeleteExistingPeeringEnabled = props.DeleteExistingPeering != nil && *props.DeleteExistingPeering == networkmanagers.DeleteExistingPeeringTrue
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.GlobalMeshEnabled = props.IsGlobal != nil && *props.IsGlobal == networkmanagers.IsGlobalTrue
+					state.Hub = flattenNetworkManagerConnectivityConfigurationHubs(props.Hubs)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.ConnectivityConfigurationsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// all of the fields are sent in a single block, so it's simpler to rebuild the properties from the config
+			props, err := expandNetworkManagerConnectivityConfiguration(model)
+			if err != nil {
+				return err
+			}
+
+			payload := *existing.Model
+			payload.Properties = props
+			if _, err := client.ConnectivityConfigurationsCreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.ConnectivityConfigurationsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerConnectivityConfiguration(input ManagerConnectivityConfigurationModel) (*networkmanagers.ConnectivityConfigurationProperties, error) {
+	topology := networkmanagers.ConnectivityTopology(input.ConnectivityTopology)
+	if topology == networkmanagers.ConnectivityTopologyHubAndSpoke && len(input.Hub) == 0 {
+		return nil, fmt.Errorf("a `hub` block must be specified when `connectivity_topology` is `%s`", networkmanagers.ConnectivityTopologyHubAndSpoke)
+	}
+
+	deleteExistingPeering := networkmanagers.DeleteExistingPeeringFalse
+	if input.DeleteExistingPeeringEnabled {
+		deleteExistingPeering = networkmanagers.DeleteExistingPeeringTrue
+	}
+
+	output := networkmanagers.ConnectivityConfigurationProperties{
+		AppliesToGroups:       expandNetworkManagerConnectivityGroupItems(input.AppliesToGroups),
+		ConnectivityTopology:  topology,
+		DeleteExistingPeering: &deleteExistingPeering,
+		Hubs:                  expandNetworkManagerConnectivityConfigurationHubs(input.Hub),
+		IsGlobal:              expandNetworkManagerIsGlobal(input.GlobalMeshEnabled),
+	}
+	if input.Description != "" {
+		output.Description = utils.String(input.Description)
+	}
+
+	return &output, nil
+}
+
+func expandNetworkManagerConnectivityGroupItems(input []ManagerConnectivityGroupItemModel) []networkmanagers.ConnectivityGroupItem {
+	output := make([]networkmanagers.ConnectivityGroupItem, 0)
+	for _, v := range input {
+		useHubGateway := networkmanagers.UseHubGatewayFalse
+		if v.UseHubGateway {
+			useHubGateway = networkmanagers.UseHubGatewayTrue
+		}
+
+		output = append(output, networkmanagers.ConnectivityGroupItem{
+			GroupConnectivity: networkmanagers.GroupConnectivity(v.GroupConnectivity),
+			IsGlobal:          expandNetworkManagerIsGlobal(v.GlobalMeshEnabled),
+			NetworkGroupId:    v.NetworkGroupId,
+			UseHubGateway:     &useHubGateway,
+		})
+	}
+	return output
+}
+
+func expandNetworkManagerConnectivityConfigurationHubs(input []ManagerConnectivityConfigurationHubModel) *[]networkmanagers.Hub {
+	output := make([]networkmanagers.Hub, 0)
+	for _, v := range input {
+		output = append(output, networkmanagers.Hub{
+			ResourceId:   utils.String(v.ResourceId),
+			ResourceType: utils.String(v.ResourceType),
+		})
+	}
+	return &output
+}
+
+func expandNetworkManagerIsGlobal(input bool) *networkmanagers.IsGlobal {
+	output := networkmanagers.IsGlobalFalse
+	if input {
+		output = networkmanagers.IsGlobalTrue
+	}
+	return &output
+}
+
+func flattenNetworkManagerConnectivityGroupItems(input []networkmanagers.ConnectivityGroupItem) []ManagerConnectivityGroupItemModel {
+	output := make([]ManagerConnectivityGroupItemModel, 0)
+	for _, v := range input {
+		output = append(output, ManagerConnectivityGroupItemModel{
+			NetworkGroupId:    v.NetworkGroupId,
+			GroupConnectivity: string(v.GroupConnectivity),
+			GlobalMeshEnabled: v.IsGlobal != nil && *v.IsGlobal == networkmanagers.IsGlobalTrue,
+			UseHubGateway:     v.UseHubGateway != nil && *v.UseHubGateway == networkmanagers.UseHubGatewayTrue,
+		})
+	}
+	return output
+}
+
+func flattenNetworkManagerConnectivityConfigurationHubs(input *[]networkmanagers.Hub) []ManagerConnectivityConfigurationHubModel {
+	output := make([]ManagerConnectivityConfigurationHubModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ManagerConnectivityConfigurationHubModel{
+			ResourceId:   utils.NormalizeNilableString(v.ResourceId),
+			ResourceType: utils.NormalizeNilableString(v.ResourceType),
+		})
+	}
+	return output
+}
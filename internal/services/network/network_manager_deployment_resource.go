@@ -0,0 +1,305 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-01-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerDeploymentResource{}
+
+type ManagerDeploymentResource struct{}
+
+type ManagerDeploymentModel struct {
+	NetworkManagerId string            `tfschema:"network_manager_id"`
+	Location         string            `tfschema:"location"`
+	ScopeAccess      string            `tfschema:"scope_access"`
+	ConfigurationIds []string          `tfschema:"configuration_ids"`
+	Triggers         map[string]string `tfschema:"triggers"`
+}
+
+func (r ManagerDeploymentResource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r ManagerDeploymentResource) ModelObject() interface{} {
+	return &ManagerDeploymentModel{}
+}
+
+func (r ManagerDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerDeploymentID
+}
+
+func (r ManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerID,
+		},
+
+		"location": location.Schema(),
+
+		"scope_access": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConfigurationType(), false),
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagersClient
+			networkManagerId, err := parse.NetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			// only a single Commit can be in progress for a Network Manager at any one time
+			unlock, err := locks.Acquire(ctx, networkManagerId.ID())
+			if err != nil {
+				return fmt.Errorf("acquiring lock for %s: %+v", networkManagerId, err)
+			}
+			defer unlock()
+
+			id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, location.Normalize(model.Location), model.ScopeAccess)
+			existing, err := getNetworkManagerDeploymentStatus(ctx, client, id)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := commitNetworkManagerDeployment(ctx, client, id, model.ConfigurationIds); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			status, err := getNetworkManagerDeploymentStatus(ctx, client, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if status == nil || status.ConfigurationIds == nil || len(*status.ConfigurationIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ManagerDeploymentModel{
+				NetworkManagerId: parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID(),
+				Location:         location.Normalize(id.LocationName),
+				ScopeAccess:      id.ScopeAccessName,
+				ConfigurationIds: *status.ConfigurationIds,
+				// the triggers only exist within the Terraform State
+				Triggers: model.Triggers,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId := parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+			unlock, err := locks.Acquire(ctx, networkManagerId.ID())
+			if err != nil {
+				return fmt.Errorf("acquiring lock for %s: %+v", networkManagerId, err)
+			}
+			defer unlock()
+
+			if err := commitNetworkManagerDeployment(ctx, client, *id, model.ConfigurationIds); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			networkManagerId := parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+			unlock, err := locks.Acquire(ctx, networkManagerId.ID())
+			if err != nil {
+				return fmt.Errorf("acquiring lock for %s: %+v", networkManagerId, err)
+			}
+			defer unlock()
+
+			// committing an empty set of configurations removes them from the target location
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := commitNetworkManagerDeployment(ctx, client, *id, []string{}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func commitNetworkManagerDeployment(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId, configurationIds []string) error {
+	networkManagerId := parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	input := networkmanagers.NetworkManagerCommit{
+		CommitType:       networkmanagers.ConfigurationType(id.ScopeAccessName),
+		ConfigurationIds: &configurationIds,
+		TargetLocations:  []string{id.LocationName},
+	}
+	if err := client.CommitThenPoll(ctx, networkManagerId, input); err != nil {
+		return fmt.Errorf("committing: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(networkmanagers.DeploymentStatusNotStarted), string(networkmanagers.DeploymentStatusDeploying)},
+		Target:     []string{string(networkmanagers.DeploymentStatusDeployed)},
+		Refresh:    networkManagerDeploymentStatusRefreshFunc(ctx, client, id),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentStatusRefreshFunc(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := getNetworkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the deployment status: %+v", err)
+		}
+		if status == nil || status.DeploymentStatus == nil {
+			return "not found", string(networkmanagers.DeploymentStatusNotStarted), nil
+		}
+
+		if *status.DeploymentStatus == networkmanagers.DeploymentStatusFailed {
+			return status, string(*status.DeploymentStatus), fmt.Errorf("the deployment failed: %s", utils.NormalizeNilableString(status.ErrorMessage))
+		}
+
+		return status, string(*status.DeploymentStatus), nil
+	}
+}
+
+// getNetworkManagerDeploymentStatus returns the status of the most recent deployment for the Location and Scope Access
+// of the specified Deployment ID - or nil if there's no deployment for that combination
+func getNetworkManagerDeploymentStatus(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) (*networkmanagers.NetworkManagerDeploymentStatus, error) {
+	networkManagerId := parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	input := networkmanagers.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagers.ConfigurationType{
+			networkmanagers.ConfigurationType(id.ScopeAccessName),
+		},
+		Regions: &[]string{
+			id.LocationName,
+		},
+	}
+
+	resp, err := client.ListDeploymentStatus(ctx, networkManagerId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, nil
+	}
+
+	for _, v := range *resp.Model.Value {
+		if v.Region == nil || !strings.EqualFold(location.Normalize(*v.Region), location.Normalize(id.LocationName)) {
+			continue
+		}
+		if v.DeploymentType == nil || !strings.EqualFold(string(*v.DeploymentType), id.ScopeAccessName) {
+			continue
+		}
+
+		status := v
+		return &status, nil
+	}
+
+	return nil, nil
+}
@@ -127,14 +127,14 @@ func resourceNetworkProfileCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.Name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(id.Name, azureNetworkProfileResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	lockKeys := []string{locks.NameKey(id.Name, azureNetworkProfileResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(*subnetsToLock, SubnetResourceName)...)
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	parameters := network.Profile{
 		Location: &location,
@@ -221,14 +221,14 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(id.Name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(id.Name, azureNetworkProfileResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	lockKeys := []string{locks.NameKey(id.Name, azureNetworkProfileResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(*subnetsToLock, SubnetResourceName)...)
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	if _, err = client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -209,8 +209,11 @@ func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("Building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(id.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(id.Name, networkSecurityGroupResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, networkSecurityGroupResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	sg := network.SecurityGroup{
 		Name:     &id.Name,
@@ -204,8 +204,11 @@ func resourceNetworkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 	protocol := d.Get("protocol").(string)
 
 	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+		release, err := locks.Acquire(ctx, locks.NameKey(id.NetworkSecurityGroupName, networkSecurityGroupResourceName))
+		if err != nil {
+			return fmt.Errorf("acquiring lock: %+v", err)
+		}
+		defer release()
 	}
 
 	rule := network.SecurityRule{
@@ -364,8 +367,11 @@ func resourceNetworkSecurityRuleDelete(d *pluginsdk.ResourceData, meta interface
 	}
 
 	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+		release, err := locks.Acquire(ctx, locks.NameKey(id.NetworkSecurityGroupName, networkSecurityGroupResourceName))
+		if err != nil {
+			return fmt.Errorf("acquiring lock: %+v", err)
+		}
+		defer release()
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
@@ -210,8 +210,11 @@ func resourceNetworkWatcherFlowLogCreateUpdate(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	locks.ByID(nsgId.ID())
-	defer locks.UnlockByID(nsgId.ID())
+	release, err := locks.Acquire(ctx, nsgId.ID())
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	loc := d.Get("location").(string)
 	if loc == "" {
@@ -331,8 +334,11 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 	networkSecurityGroupID := d.Get("network_security_group_id").(string)
 	nsgId, _ := parse.NetworkSecurityGroupID(networkSecurityGroupID)
 
-	locks.ByID(nsgId.ID())
-	defer locks.UnlockByID(nsgId.ID())
+	release, err := locks.Acquire(ctx, nsgId.ID())
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	id, err := parse.FlowLogID(d.Id())
 	if err != nil {
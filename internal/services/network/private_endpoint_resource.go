@@ -284,12 +284,12 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	cosmosDbResIds := getCosmosDbResIdInPrivateServiceConnections(parameters.PrivateEndpointProperties)
-	for _, cosmosDbResId := range cosmosDbResIds {
-		log.Printf("[DEBUG] Add Lock For Private Endpoint %q, lock name: %q", id.Name, cosmosDbResId)
-		locks.ByName(cosmosDbResId, "azurerm_private_endpoint")
-		//goland:noinspection GoDeferInLoop
-		defer locks.UnlockByName(cosmosDbResId, "azurerm_private_endpoint")
+	log.Printf("[DEBUG] Add Locks For Private Endpoint %q, lock names: %q", id.Name, cosmosDbResIds)
+	release, err := locks.Acquire(ctx, locks.NameKeys(cosmosDbResIds, "azurerm_private_endpoint")...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
 	}
+	defer release()
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
 	if err != nil {
@@ -556,11 +556,11 @@ func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{})
 		},
 	}
 	cosmosDbResIds := getCosmosDbResIdInPrivateServiceConnections(parameters.PrivateEndpointProperties)
-	for _, cosmosDbResId := range cosmosDbResIds {
-		locks.ByName(cosmosDbResId, "azurerm_private_endpoint")
-		//goland:noinspection GoDeferInLoop
-		defer locks.UnlockByName(cosmosDbResId, "azurerm_private_endpoint")
+	release, err := locks.Acquire(ctx, locks.NameKeys(cosmosDbResIds, "azurerm_private_endpoint")...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
 	}
+	defer release()
 
 	log.Printf("[DEBUG] Deleting the Private Endpoint %q / Resource Group %q..", id.Name, id.ResourceGroup)
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
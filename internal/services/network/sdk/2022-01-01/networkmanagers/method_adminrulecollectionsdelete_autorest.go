@@ -0,0 +1,74 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type AdminRuleCollectionsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// AdminRuleCollectionsDelete ...
+func (c NetworkManagersClient) AdminRuleCollectionsDelete(ctx context.Context, id parse.NetworkManagerAdminRuleCollectionId) (result AdminRuleCollectionsDeleteResponse, err error) {
+	req, err := c.preparerForAdminRuleCollectionsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForAdminRuleCollectionsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// AdminRuleCollectionsDeleteThenPoll performs AdminRuleCollectionsDelete then polls until it's completed
+func (c NetworkManagersClient) AdminRuleCollectionsDeleteThenPoll(ctx context.Context, id parse.NetworkManagerAdminRuleCollectionId) error {
+	result, err := c.AdminRuleCollectionsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing AdminRuleCollectionsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after AdminRuleCollectionsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForAdminRuleCollectionsDelete prepares the AdminRuleCollectionsDelete request.
+func (c NetworkManagersClient) preparerForAdminRuleCollectionsDelete(ctx context.Context, id parse.NetworkManagerAdminRuleCollectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForAdminRuleCollectionsDelete sends the AdminRuleCollectionsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForAdminRuleCollectionsDelete(ctx context.Context, req *http.Request) (future AdminRuleCollectionsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type AdminRuleCollectionsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *AdminRuleCollection
+}
+
+// AdminRuleCollectionsGet ...
+func (c NetworkManagersClient) AdminRuleCollectionsGet(ctx context.Context, id parse.NetworkManagerAdminRuleCollectionId) (result AdminRuleCollectionsGetResponse, err error) {
+	req, err := c.preparerForAdminRuleCollectionsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAdminRuleCollectionsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAdminRuleCollectionsGet prepares the AdminRuleCollectionsGet request.
+func (c NetworkManagersClient) preparerForAdminRuleCollectionsGet(ctx context.Context, id parse.NetworkManagerAdminRuleCollectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAdminRuleCollectionsGet handles the response to the AdminRuleCollectionsGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForAdminRuleCollectionsGet(resp *http.Response) (result AdminRuleCollectionsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,74 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type AdminRulesDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// AdminRulesDelete ...
+func (c NetworkManagersClient) AdminRulesDelete(ctx context.Context, id parse.NetworkManagerAdminRuleId) (result AdminRulesDeleteResponse, err error) {
+	req, err := c.preparerForAdminRulesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForAdminRulesDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// AdminRulesDeleteThenPoll performs AdminRulesDelete then polls until it's completed
+func (c NetworkManagersClient) AdminRulesDeleteThenPoll(ctx context.Context, id parse.NetworkManagerAdminRuleId) error {
+	result, err := c.AdminRulesDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing AdminRulesDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after AdminRulesDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForAdminRulesDelete prepares the AdminRulesDelete request.
+func (c NetworkManagersClient) preparerForAdminRulesDelete(ctx context.Context, id parse.NetworkManagerAdminRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForAdminRulesDelete sends the AdminRulesDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForAdminRulesDelete(ctx context.Context, req *http.Request) (future AdminRulesDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type NetworkGroupsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkGroup
+}
+
+// NetworkGroupsGet ...
+func (c NetworkManagersClient) NetworkGroupsGet(ctx context.Context, id parse.NetworkManagerNetworkGroupId) (result NetworkGroupsGetResponse, err error) {
+	req, err := c.preparerForNetworkGroupsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForNetworkGroupsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForNetworkGroupsGet prepares the NetworkGroupsGet request.
+func (c NetworkManagersClient) preparerForNetworkGroupsGet(ctx context.Context, id parse.NetworkManagerNetworkGroupId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForNetworkGroupsGet handles the response to the NetworkGroupsGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForNetworkGroupsGet(resp *http.Response) (result NetworkGroupsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
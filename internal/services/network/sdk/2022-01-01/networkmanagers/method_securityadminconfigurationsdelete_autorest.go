@@ -0,0 +1,74 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type SecurityAdminConfigurationsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SecurityAdminConfigurationsDelete ...
+func (c NetworkManagersClient) SecurityAdminConfigurationsDelete(ctx context.Context, id parse.NetworkManagerSecurityAdminConfigurationId) (result SecurityAdminConfigurationsDeleteResponse, err error) {
+	req, err := c.preparerForSecurityAdminConfigurationsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSecurityAdminConfigurationsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SecurityAdminConfigurationsDeleteThenPoll performs SecurityAdminConfigurationsDelete then polls until it's completed
+func (c NetworkManagersClient) SecurityAdminConfigurationsDeleteThenPoll(ctx context.Context, id parse.NetworkManagerSecurityAdminConfigurationId) error {
+	result, err := c.SecurityAdminConfigurationsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SecurityAdminConfigurationsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SecurityAdminConfigurationsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSecurityAdminConfigurationsDelete prepares the SecurityAdminConfigurationsDelete request.
+func (c NetworkManagersClient) preparerForSecurityAdminConfigurationsDelete(ctx context.Context, id parse.NetworkManagerSecurityAdminConfigurationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSecurityAdminConfigurationsDelete sends the SecurityAdminConfigurationsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForSecurityAdminConfigurationsDelete(ctx context.Context, req *http.Request) (future SecurityAdminConfigurationsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type SecurityAdminConfigurationsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *SecurityAdminConfiguration
+}
+
+// SecurityAdminConfigurationsGet ...
+func (c NetworkManagersClient) SecurityAdminConfigurationsGet(ctx context.Context, id parse.NetworkManagerSecurityAdminConfigurationId) (result SecurityAdminConfigurationsGetResponse, err error) {
+	req, err := c.preparerForSecurityAdminConfigurationsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSecurityAdminConfigurationsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSecurityAdminConfigurationsGet prepares the SecurityAdminConfigurationsGet request.
+func (c NetworkManagersClient) preparerForSecurityAdminConfigurationsGet(ctx context.Context, id parse.NetworkManagerSecurityAdminConfigurationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSecurityAdminConfigurationsGet handles the response to the SecurityAdminConfigurationsGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForSecurityAdminConfigurationsGet(resp *http.Response) (result SecurityAdminConfigurationsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,62 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type StaticMembersDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// StaticMembersDelete ...
+func (c NetworkManagersClient) StaticMembersDelete(ctx context.Context, id parse.NetworkManagerStaticMemberId) (result StaticMembersDeleteResponse, err error) {
+	req, err := c.preparerForStaticMembersDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForStaticMembersDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForStaticMembersDelete prepares the StaticMembersDelete request.
+func (c NetworkManagersClient) preparerForStaticMembersDelete(ctx context.Context, id parse.NetworkManagerStaticMemberId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForStaticMembersDelete handles the response to the StaticMembersDelete request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForStaticMembersDelete(resp *http.Response) (result StaticMembersDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
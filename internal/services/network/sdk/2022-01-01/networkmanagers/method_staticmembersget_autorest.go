@@ -0,0 +1,65 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type StaticMembersGetResponse struct {
+	HttpResponse *http.Response
+	Model        *StaticMember
+}
+
+// StaticMembersGet ...
+func (c NetworkManagersClient) StaticMembersGet(ctx context.Context, id parse.NetworkManagerStaticMemberId) (result StaticMembersGetResponse, err error) {
+	req, err := c.preparerForStaticMembersGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForStaticMembersGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForStaticMembersGet prepares the StaticMembersGet request.
+func (c NetworkManagersClient) preparerForStaticMembersGet(ctx context.Context, id parse.NetworkManagerStaticMemberId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForStaticMembersGet handles the response to the StaticMembersGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForStaticMembersGet(resp *http.Response) (result StaticMembersGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,6 @@
+package networkmanagers
+
+type AddressPrefixItem struct {
+	AddressPrefix     *string            `json:"addressPrefix,omitempty"`
+	AddressPrefixType *AddressPrefixType `json:"addressPrefixType,omitempty"`
+}
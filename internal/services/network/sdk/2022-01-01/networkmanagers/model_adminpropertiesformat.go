@@ -0,0 +1,14 @@
+package networkmanagers
+
+type AdminPropertiesFormat struct {
+	Access                SecurityConfigurationRuleAccess    `json:"access"`
+	Description           *string                            `json:"description,omitempty"`
+	DestinationPortRanges *[]string                          `json:"destinationPortRanges,omitempty"`
+	Destinations          *[]AddressPrefixItem               `json:"destinations,omitempty"`
+	Direction             SecurityConfigurationRuleDirection `json:"direction"`
+	Priority              int64                              `json:"priority"`
+	Protocol              SecurityConfigurationRuleProtocol  `json:"protocol"`
+	ProvisioningState     *ProvisioningState                 `json:"provisioningState,omitempty"`
+	SourcePortRanges      *[]string                          `json:"sourcePortRanges,omitempty"`
+	Sources               *[]AddressPrefixItem               `json:"sources,omitempty"`
+}
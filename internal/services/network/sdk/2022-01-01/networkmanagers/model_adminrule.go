@@ -0,0 +1,11 @@
+package networkmanagers
+
+type AdminRule struct {
+	Etag       *string                `json:"etag,omitempty"`
+	Id         *string                `json:"id,omitempty"`
+	Kind       AdminRuleKind          `json:"kind"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *AdminPropertiesFormat `json:"properties,omitempty"`
+	SystemData *SystemData            `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
@@ -0,0 +1,10 @@
+package networkmanagers
+
+type AdminRuleCollection struct {
+	Etag       *string                              `json:"etag,omitempty"`
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *AdminRuleCollectionPropertiesFormat `json:"properties,omitempty"`
+	SystemData *SystemData                          `json:"systemData,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package networkmanagers
+
+type ConnectivityConfigurationProperties struct {
+	AppliesToGroups       []ConnectivityGroupItem `json:"appliesToGroups"`
+	ConnectivityTopology  ConnectivityTopology    `json:"connectivityTopology"`
+	DeleteExistingPeering *DeleteExistingPeering  `json:"deleteExistingPeering,omitempty"`
+	Description           *string                 `json:"description,omitempty"`
+	Hubs                  *[]Hub                  `json:"hubs,omitempty"`
+	IsGlobal              *IsGlobal               `json:"isGlobal,omitempty"`
+	ProvisioningState     *ProvisioningState      `json:"provisioningState,omitempty"`
+}
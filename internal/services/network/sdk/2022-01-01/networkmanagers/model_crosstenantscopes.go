@@ -0,0 +1,7 @@
+package networkmanagers
+
+type CrossTenantScopes struct {
+	ManagementGroups *[]string `json:"managementGroups,omitempty"`
+	Subscriptions    *[]string `json:"subscriptions,omitempty"`
+	TenantId         *string   `json:"tenantId,omitempty"`
+}
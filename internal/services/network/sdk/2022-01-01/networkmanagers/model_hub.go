@@ -0,0 +1,6 @@
+package networkmanagers
+
+type Hub struct {
+	ResourceId   *string `json:"resourceId,omitempty"`
+	ResourceType *string `json:"resourceType,omitempty"`
+}
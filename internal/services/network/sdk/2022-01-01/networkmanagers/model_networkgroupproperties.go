@@ -0,0 +1,6 @@
+package networkmanagers
+
+type NetworkGroupProperties struct {
+	Description       *string            `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,7 @@
+package networkmanagers
+
+type NetworkManagerPropertiesNetworkManagerScopes struct {
+	CrossTenantScopes *[]CrossTenantScopes `json:"crossTenantScopes,omitempty"`
+	ManagementGroups  *[]string            `json:"managementGroups,omitempty"`
+	Subscriptions     *[]string            `json:"subscriptions,omitempty"`
+}
@@ -0,0 +1,5 @@
+package networkmanagers
+
+type NetworkManagerSecurityGroupItem struct {
+	NetworkGroupId string `json:"networkGroupId"`
+}
@@ -0,0 +1,7 @@
+package networkmanagers
+
+type SecurityAdminConfigurationPropertiesFormat struct {
+	ApplyOnNetworkIntentPolicyBasedServices *[]NetworkIntentPolicyBasedService `json:"applyOnNetworkIntentPolicyBasedServices,omitempty"`
+	Description                             *string                            `json:"description,omitempty"`
+	ProvisioningState                       *ProvisioningState                 `json:"provisioningState,omitempty"`
+}
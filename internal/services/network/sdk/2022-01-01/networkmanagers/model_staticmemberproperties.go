@@ -0,0 +1,7 @@
+package networkmanagers
+
+type StaticMemberProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Region            *string            `json:"region,omitempty"`
+	ResourceId        *string            `json:"resourceId,omitempty"`
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -73,14 +74,11 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
-	locks.ByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(parsedSubnetId.Name, SubnetResourceName)
-	defer locks.UnlockByName(parsedSubnetId.Name, SubnetResourceName)
+	release, err := lockSubnetNetworkSecurityGroupAssociation(ctx, *parsedSubnetId, parsedNetworkSecurityGroupId.Name)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	subnet, err := client.Get(ctx, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName, parsedSubnetId.Name, "")
 	if err != nil {
@@ -218,14 +216,11 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	release, err := lockSubnetNetworkSecurityGroupAssociation(ctx, *id, parsedNetworkSecurityGroupId.Name)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
@@ -251,3 +246,13 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 
 	return nil
 }
+
+// lockSubnetNetworkSecurityGroupAssociation acquires the locks for the Network Security Group, Virtual Network
+// and Subnet in a consistent order, returning a function which releases these locks
+func lockSubnetNetworkSecurityGroupAssociation(ctx context.Context, subnetId parse.SubnetId, networkSecurityGroupName string) (func(), error) {
+	return locks.Acquire(ctx,
+		locks.NameKey(networkSecurityGroupName, networkSecurityGroupResourceName),
+		locks.NameKey(subnetId.VirtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetId.Name, SubnetResourceName),
+	)
+}
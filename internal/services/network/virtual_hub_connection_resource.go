@@ -177,16 +177,16 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 
 	id := parse.NewHubVirtualNetworkConnectionID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, d.Get("name").(string))
 
-	locks.ByName(virtualHubId.Name, virtualHubResourceName)
-	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
-
 	remoteVirtualNetworkId, err := parse.VirtualNetworkID(d.Get("remote_virtual_network_id").(string))
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
-	defer locks.UnlockByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(virtualHubId.Name, virtualHubResourceName), locks.NameKey(remoteVirtualNetworkId.Name, VirtualNetworkResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
@@ -296,8 +296,11 @@ func resourceVirtualHubConnectionDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.VirtualHubName, virtualHubResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
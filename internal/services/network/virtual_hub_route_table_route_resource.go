@@ -99,8 +99,11 @@ func resourceVirtualHubRouteTableRouteCreateUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
-	locks.ByName(routeTableId.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(routeTableId.VirtualHubName, virtualHubResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(routeTableId.VirtualHubName, virtualHubResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	routeTable, err := client.Get(ctx, routeTableId.ResourceGroup, routeTableId.VirtualHubName, routeTableId.Name)
 	if err != nil {
@@ -219,8 +222,11 @@ func resourceVirtualHubRouteTableRouteDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	locks.ByName(route.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(route.VirtualHubName, virtualHubResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(route.VirtualHubName, virtualHubResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	// get latest list of routes
 	routeTable, err := client.Get(ctx, route.ResourceGroup, route.VirtualHubName, route.HubRouteTableName)
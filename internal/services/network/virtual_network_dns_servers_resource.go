@@ -76,8 +76,11 @@ func resourceVirtualNetworkDnsServersCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.VirtualNetworkName, VirtualNetworkResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
@@ -166,8 +169,11 @@ func resourceVirtualNetworkDnsServersDelete(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.VirtualNetworkName, VirtualNetworkResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
@@ -386,8 +386,11 @@ func resourceVpnGatewayConnectionResourceCreateUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	locks.ByName(gatewayId.Name, VPNGatewayResourceName)
-	defer locks.UnlockByName(gatewayId.Name, VPNGatewayResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(gatewayId.Name, VPNGatewayResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	param := network.VpnConnection{
 		Name: &name,
@@ -502,8 +505,11 @@ func resourceVpnGatewayConnectionResourceDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.VpnGatewayName, VPNGatewayResourceName)
-	defer locks.UnlockByName(id.VpnGatewayName, VPNGatewayResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.VpnGatewayName, VPNGatewayResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
 	if err != nil {
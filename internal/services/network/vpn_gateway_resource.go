@@ -283,8 +283,11 @@ func resourceVPNGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, VPNGatewayResourceName)
-	defer locks.UnlockByName(id.Name, VPNGatewayResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, VPNGatewayResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -3,11 +3,13 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsresolvers"
 )
 
 type Client struct {
 	RecordSetsClient          *privatedns.RecordSetsClient
 	PrivateZonesClient        *privatedns.PrivateZonesClient
+	ResolversClient           *dnsresolvers.DnsResolversClient
 	VirtualNetworkLinksClient *privatedns.VirtualNetworkLinksClient
 }
 
@@ -18,12 +20,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	privateZonesClient := privatedns.NewPrivateZonesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&privateZonesClient.Client, o.ResourceManagerAuthorizer)
 
+	resolversClient := dnsresolvers.NewDnsResolversClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resolversClient.Client, o.ResourceManagerAuthorizer)
+
 	virtualNetworkLinksClient := privatedns.NewVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&virtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		RecordSetsClient:          &recordSetsClient,
 		PrivateZonesClient:        &privateZonesClient,
+		ResolversClient:           &resolversClient,
 		VirtualNetworkLinksClient: &virtualNetworkLinksClient,
 	}
 }
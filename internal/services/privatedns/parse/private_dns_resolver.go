@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PrivateDnsResolverId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	DnsResolverName string
+}
+
+func NewPrivateDnsResolverID(subscriptionId, resourceGroup, dnsResolverName string) PrivateDnsResolverId {
+	return PrivateDnsResolverId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		DnsResolverName: dnsResolverName,
+	}
+}
+
+func (id PrivateDnsResolverId) String() string {
+	segments := []string{
+		fmt.Sprintf("Dns Resolver Name %q", id.DnsResolverName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Private Dns Resolver", segmentsStr)
+}
+
+func (id PrivateDnsResolverId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolvers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsResolverName)
+}
+
+// PrivateDnsResolverID parses a PrivateDnsResolver ID into an PrivateDnsResolverId struct
+func PrivateDnsResolverID(input string) (*PrivateDnsResolverId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PrivateDnsResolverId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsResolverName, err = id.PopSegment("dnsResolvers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
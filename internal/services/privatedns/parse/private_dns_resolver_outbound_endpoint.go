@@ -0,0 +1,131 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PrivateDnsResolverOutboundEndpointId struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	DnsResolverName      string
+	OutboundEndpointName string
+}
+
+func NewPrivateDnsResolverOutboundEndpointID(subscriptionId, resourceGroup, dnsResolverName, outboundEndpointName string) PrivateDnsResolverOutboundEndpointId {
+	return PrivateDnsResolverOutboundEndpointId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		DnsResolverName:      dnsResolverName,
+		OutboundEndpointName: outboundEndpointName,
+	}
+}
+
+func (id PrivateDnsResolverOutboundEndpointId) String() string {
+	segments := []string{
+		fmt.Sprintf("Outbound Endpoint Name %q", id.OutboundEndpointName),
+		fmt.Sprintf("Dns Resolver Name %q", id.DnsResolverName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Private Dns Resolver Outbound Endpoint", segmentsStr)
+}
+
+func (id PrivateDnsResolverOutboundEndpointId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolvers/%s/outboundEndpoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.OutboundEndpointName)
+}
+
+// PrivateDnsResolverOutboundEndpointID parses a PrivateDnsResolverOutboundEndpoint ID into an PrivateDnsResolverOutboundEndpointId struct
+func PrivateDnsResolverOutboundEndpointID(input string) (*PrivateDnsResolverOutboundEndpointId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PrivateDnsResolverOutboundEndpointId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsResolverName, err = id.PopSegment("dnsResolvers"); err != nil {
+		return nil, err
+	}
+	if resourceId.OutboundEndpointName, err = id.PopSegment("outboundEndpoints"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// PrivateDnsResolverOutboundEndpointIDInsensitively parses an PrivateDnsResolverOutboundEndpoint ID into an PrivateDnsResolverOutboundEndpointId struct, insensitively
+// This should only be used to parse an ID for rewriting, the PrivateDnsResolverOutboundEndpointID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func PrivateDnsResolverOutboundEndpointIDInsensitively(input string) (*PrivateDnsResolverOutboundEndpointId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PrivateDnsResolverOutboundEndpointId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'dnsResolvers' segment
+	dnsResolversKey := "dnsResolvers"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnsResolversKey) {
+			dnsResolversKey = key
+			break
+		}
+	}
+	if resourceId.DnsResolverName, err = id.PopSegment(dnsResolversKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'outboundEndpoints' segment
+	outboundEndpointsKey := "outboundEndpoints"
+	for key := range id.Path {
+		if strings.EqualFold(key, outboundEndpointsKey) {
+			outboundEndpointsKey = key
+			break
+		}
+	}
+	if resourceId.OutboundEndpointName, err = id.PopSegment(outboundEndpointsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,41 @@
+package privatedns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateDnsAaaaRecordDataSource struct{}
+
+func TestAccDataSourcePrivateDnsAaaaRecord_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_dns_aaaa_record", "test")
+	r := PrivateDnsAaaaRecordDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("zone_name").Exists(),
+				check.That(data.ResourceName).Key("records.#").HasValue("2"),
+				check.That(data.ResourceName).Key("ttl").HasValue("300"),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (PrivateDnsAaaaRecordDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_aaaa_record" "test" {
+  name                = azurerm_private_dns_aaaa_record.test.name
+  resource_group_name = azurerm_private_dns_aaaa_record.test.resource_group_name
+  zone_name           = azurerm_private_dns_zone.test.name
+}
+`, PrivateDnsAAAARecordResource{}.basic(data))
+}
@@ -0,0 +1,254 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverDnsForwardingRulesetResource{}
+
+type PrivateDnsResolverDnsForwardingRulesetResource struct{}
+
+type PrivateDnsResolverDnsForwardingRulesetModel struct {
+	Name                                  string            `tfschema:"name"`
+	ResourceGroupName                     string            `tfschema:"resource_group_name"`
+	Location                              string            `tfschema:"location"`
+	PrivateDnsResolverOutboundEndpointIds []string          `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
+	Tags                                  map[string]string `tfschema:"tags"`
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) ModelObject() interface{} {
+	return &PrivateDnsResolverDnsForwardingRulesetModel{}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverDnsForwardingRulesetID
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.SchemaForResourceType("Microsoft.Network/dnsForwardingRulesets"),
+
+		"private_dns_resolver_outbound_endpoint_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.PrivateDnsResolverOutboundEndpointID,
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.ResolversClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := parse.NewPrivateDnsResolverDnsForwardingRulesetID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.DnsForwardingRulesetsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			outboundEndpoints, err := expandPrivateDnsResolverOutboundEndpointIds(model.PrivateDnsResolverOutboundEndpointIds)
+			if err != nil {
+				return err
+			}
+
+			input := dnsresolvers.DnsForwardingRuleset{
+				Location: location.Normalize(model.Location),
+				Properties: dnsresolvers.DnsForwardingRulesetProperties{
+					DnsResolverOutboundEndpoints: outboundEndpoints,
+				},
+				Tags: tags.FromTypedObjectPointer(model.Tags),
+			}
+
+			if err := client.DnsForwardingRulesetsCreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.DnsForwardingRulesetsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverDnsForwardingRulesetModel{
+				Name:              id.DnsForwardingRulesetName,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.ToTypedObjectPointer(model.Tags)
+
+				outboundEndpointIds, err := flattenPrivateDnsResolverOutboundEndpointIds(model.Properties.DnsResolverOutboundEndpoints)
+				if err != nil {
+					return err
+				}
+				state.PrivateDnsResolverOutboundEndpointIds = outboundEndpointIds
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.DnsForwardingRulesetsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("private_dns_resolver_outbound_endpoint_ids") {
+				outboundEndpoints, err := expandPrivateDnsResolverOutboundEndpointIds(model.PrivateDnsResolverOutboundEndpointIds)
+				if err != nil {
+					return err
+				}
+				payload.Properties.DnsResolverOutboundEndpoints = outboundEndpoints
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.FromTypedObjectPointer(model.Tags)
+			}
+
+			if err := client.DnsForwardingRulesetsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DnsForwardingRulesetsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsResolverOutboundEndpointIds(input []string) (*[]dnsresolvers.SubResource, error) {
+	output := make([]dnsresolvers.SubResource, 0)
+	for _, v := range input {
+		outboundEndpointId, err := parse.PrivateDnsResolverOutboundEndpointID(v)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, dnsresolvers.SubResource{
+			Id: outboundEndpointId.ID(),
+		})
+	}
+
+	return &output, nil
+}
+
+func flattenPrivateDnsResolverOutboundEndpointIds(input *[]dnsresolvers.SubResource) ([]string, error) {
+	output := make([]string, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		outboundEndpointId, err := parse.PrivateDnsResolverOutboundEndpointIDInsensitively(v.Id)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, outboundEndpointId.ID())
+	}
+
+	return output, nil
+}
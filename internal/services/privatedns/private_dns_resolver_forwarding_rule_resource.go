@@ -0,0 +1,297 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverForwardingRuleResource{}
+
+type PrivateDnsResolverForwardingRuleResource struct{}
+
+type PrivateDnsResolverForwardingRuleModel struct {
+	Name                   string                                   `tfschema:"name"`
+	DnsForwardingRulesetId string                                   `tfschema:"dns_forwarding_ruleset_id"`
+	DomainName             string                                   `tfschema:"domain_name"`
+	TargetDnsServers       []PrivateDnsResolverTargetDnsServerModel `tfschema:"target_dns_servers"`
+	Enabled                bool                                     `tfschema:"enabled"`
+	Metadata               map[string]string                        `tfschema:"metadata"`
+}
+
+type PrivateDnsResolverTargetDnsServerModel struct {
+	IPAddress string `tfschema:"ip_address"`
+	Port      int    `tfschema:"port"`
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rule"
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) ModelObject() interface{} {
+	return &PrivateDnsResolverForwardingRuleModel{}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverForwardingRuleID
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverDnsForwardingRulesetID,
+		},
+
+		"domain_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^\s]+\.$`),
+				"`domain_name` must be a fully qualified domain name ending with a `.`",
+			),
+		},
+
+		"target_dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      53,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.ResolversClient
+			rulesetId, err := parse.PrivateDnsResolverDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPrivateDnsResolverForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroup, rulesetId.DnsForwardingRulesetName, model.Name)
+			existing, err := client.ForwardingRulesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := dnsresolvers.ForwardingRule{
+				Properties: dnsresolvers.ForwardingRuleProperties{
+					DomainName:          model.DomainName,
+					ForwardingRuleState: expandPrivateDnsResolverForwardingRuleState(model.Enabled),
+					Metadata:            &model.Metadata,
+					TargetDnsServers:    expandPrivateDnsResolverTargetDnsServers(model.TargetDnsServers),
+				},
+			}
+
+			if _, err := client.ForwardingRulesCreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ForwardingRulesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverForwardingRuleModel{
+				Name:                   id.ForwardingRuleName,
+				DnsForwardingRulesetId: parse.NewPrivateDnsResolverDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DomainName = props.DomainName
+				state.Enabled = props.ForwardingRuleState == nil || *props.ForwardingRuleState == dnsresolvers.ForwardingRuleStateEnabled
+				state.TargetDnsServers = flattenPrivateDnsResolverTargetDnsServers(props.TargetDnsServers)
+				if props.Metadata != nil {
+					state.Metadata = *props.Metadata
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.ForwardingRulesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("target_dns_servers") {
+				payload.Properties.TargetDnsServers = expandPrivateDnsResolverTargetDnsServers(model.TargetDnsServers)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.ForwardingRuleState = expandPrivateDnsResolverForwardingRuleState(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("metadata") {
+				payload.Properties.Metadata = &model.Metadata
+			}
+
+			if _, err := client.ForwardingRulesCreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if _, err := client.ForwardingRulesDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsResolverForwardingRuleState(enabled bool) *dnsresolvers.ForwardingRuleState {
+	state := dnsresolvers.ForwardingRuleStateDisabled
+	if enabled {
+		state = dnsresolvers.ForwardingRuleStateEnabled
+	}
+	return &state
+}
+
+func expandPrivateDnsResolverTargetDnsServers(input []PrivateDnsResolverTargetDnsServerModel) []dnsresolvers.TargetDnsServer {
+	output := make([]dnsresolvers.TargetDnsServer, 0)
+	for _, v := range input {
+		output = append(output, dnsresolvers.TargetDnsServer{
+			IPAddress: utils.String(v.IPAddress),
+			Port:      utils.Int64(int64(v.Port)),
+		})
+	}
+	return output
+}
+
+func flattenPrivateDnsResolverTargetDnsServers(input []dnsresolvers.TargetDnsServer) []PrivateDnsResolverTargetDnsServerModel {
+	output := make([]PrivateDnsResolverTargetDnsServerModel, 0)
+	for _, v := range input {
+		// the API defaults the port to 53 when it's omitted
+		port := 53
+		if v.Port != nil {
+			port = int(*v.Port)
+		}
+
+		output = append(output, PrivateDnsResolverTargetDnsServerModel{
+			IPAddress: utils.NormalizeNilableString(v.IPAddress),
+			Port:      port,
+		})
+	}
+	return output
+}
@@ -0,0 +1,313 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverInboundEndpointResource{}
+
+type PrivateDnsResolverInboundEndpointResource struct{}
+
+type PrivateDnsResolverInboundEndpointModel struct {
+	Name                 string                                   `tfschema:"name"`
+	PrivateDnsResolverId string                                   `tfschema:"private_dns_resolver_id"`
+	Location             string                                   `tfschema:"location"`
+	IPConfigurations     []PrivateDnsResolverIPConfigurationModel `tfschema:"ip_configurations"`
+	Tags                 map[string]string                        `tfschema:"tags"`
+}
+
+type PrivateDnsResolverIPConfigurationModel struct {
+	SubnetId                  string `tfschema:"subnet_id"`
+	PrivateIPAddress          string `tfschema:"private_ip_address"`
+	PrivateIPAllocationMethod string `tfschema:"private_ip_allocation_method"`
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_inbound_endpoint"
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) ModelObject() interface{} {
+	return &PrivateDnsResolverInboundEndpointModel{}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverInboundEndpointID
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"private_dns_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverID,
+		},
+
+		"location": location.SchemaForResourceType("Microsoft.Network/dnsResolvers/inboundEndpoints"),
+
+		"ip_configurations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"private_ip_allocation_method": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(dnsresolvers.IPAllocationMethodDynamic),
+						ValidateFunc: validation.StringInSlice(
+							dnsresolvers.PossibleValuesForIPAllocationMethod(),
+							false,
+						),
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.ResolversClient
+			dnsResolverId, err := parse.PrivateDnsResolverID(model.PrivateDnsResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPrivateDnsResolverInboundEndpointID(dnsResolverId.SubscriptionId, dnsResolverId.ResourceGroup, dnsResolverId.DnsResolverName, model.Name)
+			existing, err := client.InboundEndpointsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ipConfigurations, err := expandPrivateDnsResolverIPConfigurations(model.IPConfigurations)
+			if err != nil {
+				return err
+			}
+
+			input := dnsresolvers.InboundEndpoint{
+				Location: location.Normalize(model.Location),
+				Properties: dnsresolvers.InboundEndpointProperties{
+					IPConfigurations: ipConfigurations,
+				},
+				Tags: tags.FromTypedObjectPointer(model.Tags),
+			}
+
+			if err := client.InboundEndpointsCreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.InboundEndpointsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverInboundEndpointModel{
+				Name:                 id.InboundEndpointName,
+				PrivateDnsResolverId: parse.NewPrivateDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.ToTypedObjectPointer(model.Tags)
+
+				ipConfigurations, err := flattenPrivateDnsResolverIPConfigurations(model.Properties.IPConfigurations)
+				if err != nil {
+					return err
+				}
+				state.IPConfigurations = ipConfigurations
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.InboundEndpointsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.FromTypedObjectPointer(model.Tags)
+			}
+
+			if err := client.InboundEndpointsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolversClient
+			id, err := parse.PrivateDnsResolverInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.InboundEndpointsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsResolverIPConfigurations(input []PrivateDnsResolverIPConfigurationModel) (*[]dnsresolvers.IPConfiguration, error) {
+	output := make([]dnsresolvers.IPConfiguration, 0)
+	for _, v := range input {
+		subnetId, err := networkParse.SubnetID(v.SubnetId)
+		if err != nil {
+			return nil, err
+		}
+
+		allocationMethod := dnsresolvers.IPAllocationMethod(v.PrivateIPAllocationMethod)
+		ipConfiguration := dnsresolvers.IPConfiguration{
+			PrivateIPAllocationMethod: &allocationMethod,
+			Subnet: &dnsresolvers.SubResource{
+				Id: subnetId.ID(),
+			},
+		}
+
+		// a Private IP Address can only be specified when it's statically allocated
+		if allocationMethod == dnsresolvers.IPAllocationMethodStatic {
+			if v.PrivateIPAddress == "" {
+				return nil, fmt.Errorf("`private_ip_address` must be specified when `private_ip_allocation_method` is `%s`", dnsresolvers.IPAllocationMethodStatic)
+			}
+			ipConfiguration.PrivateIPAddress = utils.String(v.PrivateIPAddress)
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return &output, nil
+}
+
+func flattenPrivateDnsResolverIPConfigurations(input *[]dnsresolvers.IPConfiguration) ([]PrivateDnsResolverIPConfigurationModel, error) {
+	output := make([]PrivateDnsResolverIPConfigurationModel, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		ipConfiguration := PrivateDnsResolverIPConfigurationModel{
+			PrivateIPAddress: utils.NormalizeNilableString(v.PrivateIPAddress),
+		}
+
+		if v.PrivateIPAllocationMethod != nil {
+			ipConfiguration.PrivateIPAllocationMethod = string(*v.PrivateIPAllocationMethod)
+		}
+
+		if v.Subnet != nil {
+			subnetId, err := networkParse.SubnetIDInsensitively(v.Subnet.Id)
+			if err != nil {
+				return nil, err
+			}
+			ipConfiguration.SubnetId = subnetId.ID()
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return output, nil
+}
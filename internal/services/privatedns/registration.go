@@ -1,9 +1,15 @@
 package privatedns
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+var (
+	_ sdk.TypedServiceRegistration   = Registration{}
+	_ sdk.UntypedServiceRegistration = Registration{}
+)
+
 type Registration struct{}
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -15,6 +21,13 @@ func (r Registration) Name() string {
 	return "Private DNS"
 }
 
+// ResourceProviders returns the Resource Providers required by the Resources within this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Network",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -25,7 +38,14 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_private_dns_zone": dataSourcePrivateDnsZone(),
+		"azurerm_private_dns_zone":         dataSourcePrivateDnsZone(),
+		"azurerm_private_dns_a_record":     dataSourcePrivateDnsARecord(),
+		"azurerm_private_dns_aaaa_record":  dataSourcePrivateDnsAaaaRecord(),
+		"azurerm_private_dns_cname_record": dataSourcePrivateDnsCNameRecord(),
+		"azurerm_private_dns_mx_record":    dataSourcePrivateDnsMxRecord(),
+		"azurerm_private_dns_ptr_record":   dataSourcePrivateDnsPtrRecord(),
+		"azurerm_private_dns_srv_record":   dataSourcePrivateDnsSrvRecord(),
+		"azurerm_private_dns_txt_record":   dataSourcePrivateDnsTxtRecord(),
 	}
 }
 
@@ -43,3 +63,20 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_private_dns_zone_virtual_network_link": resourcePrivateDnsZoneVirtualNetworkLink(),
 	}
 }
+
+// DataSources returns the typed DataSources supported by this service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns the typed Resources supported by this service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PrivateDnsResolverResource{},
+		PrivateDnsResolverDnsForwardingRulesetResource{},
+		PrivateDnsResolverForwardingRuleResource{},
+		PrivateDnsResolverInboundEndpointResource{},
+		PrivateDnsResolverOutboundEndpointResource{},
+		PrivateDnsResolverVirtualNetworkLinkResource{},
+	}
+}
@@ -0,0 +1,76 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type DnsForwardingRulesetsCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// DnsForwardingRulesetsCreateOrUpdate ...
+func (c DnsResolversClient) DnsForwardingRulesetsCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId, input DnsForwardingRuleset) (result DnsForwardingRulesetsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForDnsForwardingRulesetsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "DnsForwardingRulesetsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDnsForwardingRulesetsCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "DnsForwardingRulesetsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DnsForwardingRulesetsCreateOrUpdateThenPoll performs DnsForwardingRulesetsCreateOrUpdate then polls until it's completed
+func (c DnsResolversClient) DnsForwardingRulesetsCreateOrUpdateThenPoll(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId, input DnsForwardingRuleset) error {
+	result, err := c.DnsForwardingRulesetsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing DnsForwardingRulesetsCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DnsForwardingRulesetsCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDnsForwardingRulesetsCreateOrUpdate prepares the DnsForwardingRulesetsCreateOrUpdate request.
+func (c DnsResolversClient) preparerForDnsForwardingRulesetsCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId, input DnsForwardingRuleset) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDnsForwardingRulesetsCreateOrUpdate sends the DnsForwardingRulesetsCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForDnsForwardingRulesetsCreateOrUpdate(ctx context.Context, req *http.Request) (future DnsForwardingRulesetsCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,74 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type DnsForwardingRulesetsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// DnsForwardingRulesetsDelete ...
+func (c DnsResolversClient) DnsForwardingRulesetsDelete(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId) (result DnsForwardingRulesetsDeleteResponse, err error) {
+	req, err := c.preparerForDnsForwardingRulesetsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "DnsForwardingRulesetsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDnsForwardingRulesetsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "DnsForwardingRulesetsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DnsForwardingRulesetsDeleteThenPoll performs DnsForwardingRulesetsDelete then polls until it's completed
+func (c DnsResolversClient) DnsForwardingRulesetsDeleteThenPoll(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId) error {
+	result, err := c.DnsForwardingRulesetsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing DnsForwardingRulesetsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DnsForwardingRulesetsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDnsForwardingRulesetsDelete prepares the DnsForwardingRulesetsDelete request.
+func (c DnsResolversClient) preparerForDnsForwardingRulesetsDelete(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDnsForwardingRulesetsDelete sends the DnsForwardingRulesetsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForDnsForwardingRulesetsDelete(ctx context.Context, req *http.Request) (future DnsForwardingRulesetsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package dnsresolvers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type DnsForwardingRulesetsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *DnsForwardingRuleset
+}
+
+// DnsForwardingRulesetsGet ...
+func (c DnsResolversClient) DnsForwardingRulesetsGet(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId) (result DnsForwardingRulesetsGetResponse, err error) {
+	req, err := c.preparerForDnsForwardingRulesetsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "DnsForwardingRulesetsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "DnsForwardingRulesetsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDnsForwardingRulesetsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "DnsForwardingRulesetsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDnsForwardingRulesetsGet prepares the DnsForwardingRulesetsGet request.
+func (c DnsResolversClient) preparerForDnsForwardingRulesetsGet(ctx context.Context, id parse.PrivateDnsResolverDnsForwardingRulesetId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDnsForwardingRulesetsGet handles the response to the DnsForwardingRulesetsGet request. The method always
+// closes the http.Response Body.
+func (c DnsResolversClient) responderForDnsForwardingRulesetsGet(resp *http.Response) (result DnsForwardingRulesetsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,66 @@
+package dnsresolvers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type ForwardingRulesCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *ForwardingRule
+}
+
+// ForwardingRulesCreateOrUpdate ...
+func (c DnsResolversClient) ForwardingRulesCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverForwardingRuleId, input ForwardingRule) (result ForwardingRulesCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForForwardingRulesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForForwardingRulesCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForForwardingRulesCreateOrUpdate prepares the ForwardingRulesCreateOrUpdate request.
+func (c DnsResolversClient) preparerForForwardingRulesCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverForwardingRuleId, input ForwardingRule) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForForwardingRulesCreateOrUpdate handles the response to the ForwardingRulesCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c DnsResolversClient) responderForForwardingRulesCreateOrUpdate(resp *http.Response) (result ForwardingRulesCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,62 @@
+package dnsresolvers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type ForwardingRulesDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// ForwardingRulesDelete ...
+func (c DnsResolversClient) ForwardingRulesDelete(ctx context.Context, id parse.PrivateDnsResolverForwardingRuleId) (result ForwardingRulesDeleteResponse, err error) {
+	req, err := c.preparerForForwardingRulesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForForwardingRulesDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForForwardingRulesDelete prepares the ForwardingRulesDelete request.
+func (c DnsResolversClient) preparerForForwardingRulesDelete(ctx context.Context, id parse.PrivateDnsResolverForwardingRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForForwardingRulesDelete handles the response to the ForwardingRulesDelete request. The method always
+// closes the http.Response Body.
+func (c DnsResolversClient) responderForForwardingRulesDelete(resp *http.Response) (result ForwardingRulesDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package dnsresolvers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type ForwardingRulesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ForwardingRule
+}
+
+// ForwardingRulesGet ...
+func (c DnsResolversClient) ForwardingRulesGet(ctx context.Context, id parse.PrivateDnsResolverForwardingRuleId) (result ForwardingRulesGetResponse, err error) {
+	req, err := c.preparerForForwardingRulesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForForwardingRulesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "ForwardingRulesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForForwardingRulesGet prepares the ForwardingRulesGet request.
+func (c DnsResolversClient) preparerForForwardingRulesGet(ctx context.Context, id parse.PrivateDnsResolverForwardingRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForForwardingRulesGet handles the response to the ForwardingRulesGet request. The method always
+// closes the http.Response Body.
+func (c DnsResolversClient) responderForForwardingRulesGet(resp *http.Response) (result ForwardingRulesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
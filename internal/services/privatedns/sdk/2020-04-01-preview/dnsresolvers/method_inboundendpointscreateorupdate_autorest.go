@@ -0,0 +1,76 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type InboundEndpointsCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// InboundEndpointsCreateOrUpdate ...
+func (c DnsResolversClient) InboundEndpointsCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId, input InboundEndpoint) (result InboundEndpointsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForInboundEndpointsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "InboundEndpointsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForInboundEndpointsCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "InboundEndpointsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// InboundEndpointsCreateOrUpdateThenPoll performs InboundEndpointsCreateOrUpdate then polls until it's completed
+func (c DnsResolversClient) InboundEndpointsCreateOrUpdateThenPoll(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId, input InboundEndpoint) error {
+	result, err := c.InboundEndpointsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing InboundEndpointsCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after InboundEndpointsCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForInboundEndpointsCreateOrUpdate prepares the InboundEndpointsCreateOrUpdate request.
+func (c DnsResolversClient) preparerForInboundEndpointsCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId, input InboundEndpoint) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForInboundEndpointsCreateOrUpdate sends the InboundEndpointsCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForInboundEndpointsCreateOrUpdate(ctx context.Context, req *http.Request) (future InboundEndpointsCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,74 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type InboundEndpointsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// InboundEndpointsDelete ...
+func (c DnsResolversClient) InboundEndpointsDelete(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId) (result InboundEndpointsDeleteResponse, err error) {
+	req, err := c.preparerForInboundEndpointsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "InboundEndpointsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForInboundEndpointsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "InboundEndpointsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// InboundEndpointsDeleteThenPoll performs InboundEndpointsDelete then polls until it's completed
+func (c DnsResolversClient) InboundEndpointsDeleteThenPoll(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId) error {
+	result, err := c.InboundEndpointsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing InboundEndpointsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after InboundEndpointsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForInboundEndpointsDelete prepares the InboundEndpointsDelete request.
+func (c DnsResolversClient) preparerForInboundEndpointsDelete(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForInboundEndpointsDelete sends the InboundEndpointsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForInboundEndpointsDelete(ctx context.Context, req *http.Request) (future InboundEndpointsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package dnsresolvers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type InboundEndpointsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *InboundEndpoint
+}
+
+// InboundEndpointsGet ...
+func (c DnsResolversClient) InboundEndpointsGet(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId) (result InboundEndpointsGetResponse, err error) {
+	req, err := c.preparerForInboundEndpointsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "InboundEndpointsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "InboundEndpointsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForInboundEndpointsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "InboundEndpointsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForInboundEndpointsGet prepares the InboundEndpointsGet request.
+func (c DnsResolversClient) preparerForInboundEndpointsGet(ctx context.Context, id parse.PrivateDnsResolverInboundEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForInboundEndpointsGet handles the response to the InboundEndpointsGet request. The method always
+// closes the http.Response Body.
+func (c DnsResolversClient) responderForInboundEndpointsGet(resp *http.Response) (result InboundEndpointsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,76 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type OutboundEndpointsCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// OutboundEndpointsCreateOrUpdate ...
+func (c DnsResolversClient) OutboundEndpointsCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId, input OutboundEndpoint) (result OutboundEndpointsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForOutboundEndpointsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "OutboundEndpointsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForOutboundEndpointsCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "OutboundEndpointsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// OutboundEndpointsCreateOrUpdateThenPoll performs OutboundEndpointsCreateOrUpdate then polls until it's completed
+func (c DnsResolversClient) OutboundEndpointsCreateOrUpdateThenPoll(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId, input OutboundEndpoint) error {
+	result, err := c.OutboundEndpointsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing OutboundEndpointsCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after OutboundEndpointsCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForOutboundEndpointsCreateOrUpdate prepares the OutboundEndpointsCreateOrUpdate request.
+func (c DnsResolversClient) preparerForOutboundEndpointsCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId, input OutboundEndpoint) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForOutboundEndpointsCreateOrUpdate sends the OutboundEndpointsCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForOutboundEndpointsCreateOrUpdate(ctx context.Context, req *http.Request) (future OutboundEndpointsCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,74 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type OutboundEndpointsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// OutboundEndpointsDelete ...
+func (c DnsResolversClient) OutboundEndpointsDelete(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId) (result OutboundEndpointsDeleteResponse, err error) {
+	req, err := c.preparerForOutboundEndpointsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "OutboundEndpointsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForOutboundEndpointsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "OutboundEndpointsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// OutboundEndpointsDeleteThenPoll performs OutboundEndpointsDelete then polls until it's completed
+func (c DnsResolversClient) OutboundEndpointsDeleteThenPoll(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId) error {
+	result, err := c.OutboundEndpointsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing OutboundEndpointsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after OutboundEndpointsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForOutboundEndpointsDelete prepares the OutboundEndpointsDelete request.
+func (c DnsResolversClient) preparerForOutboundEndpointsDelete(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForOutboundEndpointsDelete sends the OutboundEndpointsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForOutboundEndpointsDelete(ctx context.Context, req *http.Request) (future OutboundEndpointsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package dnsresolvers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type OutboundEndpointsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *OutboundEndpoint
+}
+
+// OutboundEndpointsGet ...
+func (c DnsResolversClient) OutboundEndpointsGet(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId) (result OutboundEndpointsGetResponse, err error) {
+	req, err := c.preparerForOutboundEndpointsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "OutboundEndpointsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "OutboundEndpointsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForOutboundEndpointsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "OutboundEndpointsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForOutboundEndpointsGet prepares the OutboundEndpointsGet request.
+func (c DnsResolversClient) preparerForOutboundEndpointsGet(ctx context.Context, id parse.PrivateDnsResolverOutboundEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForOutboundEndpointsGet handles the response to the OutboundEndpointsGet request. The method always
+// closes the http.Response Body.
+func (c DnsResolversClient) responderForOutboundEndpointsGet(resp *http.Response) (result OutboundEndpointsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
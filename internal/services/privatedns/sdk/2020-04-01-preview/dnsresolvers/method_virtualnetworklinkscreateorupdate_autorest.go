@@ -0,0 +1,76 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type VirtualNetworkLinksCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// VirtualNetworkLinksCreateOrUpdate ...
+func (c DnsResolversClient) VirtualNetworkLinksCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId, input VirtualNetworkLink) (result VirtualNetworkLinksCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForVirtualNetworkLinksCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "VirtualNetworkLinksCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForVirtualNetworkLinksCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "VirtualNetworkLinksCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// VirtualNetworkLinksCreateOrUpdateThenPoll performs VirtualNetworkLinksCreateOrUpdate then polls until it's completed
+func (c DnsResolversClient) VirtualNetworkLinksCreateOrUpdateThenPoll(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId, input VirtualNetworkLink) error {
+	result, err := c.VirtualNetworkLinksCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing VirtualNetworkLinksCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after VirtualNetworkLinksCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForVirtualNetworkLinksCreateOrUpdate prepares the VirtualNetworkLinksCreateOrUpdate request.
+func (c DnsResolversClient) preparerForVirtualNetworkLinksCreateOrUpdate(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId, input VirtualNetworkLink) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForVirtualNetworkLinksCreateOrUpdate sends the VirtualNetworkLinksCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForVirtualNetworkLinksCreateOrUpdate(ctx context.Context, req *http.Request) (future VirtualNetworkLinksCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,74 @@
+package dnsresolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type VirtualNetworkLinksDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// VirtualNetworkLinksDelete ...
+func (c DnsResolversClient) VirtualNetworkLinksDelete(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId) (result VirtualNetworkLinksDeleteResponse, err error) {
+	req, err := c.preparerForVirtualNetworkLinksDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "VirtualNetworkLinksDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForVirtualNetworkLinksDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "VirtualNetworkLinksDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// VirtualNetworkLinksDeleteThenPoll performs VirtualNetworkLinksDelete then polls until it's completed
+func (c DnsResolversClient) VirtualNetworkLinksDeleteThenPoll(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId) error {
+	result, err := c.VirtualNetworkLinksDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing VirtualNetworkLinksDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after VirtualNetworkLinksDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForVirtualNetworkLinksDelete prepares the VirtualNetworkLinksDelete request.
+func (c DnsResolversClient) preparerForVirtualNetworkLinksDelete(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForVirtualNetworkLinksDelete sends the VirtualNetworkLinksDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c DnsResolversClient) senderForVirtualNetworkLinksDelete(ctx context.Context, req *http.Request) (future VirtualNetworkLinksDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
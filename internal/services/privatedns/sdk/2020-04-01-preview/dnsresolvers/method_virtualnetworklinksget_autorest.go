@@ -0,0 +1,65 @@
+package dnsresolvers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+)
+
+type VirtualNetworkLinksGetResponse struct {
+	HttpResponse *http.Response
+	Model        *VirtualNetworkLink
+}
+
+// VirtualNetworkLinksGet ...
+func (c DnsResolversClient) VirtualNetworkLinksGet(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId) (result VirtualNetworkLinksGetResponse, err error) {
+	req, err := c.preparerForVirtualNetworkLinksGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "VirtualNetworkLinksGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "VirtualNetworkLinksGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForVirtualNetworkLinksGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnsresolvers.DnsResolversClient", "VirtualNetworkLinksGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForVirtualNetworkLinksGet prepares the VirtualNetworkLinksGet request.
+func (c DnsResolversClient) preparerForVirtualNetworkLinksGet(ctx context.Context, id parse.PrivateDnsResolverVirtualNetworkLinkId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForVirtualNetworkLinksGet handles the response to the VirtualNetworkLinksGet request. The method always
+// closes the http.Response Body.
+func (c DnsResolversClient) responderForVirtualNetworkLinksGet(resp *http.Response) (result VirtualNetworkLinksGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
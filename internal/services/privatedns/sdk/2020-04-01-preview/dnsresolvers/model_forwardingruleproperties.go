@@ -0,0 +1,9 @@
+package dnsresolvers
+
+type ForwardingRuleProperties struct {
+	DomainName          string               `json:"domainName"`
+	ForwardingRuleState *ForwardingRuleState `json:"forwardingRuleState,omitempty"`
+	Metadata            *map[string]string   `json:"metadata,omitempty"`
+	ProvisioningState   *ProvisioningState   `json:"provisioningState,omitempty"`
+	TargetDnsServers    []TargetDnsServer    `json:"targetDnsServers"`
+}
@@ -0,0 +1,7 @@
+package dnsresolvers
+
+type InboundEndpointProperties struct {
+	IPConfigurations  *[]IPConfiguration `json:"ipConfigurations,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+}
@@ -0,0 +1,7 @@
+package dnsresolvers
+
+type IPConfiguration struct {
+	PrivateIPAddress          *string             `json:"privateIpAddress,omitempty"`
+	PrivateIPAllocationMethod *IPAllocationMethod `json:"privateIpAllocationMethod,omitempty"`
+	Subnet                    *SubResource        `json:"subnet,omitempty"`
+}
@@ -0,0 +1,5 @@
+package dnsresolvers
+
+type SubResource struct {
+	Id string `json:"id"`
+}
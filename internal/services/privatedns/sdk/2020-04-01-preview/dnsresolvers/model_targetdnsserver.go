@@ -0,0 +1,6 @@
+package dnsresolvers
+
+type TargetDnsServer struct {
+	IPAddress *string `json:"ipAddress,omitempty"`
+	Port      *int64  `json:"port,omitempty"`
+}
@@ -423,11 +423,11 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		release, err := locks.Acquire(ctx, locks.NameKey(parsed.VirtualNetworkName, network.VirtualNetworkResourceName), locks.NameKey(parsed.Name, network.SubnetResourceName))
+		if err != nil {
+			return fmt.Errorf("acquiring locks: %+v", err)
+		}
+		defer release()
 
 		parameters.SubnetID = utils.String(v.(string))
 	}
@@ -705,11 +705,11 @@ func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		release, err := locks.Acquire(ctx, locks.NameKey(parsed.VirtualNetworkName, network.VirtualNetworkResourceName), locks.NameKey(parsed.Name, network.SubnetResourceName))
+		if err != nil {
+			return fmt.Errorf("acquiring locks: %+v", err)
+		}
+		defer release()
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RediName)
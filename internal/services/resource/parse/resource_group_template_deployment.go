@@ -37,6 +37,11 @@ func (id ResourceGroupTemplateDeploymentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DeploymentName)
 }
 
+// ResourceGroupID returns the ID of the ResourceGroup which this ResourceGroupTemplateDeployment is nested within
+func (id ResourceGroupTemplateDeploymentId) ResourceGroupID() ResourceGroupId {
+	return NewResourceGroupID(id.SubscriptionId, id.ResourceGroup)
+}
+
 // ResourceGroupTemplateDeploymentID parses a ResourceGroupTemplateDeployment ID into an ResourceGroupTemplateDeploymentId struct
 func ResourceGroupTemplateDeploymentID(input string) (*ResourceGroupTemplateDeploymentId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -18,6 +18,14 @@ func TestResourceGroupTemplateDeploymentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupTemplateDeploymentIDParent(t *testing.T) {
+	actual := NewResourceGroupTemplateDeploymentID("12345678-1234-9876-4563-123456789012", "group1", "deploy1").ResourceGroupID().ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
 func TestResourceGroupTemplateDeploymentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -40,6 +40,11 @@ func (id TemplateSpecVersionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
 }
 
+// ResourceGroupID returns the ID of the ResourceGroup which this TemplateSpecVersion is nested within
+func (id TemplateSpecVersionId) ResourceGroupID() ResourceGroupId {
+	return NewResourceGroupID(id.SubscriptionId, id.ResourceGroup)
+}
+
 // TemplateSpecVersionID parses a TemplateSpecVersion ID into an TemplateSpecVersionId struct
 func TemplateSpecVersionID(input string) (*TemplateSpecVersionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
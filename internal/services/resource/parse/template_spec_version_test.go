@@ -18,6 +18,14 @@ func TestTemplateSpecVersionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTemplateSpecVersionIDParent(t *testing.T) {
+	actual := NewTemplateSpecVersionID("12345678-1234-9876-4563-123456789012", "templateSpecRG", "templateSpec1", "v1.0").ResourceGroupID().ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/templateSpecRG"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
 func TestTemplateSpecVersionID(t *testing.T) {
 	testData := []struct {
 		Input    string
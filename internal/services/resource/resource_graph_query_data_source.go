@@ -0,0 +1,271 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	managementGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2021-03-01/resourcegraph"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceGraphQueryPageSize is the maximum number of rows which can be returned by Resource Graph in a single page
+const resourceGraphQueryPageSize = 1000
+
+type ResourceGraphQueryDataSource struct{}
+
+var _ sdk.DataSource = ResourceGraphQueryDataSource{}
+
+type ResourceGraphQueryDataSourceModel struct {
+	Query              string                  `tfschema:"query"`
+	SubscriptionIds    []string                `tfschema:"subscription_ids"`
+	ManagementGroupIds []string                `tfschema:"management_group_ids"`
+	Results            []ResourceGraphQueryRow `tfschema:"results"`
+	TotalRecords       int64                   `tfschema:"total_records"`
+}
+
+type ResourceGraphQueryRow struct {
+	Id                string            `tfschema:"id"`
+	Name              string            `tfschema:"name"`
+	Type              string            `tfschema:"type"`
+	Location          string            `tfschema:"location"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	SubscriptionId    string            `tfschema:"subscription_id"`
+	Tags              map[string]string `tfschema:"tags"`
+	Properties        string            `tfschema:"properties"`
+}
+
+func (r ResourceGraphQueryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"subscription_ids": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"management_group_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"management_group_ids": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"subscription_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: managementGroupValidate.ManagementGroupID,
+			},
+		},
+	}
+}
+
+func (r ResourceGraphQueryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"results": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"location": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"resource_group_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"subscription_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tags": tags.SchemaDataSource(),
+
+					"properties": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"total_records": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r ResourceGraphQueryDataSource) ModelObject() interface{} {
+	return &ResourceGraphQueryDataSourceModel{}
+}
+
+func (r ResourceGraphQueryDataSource) ResourceType() string {
+	return "azurerm_resource_graph_query"
+}
+
+func (r ResourceGraphQueryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceGraphClient
+
+			var model ResourceGraphQueryDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			request := resourcegraph.QueryRequest{
+				Query: model.Query,
+				Options: &resourcegraph.QueryRequestOptions{
+					ResultFormat: resourcegraphResultFormat(resourcegraph.ResultFormatObjectArray),
+					Top:          utils.Int64(resourceGraphQueryPageSize),
+				},
+			}
+
+			if len(model.ManagementGroupIds) > 0 {
+				managementGroups := make([]string, 0)
+				for _, v := range model.ManagementGroupIds {
+					id, err := managementGroupParse.ManagementGroupID(v)
+					if err != nil {
+						return err
+					}
+					managementGroups = append(managementGroups, id.Name)
+				}
+				request.ManagementGroups = &managementGroups
+			} else {
+				subscriptions := model.SubscriptionIds
+				if len(subscriptions) == 0 {
+					// default to the Subscription which the Provider is configured for
+					subscriptions = []string{metadata.Client.Account.SubscriptionId}
+				}
+				request.Subscriptions = &subscriptions
+			}
+
+			rows, totalRecords, err := r.query(ctx, client, request)
+			if err != nil {
+				return fmt.Errorf("running Resource Graph Query: %+v", err)
+			}
+
+			results, err := flattenResourceGraphQueryRows(rows)
+			if err != nil {
+				return fmt.Errorf("flattening the results of the Resource Graph Query: %+v", err)
+			}
+			model.Results = results
+			model.TotalRecords = totalRecords
+
+			metadata.ResourceData.SetId("resourceGraphQuery-" + uuid.New().String())
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+// query runs the query, retrieving each page of results using the Skip Token returned from Resource Graph
+func (r ResourceGraphQueryDataSource) query(ctx context.Context, client *resourcegraph.ResourceGraphClient, request resourcegraph.QueryRequest) ([]map[string]interface{}, int64, error) {
+	rows := make([]map[string]interface{}, 0)
+	var totalRecords int64
+
+	for {
+		resp, err := client.Resources(ctx, request)
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.Model == nil {
+			return nil, 0, fmt.Errorf("model was nil")
+		}
+
+		rows = append(rows, resp.Model.Data...)
+		totalRecords = resp.Model.TotalRecords
+
+		if resp.Model.SkipToken == nil || *resp.Model.SkipToken == "" {
+			// Resource Graph only returns a Skip Token when the `id` column is projected, otherwise the results are
+			// truncated - which we surface rather than returning a partial set of results
+			if resp.Model.ResultTruncated == resourcegraph.ResultTruncatedTrue {
+				return nil, 0, fmt.Errorf("the results were truncated (retrieved %d of %d records) - the `id` column must be projected by the query to retrieve all of the records", len(rows), totalRecords)
+			}
+			break
+		}
+
+		request.Options.SkipToken = resp.Model.SkipToken
+	}
+
+	return rows, totalRecords, nil
+}
+
+func flattenResourceGraphQueryRows(input []map[string]interface{}) ([]ResourceGraphQueryRow, error) {
+	output := make([]ResourceGraphQueryRow, 0)
+
+	for _, row := range input {
+		result := ResourceGraphQueryRow{
+			Id:                resourceGraphQueryString(row, "id"),
+			Name:              resourceGraphQueryString(row, "name"),
+			Type:              resourceGraphQueryString(row, "type"),
+			Location:          resourceGraphQueryString(row, "location"),
+			ResourceGroupName: resourceGraphQueryString(row, "resourceGroup"),
+			SubscriptionId:    resourceGraphQueryString(row, "subscriptionId"),
+			Tags:              make(map[string]string),
+		}
+
+		if v, ok := row["tags"].(map[string]interface{}); ok {
+			for key, value := range v {
+				result.Tags[key] = fmt.Sprintf("%v", value)
+			}
+		}
+
+		if v, ok := row["properties"]; ok && v != nil {
+			properties, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("serializing `properties` for %q: %+v", result.Id, err)
+			}
+			result.Properties = string(properties)
+		}
+
+		output = append(output, result)
+	}
+
+	return output, nil
+}
+
+// resourceGraphQueryString returns the value of the specified column as a string, since the columns returned
+// depend on the query (for example when using `project`) this is an empty string when the column doesn't exist
+func resourceGraphQueryString(row map[string]interface{}, column string) string {
+	if v, ok := row[column].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func resourcegraphResultFormat(input resourcegraph.ResultFormat) *resourcegraph.ResultFormat {
+	return &input
+}
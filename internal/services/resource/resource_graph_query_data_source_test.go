@@ -0,0 +1,96 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGraphQueryDataSource struct{}
+
+func TestAccDataSourceResourceGraphQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// Resource Graph is eventually consistent, so the Resource Group needs to exist before it's queried
+			Config: r.template(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("results.0.name").HasValue(fmt.Sprintf("acctestRG-rgq-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("results.0.type").HasValue("microsoft.resources/subscriptions/resourcegroups"),
+				check.That(data.ResourceName).Key("results.0.tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("results.0.tags.environment").HasValue("test"),
+				check.That(data.ResourceName).Key("results.0.properties").Exists(),
+				check.That(data.ResourceName).Key("total_records").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_projection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// Resource Graph is eventually consistent, so the Resource Group needs to exist before it's queried
+			Config: r.template(data),
+		},
+		{
+			Config: r.projection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("results.0.id").Exists(),
+				check.That(data.ResourceName).Key("results.0.name").HasValue(fmt.Sprintf("acctestRG-rgq-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("results.0.properties").HasValue(""),
+			),
+		},
+	})
+}
+
+func (ResourceGraphQueryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rgq-%d"
+  location = "%s"
+
+  tags = {
+    environment = "test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGraphQueryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "ResourceContainers | where type =~ 'microsoft.resources/subscriptions/resourcegroups' and name == '${azurerm_resource_group.test.name}'"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, r.template(data))
+}
+
+func (r ResourceGraphQueryDataSource) projection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query = "ResourceContainers | where type =~ 'microsoft.resources/subscriptions/resourcegroups' and name == '${azurerm_resource_group.test.name}' | project id, name"
+}
+`, r.template(data))
+}
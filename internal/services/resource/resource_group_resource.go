@@ -40,7 +40,7 @@ func resourceResourceGroup() *pluginsdk.Resource {
 		Schema: map[string]*pluginsdk.Schema{
 			"name": azure.SchemaResourceGroupName(),
 
-			"location": azure.SchemaLocation(),
+			"location": location.SchemaForResourceType("Microsoft.Resources/resourceGroups"),
 
 			"tags": tags.Schema(),
 		},
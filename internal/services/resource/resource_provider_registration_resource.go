@@ -9,7 +9,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2015-12-01/features"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
@@ -111,7 +110,7 @@ func (r ResourceProviderRegistrationResource) resourceCreateUpdate(ctx context.C
 	}
 
 	resourceId := parse.NewResourceProviderID(account.SubscriptionId, obj.Name)
-	if err := r.checkIfManagedByTerraform(resourceId.ResourceProvider, account); err != nil {
+	if err := r.checkIfManagedByTerraform(resourceId.ResourceProvider, metadata.Client.ResourceProviders); err != nil {
 		return err
 	}
 
@@ -173,14 +172,13 @@ func (r ResourceProviderRegistrationResource) Read() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Resource.ProvidersClient
 			featureClient := metadata.Client.Resource.FeaturesClient
-			account := metadata.Client.Account
 
 			id, err := parse.ResourceProviderID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			if err := r.checkIfManagedByTerraform(id.ResourceProvider, account); err != nil {
+			if err := r.checkIfManagedByTerraform(id.ResourceProvider, metadata.Client.ResourceProviders); err != nil {
 				return err
 			}
 
@@ -232,14 +230,13 @@ func (r ResourceProviderRegistrationResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Resource.ProvidersClient
-			account := metadata.Client.Account
 
 			id, err := parse.ResourceProviderID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			if err := r.checkIfManagedByTerraform(id.ResourceProvider, account); err != nil {
+			if err := r.checkIfManagedByTerraform(id.ResourceProvider, metadata.Client.ResourceProviders); err != nil {
 				return err
 			}
 
@@ -278,7 +275,6 @@ func (r ResourceProviderRegistrationResource) IDValidationFunc() pluginsdk.Schem
 func (r ResourceProviderRegistrationResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		client := metadata.Client.Resource.ProvidersClient
-		account := metadata.Client.Account
 
 		id, err := parse.ResourceProviderID(metadata.ResourceData.Id())
 		if err != nil {
@@ -302,7 +298,7 @@ func (r ResourceProviderRegistrationResource) CustomImporter() sdk.ResourceRunFu
 			return fmt.Errorf("importing Resource Provider %q: Resource Provider must be registered to be imported", id.ResourceProvider)
 		}
 
-		if err := r.checkIfManagedByTerraform(id.ResourceProvider, account); err != nil {
+		if err := r.checkIfManagedByTerraform(id.ResourceProvider, metadata.Client.ResourceProviders); err != nil {
 			return fmt.Errorf("importing Resource Provider %q: %+v", id.ResourceProvider, err)
 		}
 
@@ -310,20 +306,15 @@ func (r ResourceProviderRegistrationResource) CustomImporter() sdk.ResourceRunFu
 	}
 }
 
-func (r ResourceProviderRegistrationResource) checkIfManagedByTerraform(name string, account *clients.ResourceManagerAccount) error {
-	if account.SkipResourceProviderRegistration {
-		return nil
-	}
-
-	for resourceProvider := range resourceproviders.Required() {
-		if resourceProvider == name {
-			fmtStr := `The Resource Provider %q is automatically registered by Terraform.
+func (r ResourceProviderRegistrationResource) checkIfManagedByTerraform(name string, registrar *resourceproviders.Registrar) error {
+	if registrar.IsManagedByTerraform(name) {
+		fmtStr := `The Resource Provider %q is automatically registered by Terraform.
 
 To manage this Resource Provider Registration with Terraform you need to opt-out
-of Automatic Resource Provider Registration (by setting 'skip_provider_registration'
-to 'true' in the Provider block) to avoid conflicting with Terraform.`
-			return fmt.Errorf(fmtStr, name)
-		}
+of Automatic Resource Provider Registration for this Resource Provider (either by
+setting 'resource_provider_registrations' to a value which doesn't include it, or
+to 'none' in the Provider block) to avoid conflicting with Terraform.`
+		return fmt.Errorf(fmtStr, name)
 	}
 
 	return nil
@@ -0,0 +1,15 @@
+package resourcegraph
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ResourceGraphClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewResourceGraphClientWithBaseURI(endpoint string) ResourceGraphClient {
+	return ResourceGraphClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,63 @@
+package resourcegraph
+
+import "strings"
+
+type ResultFormat string
+
+const (
+	ResultFormatObjectArray ResultFormat = "objectArray"
+	ResultFormatTable       ResultFormat = "table"
+)
+
+func PossibleValuesForResultFormat() []string {
+	return []string{
+		"objectArray",
+		"table",
+	}
+}
+
+func parseResultFormat(input string) (*ResultFormat, error) {
+	vals := map[string]ResultFormat{
+		"objectarray": "objectArray",
+		"table":       "table",
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// it could be a new value - best effort convert this
+	v := input
+
+	out := ResultFormat(v)
+	return &out, nil
+}
+
+type ResultTruncated string
+
+const (
+	ResultTruncatedFalse ResultTruncated = "false"
+	ResultTruncatedTrue  ResultTruncated = "true"
+)
+
+func PossibleValuesForResultTruncated() []string {
+	return []string{
+		"false",
+		"true",
+	}
+}
+
+func parseResultTruncated(input string) (*ResultTruncated, error) {
+	vals := map[string]ResultTruncated{
+		"false": "false",
+		"true":  "true",
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// it could be a new value - best effort convert this
+	v := input
+
+	out := ResultTruncated(v)
+	return &out, nil
+}
@@ -0,0 +1,65 @@
+package resourcegraph
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ResourcesResponse struct {
+	HttpResponse *http.Response
+	Model        *QueryResponse
+}
+
+// Resources ...
+func (c ResourceGraphClient) Resources(ctx context.Context, input QueryRequest) (result ResourcesResponse, err error) {
+	req, err := c.preparerForResources(ctx, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resourcegraph.ResourceGraphClient", "Resources", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resourcegraph.ResourceGraphClient", "Resources", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForResources(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "resourcegraph.ResourceGraphClient", "Resources", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForResources prepares the Resources request.
+func (c ResourceGraphClient) preparerForResources(ctx context.Context, input QueryRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath("/providers/Microsoft.ResourceGraph/resources"),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForResources handles the response to the Resources request. The method always
+// closes the http.Response Body.
+func (c ResourceGraphClient) responderForResources(resp *http.Response) (result ResourcesResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,8 @@
+package resourcegraph
+
+type QueryRequest struct {
+	ManagementGroups *[]string            `json:"managementGroups,omitempty"`
+	Options          *QueryRequestOptions `json:"options,omitempty"`
+	Query            string               `json:"query"`
+	Subscriptions    *[]string            `json:"subscriptions,omitempty"`
+}
@@ -0,0 +1,9 @@
+package resourcegraph
+
+type QueryRequestOptions struct {
+	AllowPartialScopes *bool         `json:"allowPartialScopes,omitempty"`
+	ResultFormat       *ResultFormat `json:"resultFormat,omitempty"`
+	Skip               *int64        `json:"$skip,omitempty"`
+	SkipToken          *string       `json:"$skipToken,omitempty"`
+	Top                *int64        `json:"$top,omitempty"`
+}
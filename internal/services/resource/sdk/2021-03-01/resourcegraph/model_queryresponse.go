@@ -0,0 +1,9 @@
+package resourcegraph
+
+type QueryResponse struct {
+	Count           int64                    `json:"count"`
+	Data            []map[string]interface{} `json:"data"`
+	ResultTruncated ResultTruncated          `json:"resultTruncated"`
+	SkipToken       *string                  `json:"$skipToken,omitempty"`
+	TotalRecords    int64                    `json:"totalRecords"`
+}
@@ -148,8 +148,11 @@ func resourceSignalRServiceNetworkACLCreateUpdate(d *pluginsdk.ResourceData, met
 		return err
 	}
 
-	locks.ByName(id.ResourceName, "azurerm_signalr_service")
-	defer locks.UnlockByName(id.ResourceName, "azurerm_signalr_service")
+	release, err := locks.Acquire(ctx, locks.NameKey(id.ResourceName, "azurerm_signalr_service"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	resp, err := client.Get(ctx, *id)
 	if err != nil {
@@ -254,8 +257,11 @@ func resourceSignalRServiceNetworkACLDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	locks.ByName(id.ResourceName, "azurerm_signalr_service")
-	defer locks.UnlockByName(id.ResourceName, "azurerm_signalr_service")
+	release, err := locks.Acquire(ctx, locks.NameKey(id.ResourceName, "azurerm_signalr_service"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	resp, err := client.Get(ctx, *id)
 	if err != nil {
@@ -159,8 +159,11 @@ func resourceWebPubsubNetworkACLCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("checking for present of existing %q: %+v", id, err)
 	}
 
-	locks.ByName(id.WebPubSubName, "azurerm_web_pubsub")
-	defer locks.UnlockByName(id.WebPubSubName, "azurerm_web_pubsub")
+	release, err := locks.Acquire(ctx, locks.NameKey(id.WebPubSubName, "azurerm_web_pubsub"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	if d.IsNewResource() {
 		if !isNewNetworkACL(existing) {
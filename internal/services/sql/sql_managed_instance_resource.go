@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -25,7 +26,8 @@ import (
 )
 
 func resourceArmSqlMiServer() *schema.Resource {
-	return &schema.Resource{
+	// the Private State is used to resume the creation of the Managed Instance should the timeout be reached
+	return pluginsdk.EnablePrivateState(&schema.Resource{
 		Create: resourceArmSqlMiServerCreateUpdate,
 		Read:   resourceArmSqlMiServerRead,
 		Update: resourceArmSqlMiServerCreateUpdate,
@@ -195,7 +197,7 @@ func resourceArmSqlMiServer() *schema.Resource {
 				return old.(string) == "" && new.(string) != ""
 			}),
 		),
-	}
+	})
 }
 
 func resourceArmSqlMiServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -208,6 +210,13 @@ func resourceArmSqlMiServerCreateUpdate(d *schema.ResourceData, meta interface{}
 	resGroup := d.Get("resource_group_name").(string)
 	id := parse.NewManagedInstanceID(subscriptionId, resGroup, name)
 
+	if !d.IsNewResource() {
+		// the creation of the Managed Instance may still be in progress from a previous Terraform run
+		if err := sdk.ResumeLongRunningOperation(ctx, d, id, client.Client); err != nil {
+			return err
+		}
+	}
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 		if err != nil {
@@ -258,7 +267,7 @@ func resourceArmSqlMiServerCreateUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err = sdk.WaitForLongRunningOperation(ctx, d, id, future.FutureAPI, client.Client); err != nil {
 		if response.WasConflict(future.Response()) {
 			return fmt.Errorf("sql managed instance names need to be globally unique and %q is already in use", name)
 		}
@@ -281,6 +290,11 @@ func resourceArmSqlMiServerRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// the creation of the Managed Instance may still be in progress from a previous Terraform run
+	if err := sdk.ResumeLongRunningOperation(ctx, d, id, client.Client); err != nil {
+		return err
+	}
+
 	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
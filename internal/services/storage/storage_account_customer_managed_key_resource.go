@@ -86,8 +86,11 @@ func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceD
 		return err
 	}
 
-	locks.ByName(storageAccountID.Name, storageAccountResourceName)
-	defer locks.UnlockByName(storageAccountID.Name, storageAccountResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(storageAccountID.Name, storageAccountResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	storageAccount, err := storageClient.GetProperties(ctx, storageAccountID.ResourceGroup, storageAccountID.Name, "")
 	if err != nil {
@@ -265,8 +268,11 @@ func resourceStorageAccountCustomerManagedKeyDelete(d *pluginsdk.ResourceData, m
 		return err
 	}
 
-	locks.ByName(storageAccountID.Name, storageAccountResourceName)
-	defer locks.UnlockByName(storageAccountID.Name, storageAccountResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(storageAccountID.Name, storageAccountResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	// confirm it still exists prior to trying to update it, else we'll get an error
 	storageAccount, err := storageClient.GetProperties(ctx, storageAccountID.ResourceGroup, storageAccountID.Name, "")
@@ -180,8 +180,11 @@ func resourceStorageAccountNetworkRulesCreateUpdate(d *pluginsdk.ResourceData, m
 		resourceGroup = parsedStorageAccountId.ResourceGroup
 	}
 
-	locks.ByName(storageAccountName, storageAccountResourceName)
-	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(storageAccountName, storageAccountResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	storageAccount, err := client.GetProperties(ctx, resourceGroup, storageAccountName, "")
 	if err != nil {
@@ -282,8 +285,11 @@ func resourceStorageAccountNetworkRulesDelete(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(parsedStorageAccountNetworkRuleId.Name, storageAccountResourceName)
-	defer locks.UnlockByName(parsedStorageAccountNetworkRuleId.Name, storageAccountResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(parsedStorageAccountNetworkRuleId.Name, storageAccountResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	storageAccount, err := client.GetProperties(ctx, parsedStorageAccountNetworkRuleId.ResourceGroup, parsedStorageAccountNetworkRuleId.Name, "")
 	if err != nil {
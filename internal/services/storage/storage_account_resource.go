@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	vnetParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -92,7 +93,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
-			"location": azure.SchemaLocation(),
+			"location": location.SchemaForResourceType("Microsoft.Storage/storageAccounts"),
 
 			"account_kind": {
 				Type:     pluginsdk.TypeString,
@@ -966,8 +967,11 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	id := parse.NewStorageAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.Name, storageAccountResourceName)
-	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, storageAccountResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	existing, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -1258,8 +1262,11 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.ByName(id.Name, storageAccountResourceName)
-	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+	release, err := locks.Acquire(ctx, locks.NameKey(id.Name, storageAccountResourceName))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %+v", err)
+	}
+	defer release()
 
 	accountTier := d.Get("account_tier").(string)
 	replicationType := d.Get("account_replication_type").(string)
@@ -1928,9 +1935,6 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.ByName(id.Name, storageAccountResourceName)
-	defer locks.UnlockByName(id.Name, storageAccountResourceName)
-
 	read, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
@@ -1967,8 +1971,12 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	lockKeys := append(locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName), locks.NameKey(id.Name, storageAccountResourceName))
+	release, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return fmt.Errorf("acquiring locks: %+v", err)
+	}
+	defer release()
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
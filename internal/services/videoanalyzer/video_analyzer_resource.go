@@ -75,7 +75,7 @@ func resourceVideoAnalyzer() *pluginsdk.Resource {
 
 			"identity": commonschema.UserAssignedIdentityRequired(),
 
-			"tags": commonschema.Tags(),
+			"tags": tags.Schema(),
 		},
 	}
 }
@@ -110,7 +110,7 @@ func resourceVideoAnalyzerCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		},
 		Location: azure.NormalizeLocation(d.Get("location").(string)),
 		Identity: identity,
-		Tags:     expandTags(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.VideoAnalyzersCreateOrUpdate(ctx, id, parameters); err != nil {
@@ -165,7 +165,7 @@ func resourceVideoAnalyzerRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			return fmt.Errorf("setting `identity`: %s", err)
 		}
 
-		return tags.FlattenAndSet(d, flattenTags(model.Tags))
+		return tags.FlattenPointerAndSet(d, model.Tags)
 	}
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
@@ -29,7 +30,7 @@ const (
 )
 
 func resourceAppServiceEnvironment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.EnablePrivateState(&pluginsdk.Resource{
 		Create: resourceAppServiceEnvironmentCreate,
 		Read:   resourceAppServiceEnvironmentRead,
 		Update: resourceAppServiceEnvironmentUpdate,
@@ -170,7 +171,7 @@ func resourceAppServiceEnvironment() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	})
 }
 
 func resourceAppServiceEnvironmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -252,25 +253,14 @@ func resourceAppServiceEnvironmentCreate(d *pluginsdk.ResourceData, meta interfa
 		envelope.AppServiceEnvironment.ClusterSettings = expandAppServiceEnvironmentClusterSettings(clusterSettingsRaw)
 	}
 
-	// whilst this returns a future go-autorest has a max number of retries
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.HostingEnvironmentName, envelope); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	createWait := pluginsdk.StateChangeConf{
-		Pending: []string{
-			string(web.ProvisioningStateInProgress),
-		},
-		Target: []string{
-			string(web.ProvisioningStateSucceeded),
-		},
-		MinTimeout: 1 * time.Minute,
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
-		Refresh:    appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
-	}
-
-	// as such we'll ignore it and use a custom poller instead
-	if _, err := createWait.WaitForStateContext(ctx); err != nil {
+	if err := sdk.WaitForLongRunningOperationUsingPoller(ctx, d, id, appServiceEnvironmentPoller(client, id)); err != nil {
+		if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+			return err
+		}
 		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 	}
 
@@ -281,7 +271,7 @@ func resourceAppServiceEnvironmentCreate(d *pluginsdk.ResourceData, meta interfa
 
 func resourceAppServiceEnvironmentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.AppServiceEnvironmentsClient
-	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id, err := parse.AppServiceEnvironmentID(d.Id())
@@ -289,6 +279,11 @@ func resourceAppServiceEnvironmentUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	// the creation (or a previous update) of the App Service Environment may still be in progress from a previous Terraform run
+	if err := sdk.ResumeLongRunningOperationUsingPoller(ctx, d, id, appServiceEnvironmentPoller(client, *id)); err != nil {
+		return err
+	}
+
 	e := web.AppServiceEnvironmentPatchResource{
 		AppServiceEnvironment: &web.AppServiceEnvironment{},
 	}
@@ -325,19 +320,10 @@ func resourceAppServiceEnvironmentUpdate(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("updating App Service Environment %q (Resource Group %q): %+v", id.HostingEnvironmentName, id.ResourceGroup, err)
 	}
 
-	updateWait := pluginsdk.StateChangeConf{
-		Pending: []string{
-			string(web.ProvisioningStateInProgress),
-		},
-		Target: []string{
-			string(web.ProvisioningStateSucceeded),
-		},
-		MinTimeout: 1 * time.Minute,
-		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
-		Refresh:    appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
-	}
-
-	if _, err := updateWait.WaitForStateContext(ctx); err != nil {
+	if err := sdk.WaitForLongRunningOperationUsingPoller(ctx, d, id, appServiceEnvironmentPoller(client, *id)); err != nil {
+		if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+			return err
+		}
 		return fmt.Errorf("waiting for Update of App Service Environment %q (Resource Group %q): %+v", id.HostingEnvironmentName, id.ResourceGroup, err)
 	}
 
@@ -354,6 +340,11 @@ func resourceAppServiceEnvironmentRead(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
+	// the creation (or an update) of the App Service Environment may still be in progress from a previous Terraform run
+	if err := sdk.ResumeLongRunningOperationUsingPoller(ctx, d, id, appServiceEnvironmentPoller(client, *id)); err != nil {
+		return err
+	}
+
 	existing, err := client.Get(ctx, id.ResourceGroup, id.HostingEnvironmentName)
 	if err != nil {
 		if utils.ResponseWasNotFound(existing.Response) {
@@ -441,6 +432,33 @@ func resourceAppServiceEnvironmentDelete(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
+// appServiceEnvironmentPoller polls until the App Service Environment has been provisioned - whilst the API returns
+// a future go-autorest has a max number of retries, as such we use a custom poller instead
+func appServiceEnvironmentPoller(client *web.AppServiceEnvironmentsClient, id parse.AppServiceEnvironmentId) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return fmt.Errorf("context had no deadline")
+		}
+
+		wait := pluginsdk.StateChangeConf{
+			Pending: []string{
+				string(web.ProvisioningStateInProgress),
+			},
+			Target: []string{
+				string(web.ProvisioningStateSucceeded),
+			},
+			MinTimeout:     1 * time.Minute,
+			NotFoundChecks: 20,
+			Timeout:        time.Until(deadline),
+			Refresh:        appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
+		}
+
+		_, err := wait.WaitForStateContext(ctx)
+		return err
+	}
+}
+
 func appServiceEnvironmentRefresh(ctx context.Context, client *web.AppServiceEnvironmentsClient, resourceGroup string, name string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		read, err := client.Get(ctx, resourceGroup, name)
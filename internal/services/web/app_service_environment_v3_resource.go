@@ -293,22 +293,10 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
-			createWait := pluginsdk.StateChangeConf{
-				Pending: []string{
-					string(web.ProvisioningStateInProgress),
-				},
-				Target: []string{
-					string(web.ProvisioningStateSucceeded),
-				},
-				MinTimeout:     1 * time.Minute,
-				NotFoundChecks: 20,
-				Refresh:        appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
-			}
-
-			timeout, _ := ctx.Deadline()
-			createWait.Timeout = time.Until(timeout)
-
-			if _, err := createWait.WaitForStateContext(ctx); err != nil {
+			if err := sdk.WaitForLongRunningOperationUsingPoller(ctx, metadata.ResourceData, id, appServiceEnvironmentPoller(client, id)); err != nil {
+				if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+					return err
+				}
 				return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 			}
 
@@ -338,6 +326,11 @@ func (r AppServiceEnvironmentV3Resource) Read() sdk.ResourceFunc {
 				return err
 			}
 
+			// the creation (or an update) of the App Service Environment may still be in progress from a previous Terraform run
+			if err := sdk.ResumeLongRunningOperationUsingPoller(ctx, metadata.ResourceData, id, appServiceEnvironmentPoller(client, *id)); err != nil {
+				return err
+			}
+
 			existing, err := client.Get(ctx, id.ResourceGroup, id.HostingEnvironmentName)
 			if err != nil {
 				if utils.ResponseWasNotFound(existing.Response) {
@@ -433,6 +426,11 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				return err
 			}
 
+			// the creation (or a previous update) of the App Service Environment may still be in progress from a previous Terraform run
+			if err := sdk.ResumeLongRunningOperationUsingPoller(ctx, metadata.ResourceData, id, appServiceEnvironmentPoller(client, *id)); err != nil {
+				return err
+			}
+
 			metadata.Logger.Info("Decoding state...")
 			var state AppServiceEnvironmentV3Model
 			if err := metadata.Decode(&state); err != nil {
@@ -467,6 +465,13 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
+			if err := sdk.WaitForLongRunningOperationUsingPoller(ctx, metadata.ResourceData, id, appServiceEnvironmentPoller(client, *id)); err != nil {
+				if _, ok := err.(pluginsdk.OperationInProgressError); ok {
+					return err
+				}
+				return fmt.Errorf("waiting for the update of %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
@@ -0,0 +1,138 @@
+package tags
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// defaultTags are the Tags specified in the `default_tags` block within the Provider, which are
+// merged into the Tags for each Resource - Tags specified on the Resource take precedence.
+//
+// These are merged into the planned value for the Tags (see CustomizeDiffForDefaultTags) so that changes
+// to these are shown in the plan, in addition to when the Tags are expanded.
+//
+// NOTE: since Expand/Flatten are called without access to the Provider Meta, these are configured
+// at the Provider level - as such when multiple (aliased) Provider blocks are used, these must match
+var (
+	defaultTags     map[string]string
+	defaultTagsLock sync.RWMutex
+)
+
+// SetDefaultTags configures the Tags which should be merged into the Tags for each Resource
+func SetDefaultTags(input map[string]string) {
+	defaultTagsLock.Lock()
+	defer defaultTagsLock.Unlock()
+
+	defaultTags = input
+}
+
+// DefaultTags returns a copy of the Tags which are merged into the Tags for each Resource
+func DefaultTags() map[string]string {
+	defaultTagsLock.RLock()
+	defer defaultTagsLock.RUnlock()
+
+	output := make(map[string]string, len(defaultTags))
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+	return output
+}
+
+// mergeDefaultTags merges the Default Tags into the Tags for this Resource, where the keys
+// defined on the Resource take precedence - since Tag keys are case-insensitive in Azure
+// a Default Tag is only added when the Resource doesn't contain a key with the same name
+func mergeDefaultTags(input map[string]*string) map[string]*string {
+	for k, v := range DefaultTags() {
+		if findKey(input, k) != "" {
+			continue
+		}
+
+		value := v
+		input[k] = &value
+	}
+
+	return input
+}
+
+func findKey(input map[string]*string, key string) string {
+	for k := range input {
+		if strings.EqualFold(k, key) {
+			return k
+		}
+	}
+	return ""
+}
+
+// CustomizeDiffForDefaultTags returns a CustomizeDiffFunc which merges the Default Tags into the planned
+// value for the specified (top-level, Optional and Computed) Tags field, so that changes to the Default Tags
+// are shown in the plan - Tags ignored in the Provider block are removed from the planned value.
+//
+// Where changing the Tags requires the Resource to be recreated, the Default Tags are only merged in when
+// the Resource is created, so that changing the Default Tags doesn't recreate these Resources.
+func CustomizeDiffForDefaultTags(field string, forceNew bool) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+		configured, known := configuredTags(d, field)
+		if !known {
+			// the Tags are interpolated from values which aren't known yet, so these are merged during apply
+			return nil
+		}
+
+		var existing map[string]*string
+		if forceNew && d.Id() != "" {
+			old, _ := d.GetChange(field)
+			existing = expand(old.(map[string]interface{}))
+		}
+
+		return d.SetNew(field, Flatten(plannedTags(configured, existing)))
+	}
+}
+
+// configuredTags returns the Tags defined in the Configuration for this Resource, since the value returned
+// from the ResourceDiff falls back to the State for a Computed field - and whether these are known
+func configuredTags(d *pluginsdk.ResourceDiff, field string) (map[string]*string, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil, false
+	}
+
+	raw := config.GetAttr(field)
+	if !raw.IsWhollyKnown() {
+		return nil, false
+	}
+
+	output := make(map[string]*string)
+	if raw.IsNull() {
+		return output, true
+	}
+	for k, v := range raw.AsValueMap() {
+		value := ""
+		if !v.IsNull() {
+			value = v.AsString()
+		}
+		output[k] = &value
+	}
+	return output, true
+}
+
+// plannedTags returns the Tags which will be sent to Azure for the Tags defined on the Resource. When the
+// existing Tags are specified (rather than nil) only the Default Tags already assigned are included.
+func plannedTags(configured map[string]*string, existing map[string]*string) map[string]*string {
+	if existing == nil {
+		return mergeDefaultTags(configured)
+	}
+
+	for k := range DefaultTags() {
+		if findKey(configured, k) != "" {
+			continue
+		}
+
+		if key := findKey(existing, k); key != "" {
+			configured[key] = existing[key]
+		}
+	}
+
+	return configured
+}
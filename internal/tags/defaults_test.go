@@ -0,0 +1,142 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandWithDefaultTags(t *testing.T) {
+	SetDefaultTags(map[string]string{
+		"cost-center": "1234",
+		"Owner":       "platform",
+	})
+	defer SetDefaultTags(nil)
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:  "No Resource Tags",
+			Input: map[string]interface{}{},
+			Expected: map[string]string{
+				"cost-center": "1234",
+				"Owner":       "platform",
+			},
+		},
+		{
+			Name: "Additional Resource Tags",
+			Input: map[string]interface{}{
+				"env": "prod",
+			},
+			Expected: map[string]string{
+				"cost-center": "1234",
+				"env":         "prod",
+				"Owner":       "platform",
+			},
+		},
+		{
+			Name: "Resource Tags take precedence",
+			Input: map[string]interface{}{
+				"owner": "networking",
+			},
+			Expected: map[string]string{
+				"cost-center": "1234",
+				"owner":       "networking",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := ToTypedObject(Expand(v.Input))
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d tags but got %d: %+v", len(v.Expected), len(actual), actual)
+		}
+		for k, expected := range v.Expected {
+			if actual[k] != expected {
+				t.Fatalf("Expected %q to be %q but got %q", k, expected, actual[k])
+			}
+		}
+	}
+}
+
+func TestExpandWithoutDefaultTags(t *testing.T) {
+	SetDefaultTags(map[string]string{
+		"cost-center": "1234",
+	})
+	defer SetDefaultTags(nil)
+
+	actual := ExpandWithoutDefaultTags(map[string]interface{}{
+		"env": "prod",
+	})
+	if len(actual) != 1 || *actual["env"] != "prod" {
+		t.Fatalf("Expected only the Resource Tags but got %+v", ToTypedObject(actual))
+	}
+}
+
+func TestPlannedTags(t *testing.T) {
+	SetDefaultTags(map[string]string{
+		"cost-center": "1234",
+		"Owner":       "platform",
+	})
+	defer SetDefaultTags(nil)
+
+	testData := []struct {
+		Name       string
+		Configured map[string]interface{}
+		Existing   map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Name:       "No Resource Tags",
+			Configured: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"Owner":       "platform",
+			},
+		},
+		{
+			Name: "Resource Tags take precedence",
+			Configured: map[string]interface{}{
+				"env":   "prod",
+				"owner": "networking",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"env":         "prod",
+				"owner":       "networking",
+			},
+		},
+		{
+			Name: "Existing Resource only includes the existing Default Tags",
+			Configured: map[string]interface{}{
+				"env": "prod",
+			},
+			Existing: map[string]interface{}{
+				"Cost-Center": "5678",
+				"env":         "test",
+			},
+			Expected: map[string]interface{}{
+				"Cost-Center": "5678",
+				"env":         "prod",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		var existing map[string]*string
+		if v.Existing != nil {
+			existing = expand(v.Existing)
+		}
+
+		actual := Flatten(plannedTags(expand(v.Configured), existing))
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
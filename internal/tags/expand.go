@@ -1,6 +1,22 @@
 package tags
 
+// Expand expands the Tags defined on the Resource into the Tags sent to Azure, which
+// includes any Default Tags configured in the Provider block.
+//
+// The Tags ignored in the Provider block are retained when the request is sent to Azure,
+// see RetainIgnoredTagsSender.
 func Expand(tagsMap map[string]interface{}) map[string]*string {
+	return mergeDefaultTags(expand(tagsMap))
+}
+
+// ExpandWithoutDefaultTags expands the Tags without including the Default Tags configured in the
+// Provider block, for example when the Tags are used to filter the results in a Data Source - or
+// for Tags defined within a block, since the Default Tags are only assigned to the Resource itself
+func ExpandWithoutDefaultTags(tagsMap map[string]interface{}) map[string]*string {
+	return expand(tagsMap)
+}
+
+func expand(tagsMap map[string]interface{}) map[string]*string {
 	output := make(map[string]*string, len(tagsMap))
 
 	for i, v := range tagsMap {
@@ -6,7 +6,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+// Flatten flattens the Tags returned from Azure into the Tags defined on the Resource, excluding
+// any Tags ignored in the Provider block (since these are managed outside of Terraform)
 func Flatten(tagMap map[string]*string) map[string]interface{} {
+	tagMap = Filter(tagMap, ignoredKeys(tagMap)...)
+
 	// If tagsMap is nil, len(tagsMap) will be 0.
 	output := make(map[string]interface{}, len(tagMap))
 
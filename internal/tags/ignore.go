@@ -0,0 +1,81 @@
+package tags
+
+import (
+	"strings"
+	"sync"
+)
+
+// ignoredTags are the Tags specified in the `ignore_tags` block within the Provider, which are
+// managed outside of Terraform (for example by Azure Policy) - these are removed from the Tags
+// when they're flattened, and the existing values retained when the Resource is updated (see
+// RetainIgnoredTagsSender)
+var (
+	ignoredTagKeys        []string
+	ignoredTagKeyPrefixes []string
+	ignoredTagsLock       sync.RWMutex
+)
+
+// SetIgnoredTags configures the Tag keys (and key prefixes) which are managed outside of Terraform
+func SetIgnoredTags(keys []string, keyPrefixes []string) {
+	ignoredTagsLock.Lock()
+	defer ignoredTagsLock.Unlock()
+
+	ignoredTagKeys = keys
+	ignoredTagKeyPrefixes = keyPrefixes
+}
+
+// hasIgnoredTags returns whether any Tags are managed outside of Terraform
+func hasIgnoredTags() bool {
+	ignoredTagsLock.RLock()
+	defer ignoredTagsLock.RUnlock()
+
+	return len(ignoredTagKeys) > 0 || len(ignoredTagKeyPrefixes) > 0
+}
+
+// IsIgnored returns whether the specified Tag key is managed outside of Terraform, either since it
+// matches one of the keys - or starts with one of the key prefixes (both of which are case-insensitive)
+func IsIgnored(key string) bool {
+	ignoredTagsLock.RLock()
+	defer ignoredTagsLock.RUnlock()
+
+	for _, v := range ignoredTagKeys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range ignoredTagKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ignoredKeys returns the keys within the specified Tags which are managed outside of Terraform
+func ignoredKeys(input map[string]*string) []string {
+	output := make([]string, 0)
+	for k := range input {
+		if IsIgnored(k) {
+			output = append(output, k)
+		}
+	}
+	return output
+}
+
+// retainIgnoredTags adds the Tags which are managed outside of Terraform from the existing Tags for this
+// Resource, since the Tags are replaced when the Resource is updated - Tags defined on the Resource take
+// precedence
+func retainIgnoredTags(input map[string]*string, existing map[string]*string) map[string]*string {
+	for k, v := range existing {
+		if v == nil || !IsIgnored(k) || findKey(input, k) != "" {
+			continue
+		}
+
+		value := *v
+		input[k] = &value
+	}
+
+	return input
+}
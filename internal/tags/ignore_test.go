@@ -0,0 +1,138 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestIsIgnored(t *testing.T) {
+	SetIgnoredTags([]string{"ms-resource-usage"}, []string{"hidden-link:"})
+	defer SetIgnoredTags(nil, nil)
+
+	testData := []struct {
+		Key      string
+		Expected bool
+	}{
+		{
+			Key:      "ms-resource-usage",
+			Expected: true,
+		},
+		{
+			Key:      "MS-Resource-Usage",
+			Expected: true,
+		},
+		{
+			Key:      "ms-resource-usage-2",
+			Expected: false,
+		},
+		{
+			Key:      "hidden-link:/app-insights-resource-id",
+			Expected: true,
+		},
+		{
+			Key:      "Hidden-Link:/app-insights-resource-id",
+			Expected: true,
+		},
+		{
+			Key:      "env",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Key)
+
+		if actual := IsIgnored(v.Key); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenIgnoredTags(t *testing.T) {
+	SetIgnoredTags([]string{"ms-resource-usage"}, []string{"hidden-link:"})
+	defer SetIgnoredTags(nil, nil)
+
+	input := map[string]*string{
+		"env":                                   utils.String("prod"),
+		"MS-Resource-Usage":                     utils.String("azure-cloud-shell"),
+		"hidden-link:/app-insights-resource-id": utils.String("/subscriptions/00000000-0000-0000-0000-000000000000"),
+	}
+	expected := map[string]interface{}{
+		"env": "prod",
+	}
+
+	if actual := Flatten(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+	if actual := ToTypedObject(input); !reflect.DeepEqual(actual, map[string]string{"env": "prod"}) {
+		t.Fatalf("Expected only the Tags which aren't ignored but got %+v", actual)
+	}
+}
+
+func TestRetainIgnoredTags(t *testing.T) {
+	SetIgnoredTags([]string{"ms-resource-usage"}, []string{"hidden-link:"})
+	defer SetIgnoredTags(nil, nil)
+
+	existing := map[string]*string{
+		"env":                                   utils.String("test"),
+		"owner":                                 utils.String("platform"),
+		"ms-resource-usage":                     utils.String("azure-cloud-shell"),
+		"hidden-link:/app-insights-resource-id": utils.String("/subscriptions/00000000-0000-0000-0000-000000000000"),
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Existing map[string]*string
+		Expected map[string]string
+	}{
+		{
+			Name: "No Existing Tags",
+			Input: map[string]interface{}{
+				"env": "prod",
+			},
+			Expected: map[string]string{
+				"env": "prod",
+			},
+		},
+		{
+			Name: "Ignored Tags are retained",
+			Input: map[string]interface{}{
+				"env": "prod",
+			},
+			Existing: existing,
+			Expected: map[string]string{
+				"env":                                   "prod",
+				"ms-resource-usage":                     "azure-cloud-shell",
+				"hidden-link:/app-insights-resource-id": "/subscriptions/00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Name: "Resource Tags take precedence",
+			Input: map[string]interface{}{
+				"MS-Resource-Usage": "terraform",
+			},
+			Existing: existing,
+			Expected: map[string]string{
+				"MS-Resource-Usage":                     "terraform",
+				"hidden-link:/app-insights-resource-id": "/subscriptions/00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := retainIgnoredTags(Expand(v.Input), v.Existing)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d tags but got %d: %+v", len(v.Expected), len(actual), actual)
+		}
+		for k, expected := range v.Expected {
+			if actual[k] == nil || *actual[k] != expected {
+				t.Fatalf("Expected %q to be %q but got %v", k, expected, actual[k])
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package tags
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ExpandPointer expands the Tags defined on the Resource into the Tags sent to Azure in the format used
+// by the newer SDKs (a `*map[string]string`) - as with Expand this includes the Default Tags configured
+// in the Provider block.
+func ExpandPointer(tagsMap map[string]interface{}) *map[string]string {
+	return toPointer(Expand(tagsMap))
+}
+
+// FlattenPointer flattens the Tags returned from Azure in the format used by the newer SDKs (a
+// `*map[string]string`), excluding any Tags ignored in the Provider block
+func FlattenPointer(input *map[string]string) map[string]interface{} {
+	if input == nil {
+		return make(map[string]interface{})
+	}
+
+	return Flatten(fromPointer(*input))
+}
+
+func FlattenPointerAndSet(d *pluginsdk.ResourceData, input *map[string]string) error {
+	if err := d.Set("tags", FlattenPointer(input)); err != nil {
+		return fmt.Errorf("setting `tags`: %s", err)
+	}
+
+	return nil
+}
+
+// FromTypedObjectPointer converts the Tags defined on a Typed Resource into the Tags sent to Azure in the
+// format used by the newer SDKs (a `*map[string]string`) - see FromTypedObject
+func FromTypedObjectPointer(input map[string]string) *map[string]string {
+	return toPointer(FromTypedObject(input))
+}
+
+// ToTypedObjectPointer converts the Tags returned from Azure in the format used by the newer SDKs (a
+// `*map[string]string`) into the Tags defined on a Typed Resource - see ToTypedObject
+func ToTypedObjectPointer(input *map[string]string) map[string]string {
+	if input == nil {
+		return make(map[string]string)
+	}
+
+	return ToTypedObject(fromPointer(*input))
+}
+
+func fromPointer(input map[string]string) map[string]*string {
+	output := make(map[string]*string, len(input))
+	for k, v := range input {
+		value := v
+		output[k] = &value
+	}
+	return output
+}
+
+func toPointer(input map[string]*string) *map[string]string {
+	output := make(map[string]string, len(input))
+	for k, v := range input {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+	return &output
+}
@@ -0,0 +1,69 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandPointer(t *testing.T) {
+	SetDefaultTags(map[string]string{
+		"cost-center": "1234",
+	})
+	defer SetDefaultTags(nil)
+
+	expected := map[string]string{
+		"cost-center": "1234",
+		"env":         "prod",
+	}
+
+	actual := ExpandPointer(map[string]interface{}{"env": "prod"})
+	if actual == nil || !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFlattenPointer(t *testing.T) {
+	SetIgnoredTags([]string{"ms-resource-usage"}, nil)
+	defer SetIgnoredTags(nil, nil)
+
+	if actual := FlattenPointer(nil); len(actual) != 0 {
+		t.Fatalf("Expected no Tags but got %+v", actual)
+	}
+
+	input := &map[string]string{
+		"env":               "prod",
+		"ms-resource-usage": "azure-cloud-shell",
+	}
+	expected := map[string]interface{}{
+		"env": "prod",
+	}
+	if actual := FlattenPointer(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestTypedObjectPointer(t *testing.T) {
+	SetDefaultTags(map[string]string{
+		"cost-center": "1234",
+	})
+	defer SetDefaultTags(nil)
+	SetIgnoredTags([]string{"ms-resource-usage"}, nil)
+	defer SetIgnoredTags(nil, nil)
+
+	expected := map[string]string{
+		"cost-center": "1234",
+		"env":         "prod",
+	}
+	actual := FromTypedObjectPointer(map[string]string{"env": "prod"})
+	if actual == nil || !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	(*actual)["ms-resource-usage"] = "azure-cloud-shell"
+	if flattened := ToTypedObjectPointer(actual); !reflect.DeepEqual(flattened, map[string]string{"cost-center": "1234", "env": "prod"}) {
+		t.Fatalf("Expected the Tags which aren't ignored but got %+v", flattened)
+	}
+	if flattened := ToTypedObjectPointer(nil); len(flattened) != 0 {
+		t.Fatalf("Expected no Tags but got %+v", flattened)
+	}
+}
@@ -0,0 +1,124 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RetainIgnoredTagsSender returns a Sender which retains the existing values of the Tags ignored in the
+// Provider block when a Resource is created or updated.
+//
+// Since the Tags sent to Azure replace the existing Tags, and the ignored Tags are removed when the Tags
+// are flattened (so aren't included when they're expanded), when the body of a PUT/PATCH request contains
+// `tags` the existing Resource is retrieved from the same URI, and the existing values for the ignored
+// Tags are added to the request. This is done for each request sent to Azure, rather than within each
+// Resource, so that every Resource retains the ignored Tags.
+func RetainIgnoredTagsSender(next autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Body == nil || (req.Method != http.MethodPut && req.Method != http.MethodPatch) || !hasIgnoredTags() {
+			return next.Do(req)
+		}
+
+		if err := retainIgnoredTagsInRequest(next, req); err != nil {
+			return nil, err
+		}
+
+		return next.Do(req)
+	})
+}
+
+func retainIgnoredTagsInRequest(next autorest.Sender, req *http.Request) error {
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return fmt.Errorf("reading the request body: %+v", err)
+	}
+	setRequestBody(req, body)
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		// not a JSON object, so there's no Tags to retain
+		return nil
+	}
+	var configured map[string]*string
+	if raw, ok := payload["tags"]; !ok || json.Unmarshal(raw, &configured) != nil || configured == nil {
+		return nil
+	}
+
+	existing, err := existingTags(next, req)
+	if err != nil {
+		return fmt.Errorf("retrieving the existing Tags for %q: %+v", req.URL.Path, err)
+	}
+
+	count := len(configured)
+	retained := retainIgnoredTags(configured, existing)
+	if len(retained) == count {
+		// there are no ignored Tags to retain
+		return nil
+	}
+
+	log.Printf("[DEBUG] Retaining the ignored Tags %q for %q", ignoredKeys(existing), req.URL.Path)
+	if payload["tags"], err = json.Marshal(retained); err != nil {
+		return fmt.Errorf("marshaling the Tags: %+v", err)
+	}
+	if body, err = json.Marshal(payload); err != nil {
+		return fmt.Errorf("marshaling the request body: %+v", err)
+	}
+	setRequestBody(req, body)
+
+	return nil
+}
+
+// existingTags returns the Tags for the Resource at the URI of the request, which are empty when the
+// Resource doesn't exist (or can't be retrieved from this URI)
+func existingTags(next autorest.Sender, req *http.Request) (map[string]*string, error) {
+	get, err := http.NewRequestWithContext(req.Context(), http.MethodGet, req.URL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	get.Header = req.Header.Clone()
+	for _, header := range []string{"Content-Type", "Content-Length", "If-Match", "If-None-Match"} {
+		get.Header.Del(header)
+	}
+
+	resp, err := next.Do(get)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil
+	}
+
+	var existing struct {
+		Tags map[string]*string `json:"tags"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&existing); err != nil {
+		return nil, nil
+	}
+
+	output := make(map[string]*string)
+	for k, v := range existing.Tags {
+		if IsIgnored(k) {
+			output[k] = v
+		}
+	}
+	return output, nil
+}
+
+func setRequestBody(req *http.Request, body []byte) {
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Length", fmt.Sprintf("%d", len(body)))
+}
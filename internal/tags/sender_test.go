@@ -0,0 +1,133 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetainIgnoredTagsSender(t *testing.T) {
+	SetIgnoredTags([]string{"ms-resource-usage"}, []string{"hidden-link:"})
+	defer SetIgnoredTags(nil, nil)
+
+	testData := []struct {
+		Name     string
+		Method   string
+		Existing string
+		Body     string
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "Resource doesn't exist",
+			Method:   http.MethodPut,
+			Body:     `{"location":"westeurope","tags":{"env":"prod"}}`,
+			Expected: map[string]interface{}{"location": "westeurope", "tags": map[string]interface{}{"env": "prod"}},
+		},
+		{
+			// the ignored Tag was changed outside of Terraform
+			Name:     "Ignored Tags are retained",
+			Method:   http.MethodPut,
+			Existing: `{"location":"westeurope","tags":{"env":"test","ms-resource-usage":"changed","hidden-link:/app-insights":"/subscriptions/00000000-0000-0000-0000-000000000000"}}`,
+			Body:     `{"location":"westeurope","tags":{"env":"prod"}}`,
+			Expected: map[string]interface{}{
+				"location": "westeurope",
+				"tags": map[string]interface{}{
+					"env":                       "prod",
+					"ms-resource-usage":         "changed",
+					"hidden-link:/app-insights": "/subscriptions/00000000-0000-0000-0000-000000000000",
+				},
+			},
+		},
+		{
+			Name:     "Ignored Tags are retained when patching",
+			Method:   http.MethodPatch,
+			Existing: `{"tags":{"ms-resource-usage":"azure-cloud-shell"}}`,
+			Body:     `{"tags":{}}`,
+			Expected: map[string]interface{}{"tags": map[string]interface{}{"ms-resource-usage": "azure-cloud-shell"}},
+		},
+		{
+			Name:     "Resource Tags take precedence",
+			Method:   http.MethodPut,
+			Existing: `{"tags":{"ms-resource-usage":"azure-cloud-shell"}}`,
+			Body:     `{"tags":{"MS-Resource-Usage":"terraform"}}`,
+			Expected: map[string]interface{}{"tags": map[string]interface{}{"MS-Resource-Usage": "terraform"}},
+		},
+		{
+			Name:     "Request without Tags",
+			Method:   http.MethodPut,
+			Existing: `{"tags":{"ms-resource-usage":"azure-cloud-shell"}}`,
+			Body:     `{"properties":{"enabled":true}}`,
+			Expected: map[string]interface{}{"properties": map[string]interface{}{"enabled": true}},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		var sent []byte
+		sender := RetainIgnoredTagsSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet {
+				if v.Existing == "" {
+					return testResponse(http.StatusNotFound, `{"error":{"code":"ResourceNotFound"}}`), nil
+				}
+				return testResponse(http.StatusOK, v.Existing), nil
+			}
+
+			body, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			if req.ContentLength != int64(len(body)) {
+				t.Fatalf("expected the Content Length to be %d but got %d", len(body), req.ContentLength)
+			}
+			sent = body
+			return testResponse(http.StatusOK, string(body)), nil
+		}))
+
+		req, err := http.NewRequest(v.Method, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1?api-version=2020-06-01", bytes.NewReader([]byte(v.Body)))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+
+		var actual map[string]interface{}
+		if err := json.Unmarshal(sent, &actual); err != nil {
+			t.Fatalf("unmarshaling %q: %+v", string(sent), err)
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRetainIgnoredTagsSenderNoIgnoredTags(t *testing.T) {
+	sender := RetainIgnoredTagsSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			t.Fatalf("expected the existing Resource not to be retrieved when no Tags are ignored")
+		}
+		return testResponse(http.StatusOK, `{}`), nil
+	}))
+
+	req, err := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1?api-version=2020-06-01", bytes.NewReader([]byte(`{"tags":{}}`)))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+}
+
+func testResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
@@ -1,5 +1,7 @@
 package tags
 
+// FromTypedObject converts the Tags defined on the Resource into the Tags sent to Azure, which
+// includes any Default Tags configured in the Provider block - see Expand
 func FromTypedObject(input map[string]string) map[string]*string {
 	output := make(map[string]*string, len(input))
 
@@ -9,13 +11,15 @@ func FromTypedObject(input map[string]string) map[string]*string {
 		output[k] = &value
 	}
 
-	return output
+	return mergeDefaultTags(output)
 }
 
+// ToTypedObject converts the Tags returned from Azure into the Tags defined on the Resource, excluding
+// any Tags ignored in the Provider block (since these are managed outside of Terraform)
 func ToTypedObject(input map[string]*string) map[string]string {
 	output := make(map[string]string)
 
-	for k, v := range input {
+	for k, v := range Filter(input, ignoredKeys(input)...) {
 		if v == nil {
 			continue
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -47,3 +48,35 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 		},
 	}
 }
+
+// UnknownResourceId is a (well-formed) Resource ID for a Resource Type which doesn't exist in Azure, which
+// no Resource validating the Resource ID during import should accept
+const UnknownResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Unknown.Provider/things/thing1"
+
+// ImporterResourceIdError returns the error raised when parsing the specified Resource ID using the Importer
+// for this Resource, which (when using ImporterValidatingResourceId) parses the Resource ID prior to running
+// any custom import logic.
+//
+// This is intended to be used by the tooling, where the Provider hasn't been configured - custom import logic
+// can assume the Provider has been configured, which only runs once the Resource ID has been parsed successfully.
+func ImporterResourceIdError(resource *schema.Resource, id string) (err error) {
+	defer func() {
+		if recover() != nil {
+			err = nil
+		}
+	}()
+
+	d := resource.Data(nil)
+	d.SetId(id)
+	if _, err := resource.Importer.StateContext(context.TODO(), d, nil); err != nil && strings.HasPrefix(err.Error(), "parsing Resource ID") {
+		return err
+	}
+
+	return nil
+}
+
+// ImporterValidatesResourceId returns whether the Importer for this Resource validates the Resource ID, such that
+// the Resource ID for another Resource Type is rejected - since Resources which don't accept any Resource ID
+func ImporterValidatesResourceId(resource *schema.Resource) bool {
+	return ImporterResourceIdError(resource, UnknownResourceId) != nil
+}
@@ -0,0 +1,41 @@
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestImporterResourceIdError(t *testing.T) {
+	validating := &schema.Resource{
+		Importer: ImporterValidatingResourceIdThen(func(id string) error {
+			if !strings.HasSuffix(id, "/example/resource1") {
+				return fmt.Errorf("expected an Example Resource ID")
+			}
+			return nil
+		}, func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+			// custom import logic which uses the Provider
+			_ = meta.(fmt.Stringer).String()
+			return []*ResourceData{d}, nil
+		}),
+	}
+	if err := ImporterResourceIdError(validating, "/subscriptions/00000000-0000-0000-0000-000000000000/example/resource1"); err != nil {
+		t.Fatalf("expected the Resource ID to be valid but got %+v", err)
+	}
+	if err := ImporterResourceIdError(validating, UnknownResourceId); err == nil {
+		t.Fatalf("expected the Unknown Resource ID to be invalid")
+	}
+	if !ImporterValidatesResourceId(validating) {
+		t.Fatalf("expected the Importer to validate the Resource ID")
+	}
+
+	passthrough := &schema.Resource{
+		Importer: DefaultImporter(),
+	}
+	if ImporterValidatesResourceId(passthrough) {
+		t.Fatalf("expected the Importer not to validate the Resource ID")
+	}
+}
@@ -0,0 +1,217 @@
+package pluginsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// The Private State is stored alongside a Resource in the Terraform State but isn't exposed to users, which
+// the Plugin SDK uses to store the Schema Version and Timeouts. The Plugin SDK doesn't expose this to Resources
+// - as such the Private State is read from (and written to) the gRPC requests by the ProviderServer in this
+// package, which is made available to a Resource by registering the ResourceData for the duration of the operation.
+
+// privateStateKeyPrefix is the prefix for the keys within the Private State which are managed by the Provider,
+// to distinguish these from the keys which are managed by the Plugin SDK
+const privateStateKeyPrefix = "azurerm_"
+
+type privateState struct {
+	lock   sync.Mutex
+	values map[string]json.RawMessage
+}
+
+type privateStateContextKey struct{}
+
+// newPrivateStateContext returns a context containing the Provider managed keys within the Private State
+func newPrivateStateContext(ctx context.Context, raw []byte) (context.Context, *privateState) {
+	state := &privateState{
+		values: filterPrivateState(raw, true),
+	}
+	return context.WithValue(ctx, privateStateContextKey{}, state), state
+}
+
+// merge merges the Provider managed keys into the Private State returned from the Plugin SDK
+func (s *privateState) merge(raw []byte) ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.values) == 0 && len(raw) == 0 {
+		return raw, nil
+	}
+
+	output := filterPrivateState(raw, false)
+	for k, v := range s.values {
+		output[k] = v
+	}
+	return json.Marshal(output)
+}
+
+func filterPrivateState(raw []byte, providerManaged bool) map[string]json.RawMessage {
+	output := make(map[string]json.RawMessage)
+	if len(raw) == 0 {
+		return output
+	}
+
+	values := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return output
+	}
+	for k, v := range values {
+		isProviderManaged := len(k) > len(privateStateKeyPrefix) && k[:len(privateStateKeyPrefix)] == privateStateKeyPrefix
+		if isProviderManaged == providerManaged {
+			output[k] = v
+		}
+	}
+	return output
+}
+
+var (
+	// registeredPrivateState is the Private State for each ResourceData which is currently being operated on
+	registeredPrivateState     = make(map[*ResourceData]*privateState)
+	registeredPrivateStateLock sync.Mutex
+)
+
+// WithPrivateState makes the Private State from the context available to the ResourceData whilst `fn` is running
+func WithPrivateState(ctx context.Context, d *ResourceData, fn func() error) error {
+	state, ok := ctx.Value(privateStateContextKey{}).(*privateState)
+	if !ok || d == nil {
+		return fn()
+	}
+
+	registeredPrivateStateLock.Lock()
+	registeredPrivateState[d] = state
+	registeredPrivateStateLock.Unlock()
+
+	defer func() {
+		registeredPrivateStateLock.Lock()
+		delete(registeredPrivateState, d)
+		registeredPrivateStateLock.Unlock()
+	}()
+
+	return fn()
+}
+
+func privateStateFor(d *ResourceData) *privateState {
+	registeredPrivateStateLock.Lock()
+	defer registeredPrivateStateLock.Unlock()
+
+	return registeredPrivateState[d]
+}
+
+// GetPrivateState retrieves the value for the specified key from the Private State for this Resource, returning
+// false if the key doesn't exist or the Private State is unavailable
+func GetPrivateState(d *ResourceData, key string, v interface{}) (bool, error) {
+	state := privateStateFor(d)
+	if state == nil {
+		return false, nil
+	}
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	raw, ok := state.values[privateStateKeyPrefix+key]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, fmt.Errorf("decoding %q from the Private State: %+v", key, err)
+	}
+	return true, nil
+}
+
+// SetPrivateState sets the value for the specified key within the Private State for this Resource, returning
+// false if the Private State is unavailable (for example when running the Acceptance Tests)
+func SetPrivateState(d *ResourceData, key string, v interface{}) (bool, error) {
+	state := privateStateFor(d)
+	if state == nil {
+		return false, nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return false, fmt.Errorf("encoding %q for the Private State: %+v", key, err)
+	}
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	state.values[privateStateKeyPrefix+key] = raw
+	return true, nil
+}
+
+// RemovePrivateState removes the specified key from the Private State for this Resource
+func RemovePrivateState(d *ResourceData, key string) {
+	state := privateStateFor(d)
+	if state == nil {
+		return
+	}
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	delete(state.values, privateStateKeyPrefix+key)
+}
+
+// OperationInProgressError is returned when an operation is still in progress, but has been persisted to the
+// Private State so that it can be resumed during the next Terraform run - which is surfaced as a warning
+type OperationInProgressError struct {
+	Message string
+}
+
+func (e OperationInProgressError) Error() string {
+	return e.Message
+}
+
+// DiagnosticsFromError converts the error into Diagnostics, where an OperationInProgressError is a warning
+func DiagnosticsFromError(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	if inProgress, ok := err.(OperationInProgressError); ok {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  inProgress.Message,
+				Detail:   inProgress.Message,
+			},
+		}
+	}
+
+	return diag.FromErr(err)
+}
+
+// EnablePrivateState makes the Private State available to the Create, Read, Update and Delete functions
+// for this Resource - and allows these to return an OperationInProgressError
+func EnablePrivateState(r *Resource) *Resource {
+	wrap := func(fn func(*ResourceData, interface{}) error) func(context.Context, *ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			return DiagnosticsFromError(WithPrivateState(ctx, d, func() error {
+				return fn(d, meta)
+			}))
+		}
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if r.Create != nil { //nolint:staticcheck
+		r.CreateContext = wrap(r.Create) //nolint:staticcheck
+		r.Create = nil                   //nolint:staticcheck
+	}
+	if r.Read != nil { //nolint:staticcheck
+		r.ReadContext = wrap(r.Read) //nolint:staticcheck
+		r.Read = nil                 //nolint:staticcheck
+	}
+	if r.Update != nil { //nolint:staticcheck
+		r.UpdateContext = wrap(r.Update) //nolint:staticcheck
+		r.Update = nil                   //nolint:staticcheck
+	}
+	if r.Delete != nil { //nolint:staticcheck
+		r.DeleteContext = wrap(r.Delete) //nolint:staticcheck
+		r.Delete = nil                   //nolint:staticcheck
+	}
+
+	return r
+}
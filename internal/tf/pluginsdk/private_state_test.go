@@ -0,0 +1,73 @@
+package pluginsdk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestPrivateState(t *testing.T) {
+	// the Private State returned from the Plugin SDK contains the Schema Version and Timeouts
+	prior := []byte(`{"schema_version":"1","azurerm_existing":"hello"}`)
+	ctx, state := newPrivateStateContext(context.TODO(), prior)
+
+	d := &ResourceData{}
+	err := WithPrivateState(ctx, d, func() error {
+		var existing string
+		exists, err := GetPrivateState(d, "existing", &existing)
+		if err != nil {
+			return err
+		}
+		if !exists || existing != "hello" {
+			t.Fatalf("expected `existing` to be %q but got %q", "hello", existing)
+		}
+
+		RemovePrivateState(d, "existing")
+		if _, err := SetPrivateState(d, "pending_operation", map[string]string{"pollingURI": "https://example.com"}); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// once the operation has completed the ResourceData is no longer registered
+	if persisted, _ := SetPrivateState(d, "other", "value"); persisted {
+		t.Fatalf("expected the Private State to be unavailable")
+	}
+
+	raw, err := state.merge([]byte(`{"schema_version":"2"}`))
+	if err != nil {
+		t.Fatalf("merging: %+v", err)
+	}
+	actual := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &actual); err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+
+	if actual["schema_version"] != "2" {
+		t.Fatalf("expected the `schema_version` from the Plugin SDK to be retained but got %+v", actual)
+	}
+	if _, ok := actual["azurerm_existing"]; ok {
+		t.Fatalf("expected `azurerm_existing` to be removed but got %+v", actual)
+	}
+	if _, ok := actual["azurerm_pending_operation"]; !ok {
+		t.Fatalf("expected `azurerm_pending_operation` to be set but got %+v", actual)
+	}
+}
+
+func TestPrivateStateUnavailable(t *testing.T) {
+	// the Private State isn't available when the Provider is used without the ProviderServer (e.g. in tests)
+	d := &ResourceData{}
+	err := WithPrivateState(context.TODO(), d, func() error {
+		persisted, err := SetPrivateState(d, "pending_operation", "value")
+		if persisted {
+			t.Fatalf("expected the Private State to be unavailable")
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
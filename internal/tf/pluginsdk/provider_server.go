@@ -0,0 +1,60 @@
+package pluginsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// privateStateProviderServer is a ProviderServer which makes the Private State available to Resources
+// (see WithPrivateState) - and persists any changes made to it
+type privateStateProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+// NewProviderServerWithPrivateState returns a ProviderServer which makes the Private State available to Resources
+func NewProviderServerWithPrivateState(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return privateStateProviderServer{
+		ProviderServer: server,
+	}
+}
+
+func (s privateStateProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	ctx, state := newPrivateStateContext(ctx, req.Private)
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.Private, err = state.merge(resp.Private); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s privateStateProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	// the Plugin SDK rebuilds the Private State during the plan, so the Provider managed keys need to be retained
+	_, state := newPrivateStateContext(ctx, req.PriorPrivate)
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.PlannedPrivate, err = state.merge(resp.PlannedPrivate); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s privateStateProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	ctx, state := newPrivateStateContext(ctx, req.PlannedPrivate)
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.Private, err = state.merge(resp.Private); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+package throttling
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a Token Bucket which allows a burst of requests (up to the capacity), after which
+// requests are limited to the rate at which the tokens are refilled - which mirrors how the Resource
+// Manager API throttles requests.
+type tokenBucket struct {
+	lock sync.Mutex
+
+	// rate is the number of tokens added to the bucket per second, where 0 is unlimited
+	rate float64
+
+	// capacity is the maximum number of tokens within the bucket
+	capacity float64
+
+	tokens      float64
+	lastRefill  time.Time
+	pausedUntil time.Time
+
+	// now is only here to aid testing
+	now func() time.Time
+}
+
+func newTokenBucket(requestsPerSecond int) *tokenBucket {
+	rate := float64(requestsPerSecond)
+	capacity := rate * burstMultiplier
+	return &tokenBucket{
+		rate:       rate,
+		capacity:   capacity,
+		tokens:     capacity,
+		lastRefill: time.Now(),
+		now:        time.Now,
+	}
+}
+
+// wait blocks until a token is available (and the bucket isn't paused), or the context is cancelled
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := b.take()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// take takes a token from the bucket if one's available - otherwise returns how long to wait for one
+func (b *tokenBucket) take() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.now()
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+
+	if b.rate <= 0 {
+		return 0
+	}
+
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	if elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.lastRefill = now
+}
+
+// pauseUntil pauses all requests using this bucket until the specified time, for example
+// when the Resource Manager API returns a 429 with a `Retry-After` header
+func (b *tokenBucket) pauseUntil(until time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// limitTo limits the tokens within the bucket to the number of requests remaining, as returned by
+// the Resource Manager API - since other clients can also be using the quota for this Subscription
+func (b *tokenBucket) limitTo(remaining float64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.rate <= 0 {
+		return
+	}
+
+	b.refill(b.now())
+	if remaining < b.tokens {
+		b.tokens = remaining
+	}
+}
@@ -0,0 +1,198 @@
+package throttling
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// burstMultiplier is the number of seconds of requests which can be sent in a burst, which
+	// matches the ratio between the bucket size and the refill rate used by Resource Manager
+	burstMultiplier = 10
+
+	// defaultPause is how long requests are paused when a 429 is returned without a `Retry-After` header
+	defaultPause = 10 * time.Second
+
+	headerRemainingSubscriptionReads    = "x-ms-ratelimit-remaining-subscription-reads"
+	headerRemainingSubscriptionWrites   = "x-ms-ratelimit-remaining-subscription-writes"
+	headerRemainingSubscriptionDeletes  = "x-ms-ratelimit-remaining-subscription-deletes"
+	headerRemainingSubscriptionRequests = "x-ms-ratelimit-remaining-subscription-resource-requests"
+)
+
+// resourceProviderFallback is the Resource Provider used for requests which aren't to a Resource Provider,
+// for example listing the Resource Groups within a Subscription
+const resourceProviderFallback = "Microsoft.Resources"
+
+var (
+	subscriptionIdRegex   = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)`)
+	resourceProviderRegex = regexp.MustCompile(`(?i)/providers/([^/]+)`)
+)
+
+// Settings defines the budget for the requests sent to the Resource Manager API, where 0 is unlimited
+type Settings struct {
+	// SubscriptionReadsPerSecond is the number of read requests per second sent to a Subscription
+	SubscriptionReadsPerSecond int
+
+	// SubscriptionWritesPerSecond is the number of write (and delete) requests per second sent to a Subscription
+	SubscriptionWritesPerSecond int
+
+	// ResourceProviderRequestsPerSecond is the number of requests per second sent to each
+	// Resource Provider (e.g. `Microsoft.Compute`) within a Subscription
+	ResourceProviderRequestsPerSecond int
+}
+
+// Governor limits the requests sent to the Resource Manager API across every client, using a Token Bucket
+// for each Subscription and each Resource Provider within it - and pausing requests when the API returns
+// a 429, so that parallel requests don't continue to exhaust the quota.
+type Governor struct {
+	settings Settings
+
+	lock    sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func NewGovernor(settings Settings) *Governor {
+	return &Governor{
+		settings: settings,
+		buckets:  make(map[string]*tokenBucket),
+	}
+}
+
+// Sender returns a Sender which applies the budget to the requests sent using the specified Sender
+func (g *Governor) Sender(next autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		subscriptionBucket, providerBucket := g.bucketsForRequest(req)
+		if subscriptionBucket == nil {
+			// this isn't a request to a Subscription (e.g. a Data Plane API), so there's no budget
+			return next.Do(req)
+		}
+
+		if err := subscriptionBucket.wait(req.Context()); err != nil {
+			return nil, err
+		}
+		if err := providerBucket.wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, err := next.Do(req)
+		if resp == nil {
+			return resp, err
+		}
+
+		subscriptionRemaining, hasSubscriptionRemaining := remainingRequests(resp, subscriptionHeaderForMethod(req.Method))
+		if hasSubscriptionRemaining {
+			subscriptionBucket.limitTo(subscriptionRemaining)
+		}
+		if providerRemaining, ok := remainingRequests(resp, headerRemainingSubscriptionRequests); ok {
+			providerBucket.limitTo(providerRemaining)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			until := time.Now().Add(retryAfter(resp))
+			log.Printf("[DEBUG] Throttled by the Resource Manager API for %s %s - pausing requests until %s", req.Method, req.URL.Path, until.Format(time.RFC3339))
+
+			// when there's quota remaining within the Subscription this is throttled by the Resource Provider
+			providerBucket.pauseUntil(until)
+			if !hasSubscriptionRemaining || subscriptionRemaining <= 0 {
+				subscriptionBucket.pauseUntil(until)
+			}
+		}
+
+		return resp, err
+	})
+}
+
+func (g *Governor) bucketsForRequest(req *http.Request) (subscriptionBucket *tokenBucket, providerBucket *tokenBucket) {
+	if req.URL == nil {
+		return nil, nil
+	}
+
+	match := subscriptionIdRegex.FindStringSubmatch(req.URL.Path)
+	if len(match) != 2 {
+		return nil, nil
+	}
+	subscriptionId := strings.ToLower(match[1])
+
+	// requests to a nested resource (e.g. Diagnostic Settings) count against the last Resource Provider
+	provider := resourceProviderFallback
+	if matches := resourceProviderRegex.FindAllStringSubmatch(req.URL.Path, -1); len(matches) > 0 {
+		provider = matches[len(matches)-1][1]
+	}
+
+	// Resource Manager limits reads, writes and deletes separately
+	subscriptionRate, operation := g.settings.SubscriptionWritesPerSecond, "writes"
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		subscriptionRate, operation = g.settings.SubscriptionReadsPerSecond, "reads"
+	case http.MethodDelete:
+		operation = "deletes"
+	}
+
+	subscriptionBucket = g.bucket(fmt.Sprintf("%s/%s", subscriptionId, operation), subscriptionRate)
+	providerBucket = g.bucket(fmt.Sprintf("%s/providers/%s", subscriptionId, strings.ToLower(provider)), g.settings.ResourceProviderRequestsPerSecond)
+	return subscriptionBucket, providerBucket
+}
+
+func (g *Governor) bucket(key string, requestsPerSecond int) *tokenBucket {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	bucket, ok := g.buckets[key]
+	if !ok {
+		bucket = newTokenBucket(requestsPerSecond)
+		g.buckets[key] = bucket
+	}
+	return bucket
+}
+
+func subscriptionHeaderForMethod(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return headerRemainingSubscriptionReads
+	case http.MethodDelete:
+		return headerRemainingSubscriptionDeletes
+	default:
+		return headerRemainingSubscriptionWrites
+	}
+}
+
+func remainingRequests(resp *http.Response, header string) (float64, bool) {
+	v := resp.Header.Get(header)
+	if v == "" {
+		return 0, false
+	}
+
+	remaining, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+
+	return float64(remaining), true
+}
+
+// retryAfter returns how long to wait before sending further requests, from the `Retry-After`
+// header - which is either a number of seconds or a HTTP Date
+func retryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return defaultPause
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		return time.Until(date)
+	}
+
+	return defaultPause
+}
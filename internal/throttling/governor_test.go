@@ -0,0 +1,164 @@
+package throttling
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(1)
+	bucket.now = func() time.Time {
+		return now
+	}
+	bucket.lastRefill = now
+
+	// the bucket starts full, allowing a burst of requests
+	for i := 0; i < burstMultiplier; i++ {
+		if delay := bucket.take(); delay != 0 {
+			t.Fatalf("expected request %d to be allowed but got a delay of %s", i, delay)
+		}
+	}
+	if delay := bucket.take(); delay != time.Second {
+		t.Fatalf("expected a delay of 1s once the bucket is empty but got %s", delay)
+	}
+
+	// which is then refilled at the rate
+	now = now.Add(2 * time.Second)
+	for i := 0; i < 2; i++ {
+		if delay := bucket.take(); delay != 0 {
+			t.Fatalf("expected request %d to be allowed after refilling but got a delay of %s", i, delay)
+		}
+	}
+
+	// and paused when throttled
+	now = now.Add(5 * time.Second)
+	bucket.pauseUntil(now.Add(30 * time.Second))
+	if delay := bucket.take(); delay != 30*time.Second {
+		t.Fatalf("expected a delay of 30s when paused but got %s", delay)
+	}
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	bucket := newTokenBucket(0)
+	for i := 0; i < 1000; i++ {
+		if delay := bucket.take(); delay != 0 {
+			t.Fatalf("expected request %d to be allowed but got a delay of %s", i, delay)
+		}
+	}
+}
+
+func TestTokenBucketLimitTo(t *testing.T) {
+	bucket := newTokenBucket(5)
+	bucket.limitTo(1)
+
+	if delay := bucket.take(); delay != 0 {
+		t.Fatalf("expected the first request to be allowed but got a delay of %s", delay)
+	}
+	if delay := bucket.take(); delay == 0 {
+		t.Fatalf("expected the second request to be delayed")
+	}
+}
+
+func TestGovernorBucketsForRequest(t *testing.T) {
+	testData := []struct {
+		Name                 string
+		Method               string
+		Path                 string
+		ExpectedSubscription string
+		ExpectedProvider     string
+	}{
+		{
+			Name:                 "Resource",
+			Method:               http.MethodGet,
+			Path:                 "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example",
+			ExpectedSubscription: "00000000-0000-0000-0000-000000000000/reads",
+			ExpectedProvider:     "00000000-0000-0000-0000-000000000000/providers/microsoft.compute",
+		},
+		{
+			Name:                 "Nested Resource",
+			Method:               http.MethodPut,
+			Path:                 "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example/providers/Microsoft.Insights/diagnosticSettings/example",
+			ExpectedSubscription: "00000000-0000-0000-0000-000000000000/writes",
+			ExpectedProvider:     "00000000-0000-0000-0000-000000000000/providers/microsoft.insights",
+		},
+		{
+			Name:                 "Resource Group",
+			Method:               http.MethodDelete,
+			Path:                 "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			ExpectedSubscription: "00000000-0000-0000-0000-000000000000/deletes",
+			ExpectedProvider:     "00000000-0000-0000-0000-000000000000/providers/microsoft.resources",
+		},
+		{
+			Name:   "Data Plane",
+			Method: http.MethodGet,
+			Path:   "/secrets/example",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		governor := NewGovernor(Settings{})
+		subscriptionBucket, providerBucket := governor.bucketsForRequest(&http.Request{
+			Method: v.Method,
+			URL:    &url.URL{Path: v.Path},
+		})
+
+		if v.ExpectedSubscription == "" {
+			if subscriptionBucket != nil || providerBucket != nil {
+				t.Fatalf("expected no buckets for %q", v.Path)
+			}
+			continue
+		}
+
+		if governor.buckets[v.ExpectedSubscription] != subscriptionBucket {
+			t.Fatalf("expected the Subscription bucket %q", v.ExpectedSubscription)
+		}
+		if governor.buckets[v.ExpectedProvider] != providerBucket {
+			t.Fatalf("expected the Resource Provider bucket %q", v.ExpectedProvider)
+		}
+	}
+}
+
+func TestGovernorPausesWhenThrottled(t *testing.T) {
+	governor := NewGovernor(Settings{})
+	sender := governor.Sender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		resp := &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{},
+			Request:    req,
+		}
+		resp.Header.Set("Retry-After", "60")
+		resp.Header.Set(headerRemainingSubscriptionReads, "100")
+		return resp, nil
+	}))
+
+	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example", nil)
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	// there's quota remaining in the Subscription, so only the Resource Provider should be paused
+	subscriptionBucket := governor.buckets["00000000-0000-0000-0000-000000000000/reads"]
+	if delay := subscriptionBucket.take(); delay != 0 {
+		t.Fatalf("expected the Subscription not to be paused but got a delay of %s", delay)
+	}
+	providerBucket := governor.buckets["00000000-0000-0000-0000-000000000000/providers/microsoft.compute"]
+	if delay := providerBucket.take(); delay < 55*time.Second {
+		t.Fatalf("expected the Resource Provider to be paused for around 60s but got a delay of %s", delay)
+	}
+
+	// and the paused request should be cancellable
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example", nil)
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("expected an error when the context is cancelled")
+	}
+}
@@ -0,0 +1,738 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/generator-import/parsers"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+func main() {
+	f := flag.NewFlagSet("generator-import", flag.ExitOnError)
+
+	resourceGroupId := f.String("resource-group-id", "", "The ID of the Resource Group containing the Resources which should be imported")
+	outputPath := f.String("output", "", "The path to the file where the generated configuration should be written (defaults to stdout)")
+
+	_ = f.Parse(os.Args[1:])
+
+	if resourceGroupId == nil || *resourceGroupId == "" {
+		log.Print("The ID of the Resource Group must be specified via `-resource-group-id`")
+		os.Exit(1)
+		return
+	}
+
+	if err := run(context.Background(), *resourceGroupId, *outputPath); err != nil {
+		log.Print(err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, resourceGroupId, outputPath string) error {
+	id, err := resourceParse.ResourceGroupID(resourceGroupId)
+	if err != nil {
+		return fmt.Errorf("expected %q to be a Resource Group ID in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}`: %+v", resourceGroupId, err)
+	}
+
+	client, err := buildClient(ctx, id.SubscriptionId)
+	if err != nil {
+		return fmt.Errorf("building client: %+v", err)
+	}
+
+	resourceGroup, err := client.Resource.GroupsClient.Get(ctx, id.ResourceGroup)
+	if err != nil {
+		return fmt.Errorf("retrieving Resource Group %q: %+v", id.ResourceGroup, err)
+	}
+
+	azureResources := []azureResource{
+		{
+			Id:       resourceGroupId,
+			Name:     id.ResourceGroup,
+			Type:     "Microsoft.Resources/resourceGroups",
+			Location: stringValue(resourceGroup.Location),
+			Tags:     stringMapValue(resourceGroup.Tags),
+		},
+	}
+
+	log.Printf("[DEBUG] Listing the Resources within %q..", resourceGroupId)
+	iterator, err := client.Resource.ResourcesClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "", nil)
+	if err != nil {
+		return fmt.Errorf("listing Resources within %q: %+v", resourceGroupId, err)
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.ID != nil {
+			azureResources = append(azureResources, azureResource{
+				Id:       *item.ID,
+				Name:     stringValue(item.Name),
+				Type:     stringValue(item.Type),
+				Kind:     stringValue(item.Kind),
+				Location: stringValue(item.Location),
+				Tags:     stringMapValue(item.Tags),
+			})
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Resources within %q: %+v", resourceGroupId, err)
+		}
+	}
+
+	for i, item := range azureResources {
+		osType, err := osTypeFor(ctx, client, item)
+		if err != nil {
+			return fmt.Errorf("determining the OS Type for %q: %+v", item.Id, err)
+		}
+		azureResources[i].OSType = osType
+	}
+
+	output := newConfigGenerator(buildResourceMatchers()).generate(azureResources)
+
+	if outputPath == "" {
+		fmt.Print(output)
+		return nil
+	}
+
+	return ioutil.WriteFile(outputPath, []byte(output), 0644)
+}
+
+func buildClient(ctx context.Context, subscriptionId string) (*clients.Client, error) {
+	environment, exists := os.LookupEnv("ARM_ENVIRONMENT")
+	if !exists {
+		environment = "public"
+	}
+
+	builder := authentication.Builder{
+		SubscriptionID: subscriptionId,
+		ClientID:       os.Getenv("ARM_CLIENT_ID"),
+		TenantID:       os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:   os.Getenv("ARM_CLIENT_SECRET"),
+		Environment:    environment,
+		MetadataHost:   os.Getenv("ARM_METADATA_HOST"),
+
+		// a Service Principal is used when the Client Secret is specified, otherwise the Azure CLI
+		SupportsClientSecretAuth: true,
+		SupportsAzureCliToken:    true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("building the Authentication Config: %+v", err)
+	}
+
+	return clients.Build(ctx, clients.ClientBuilder{
+		AuthConfig:               config,
+		SkipProviderRegistration: true,
+		TerraformVersion:         "0.15+compatible",
+		Features:                 features.Default(),
+	})
+}
+
+// azureResource is a Resource which exists within the Resource Group
+type azureResource struct {
+	Id       string
+	Name     string
+	Type     string
+	Kind     string
+	OSType   string
+	Location string
+	Tags     map[string]string
+}
+
+// armResourceTypeVariant is a Terraform Resource which only imports the Resources of an ARM Resource Type where the
+// Kind and/or OS Type (when specified) match, since some ARM Resource Types are split into multiple Terraform Resources
+// (e.g. Linux and Windows Virtual Machines) which accept the same Resource ID
+type armResourceTypeVariant struct {
+	resourceType string
+	kind         string
+	osType       string
+}
+
+func (t armResourceTypeVariant) matches(item azureResource) bool {
+	if t.osType != "" && !strings.EqualFold(t.osType, item.OSType) {
+		return false
+	}
+
+	if t.kind != "" {
+		for _, v := range strings.Split(item.Kind, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), t.kind) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// armResourceTypeVariants are the Terraform Resources (in order of preference) for the ARM Resource Types (lower-cased)
+// which can't be distinguished using the Resource ID alone - any other Terraform Resources which can import these
+// Resource ID's manage a part of the Resource (e.g. the Source Control for a Web App) and so aren't matched
+var armResourceTypeVariants = map[string][]armResourceTypeVariant{
+	// the OS Type is retrieved from the Storage Profile of the Virtual Machine (Scale Set), see `osTypeFor`
+	"microsoft.compute/virtualmachines": {
+		{resourceType: "azurerm_linux_virtual_machine", osType: "Linux"},
+		{resourceType: "azurerm_windows_virtual_machine", osType: "Windows"},
+		{resourceType: "azurerm_virtual_machine"},
+	},
+	"microsoft.compute/virtualmachinescalesets": {
+		{resourceType: "azurerm_linux_virtual_machine_scale_set", osType: "Linux"},
+		{resourceType: "azurerm_windows_virtual_machine_scale_set", osType: "Windows"},
+		{resourceType: "azurerm_virtual_machine_scale_set"},
+	},
+
+	// Web Apps and Function Apps are distinguished using the Kind, which contains `linux` for Linux
+	"microsoft.web/sites": {
+		{resourceType: "azurerm_logic_app_standard", kind: "workflowapp"},
+		{resourceType: "azurerm_linux_function_app", kind: "functionapp", osType: "Linux"},
+		{resourceType: "azurerm_windows_function_app", kind: "functionapp", osType: "Windows"},
+		{resourceType: "azurerm_linux_web_app", kind: "app", osType: "Linux"},
+		{resourceType: "azurerm_windows_web_app", kind: "app", osType: "Windows"},
+		{resourceType: "azurerm_function_app", kind: "functionapp"},
+		{resourceType: "azurerm_app_service", kind: "app"},
+	},
+	"microsoft.web/sites/slots": {
+		{resourceType: "azurerm_linux_function_app_slot", kind: "functionapp", osType: "Linux"},
+		{resourceType: "azurerm_windows_function_app_slot", kind: "functionapp", osType: "Windows"},
+		{resourceType: "azurerm_linux_web_app_slot", kind: "app", osType: "Linux"},
+		{resourceType: "azurerm_windows_web_app_slot", kind: "app", osType: "Windows"},
+		{resourceType: "azurerm_function_app_slot", kind: "functionapp"},
+		{resourceType: "azurerm_app_service_slot", kind: "app"},
+	},
+}
+
+// osTypeFor returns the Operating System used by this Resource, which is needed to determine which Terraform Resource
+// the Resource should be imported into, since this isn't available from the Resource Group
+func osTypeFor(ctx context.Context, client *clients.Client, item azureResource) (string, error) {
+	switch strings.ToLower(item.Type) {
+	case "microsoft.compute/virtualmachines":
+		id, err := computeParse.VirtualMachineID(item.Id)
+		if err != nil {
+			return "", err
+		}
+		vm, err := client.Compute.VMClient.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return "", fmt.Errorf("retrieving Virtual Machine %q: %+v", item.Id, err)
+		}
+		if props := vm.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+			return string(props.StorageProfile.OsDisk.OsType), nil
+		}
+
+	case "microsoft.compute/virtualmachinescalesets":
+		id, err := computeParse.VirtualMachineScaleSetID(item.Id)
+		if err != nil {
+			return "", err
+		}
+		vmss, err := client.Compute.VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return "", fmt.Errorf("retrieving Virtual Machine Scale Set %q: %+v", item.Id, err)
+		}
+		if props := vmss.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil {
+			if profile := props.VirtualMachineProfile.StorageProfile; profile != nil && profile.OsDisk != nil {
+				return string(profile.OsDisk.OsType), nil
+			}
+		}
+
+	case "microsoft.web/serverfarms", "microsoft.web/sites", "microsoft.web/sites/slots":
+		for _, v := range strings.Split(item.Kind, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), "linux") {
+				return "Linux", nil
+			}
+		}
+		return "Windows", nil
+	}
+
+	return "", nil
+}
+
+// resourceMatcher is a Terraform Resource which supports import
+type resourceMatcher struct {
+	resourceType string
+	resource     *schema.Resource
+	validate     func(id string) bool
+
+	// validatesId specifies whether the Resource ID is validated during import, Resources which don't validate
+	// the Resource ID aren't matched, since `validate` always returns true
+	validatesId bool
+}
+
+// buildResourceMatchers returns a resourceMatcher for each Resource within the Service Registrations
+func buildResourceMatchers() []resourceMatcher {
+	matchers := make([]resourceMatcher, 0)
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				log.Printf("[DEBUG] Skipping %q since the Resource couldn't be built: %+v", r.ResourceType(), err)
+				continue
+			}
+
+			validateFunc := r.IDValidationFunc()
+			matchers = append(matchers, resourceMatcher{
+				resourceType: r.ResourceType(),
+				resource:     resource,
+				validate: func(id string) bool {
+					_, errors := validateFunc(id, "id")
+					return len(errors) == 0
+				},
+			})
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for resourceType, r := range service.SupportedResources() {
+			if r.Importer == nil || r.Importer.StateContext == nil {
+				continue
+			}
+
+			resource := r
+			matchers = append(matchers, resourceMatcher{
+				resourceType: resourceType,
+				resource:     resource,
+				validate: func(id string) bool {
+					return pluginsdk.ImporterResourceIdError(resource, id) == nil
+				},
+			})
+		}
+	}
+
+	for i, matcher := range matchers {
+		matchers[i].validatesId = !matcher.validate(pluginsdk.UnknownResourceId)
+	}
+
+	sort.Slice(matchers, func(i, j int) bool {
+		return matchers[i].resourceType < matchers[j].resourceType
+	})
+	return matchers
+}
+
+type configGenerator struct {
+	matchers []resourceMatcher
+
+	// addresses is the Terraform address for each Resource ID (lower-cased) which has been generated,
+	// allowing Resources which reference these to use a reference rather than the ID
+	addresses map[string]string
+
+	// labels is the set of Terraform Labels which have been used for each Resource Type
+	labels map[string]map[string]struct{}
+}
+
+func newConfigGenerator(matchers []resourceMatcher) *configGenerator {
+	return &configGenerator{
+		matchers:  matchers,
+		addresses: make(map[string]string),
+		labels:    make(map[string]map[string]struct{}),
+	}
+}
+
+func (g *configGenerator) generate(input []azureResource) string {
+	// parent Resources have shorter IDs, so these are generated first so that they can be referenced
+	sort.SliceStable(input, func(i, j int) bool {
+		if len(input[i].Id) != len(input[j].Id) {
+			return len(input[i].Id) < len(input[j].Id)
+		}
+		return input[i].Id < input[j].Id
+	})
+
+	blocks := make([]string, 0)
+	unsupported := make([]string, 0)
+	for _, item := range input {
+		matches := g.resourceTypesFor(item)
+		if len(matches) == 0 {
+			unsupported = append(unsupported, fmt.Sprintf("#  - %s (%s)", item.Id, item.Type))
+			continue
+		}
+
+		blocks = append(blocks, g.generateResource(item, matches))
+	}
+
+	output := "# NOTE: this file is generated via 'internal/tools/generator-import' and is intended to be a starting point\n"
+	output += "# for importing these Resources, the configuration for each Resource requires human review.\n"
+	if len(unsupported) > 0 {
+		output += "#\n# The following Resources couldn't be mapped to a Terraform Resource:\n"
+		output += strings.Join(unsupported, "\n") + "\n"
+	}
+	for _, block := range blocks {
+		output += "\n" + block
+	}
+	return output
+}
+
+// resourceTypesFor returns the matchers for the Terraform Resources which can import this Resource, determined by
+// parsing the Resource ID using the Resource ID Parser for each Terraform Resource - where the ARM Resource Type is
+// split across multiple Terraform Resources the Kind/OS Type is used to choose between them
+func (g *configGenerator) resourceTypesFor(item azureResource) []resourceMatcher {
+	matches := make([]resourceMatcher, 0)
+	for _, matcher := range g.matchers {
+		if matcher.validatesId && matcher.validate(item.Id) {
+			matches = append(matches, matcher)
+		}
+	}
+
+	variants, ok := armResourceTypeVariants[strings.ToLower(item.Type)]
+	if !ok {
+		return inOrderOfPreference(matches)
+	}
+
+	output := make([]resourceMatcher, 0)
+	for _, variant := range variants {
+		if !variant.matches(item) {
+			continue
+		}
+		for _, matcher := range matches {
+			if matcher.resourceType == variant.resourceType {
+				output = append(output, matcher)
+			}
+		}
+	}
+
+	return inOrderOfPreference(output)
+}
+
+// inOrderOfPreference returns the matchers where Resources which aren't deprecated (nor associations between
+// two Resources, or Resources which manage a part of another matched Resource - both of which use the ID of
+// that Resource) are preferred
+func inOrderOfPreference(input []resourceMatcher) []resourceMatcher {
+	managesPartOf := func(matcher resourceMatcher) bool {
+		for _, other := range input {
+			if strings.HasPrefix(matcher.resourceType, other.resourceType+"_") {
+				return true
+			}
+		}
+		return false
+	}
+
+	preferred := make([]resourceMatcher, 0)
+	associations := make([]resourceMatcher, 0)
+	deprecated := make([]resourceMatcher, 0)
+	for _, matcher := range input {
+		switch {
+		case matcher.resource.DeprecationMessage != "":
+			deprecated = append(deprecated, matcher)
+		case strings.HasSuffix(matcher.resourceType, "_association") || managesPartOf(matcher):
+			associations = append(associations, matcher)
+		default:
+			preferred = append(preferred, matcher)
+		}
+	}
+
+	output := append(preferred, associations...)
+	return append(output, deprecated...)
+}
+
+func (g *configGenerator) generateResource(item azureResource, matches []resourceMatcher) string {
+	matcher := matches[0]
+	label := g.labelFor(matcher.resourceType, item.Name)
+	address := fmt.Sprintf("%s.%s", matcher.resourceType, label)
+
+	output := ""
+	if len(matches) > 1 {
+		alternatives := make([]string, 0)
+		for _, v := range matches[1:] {
+			alternatives = append(alternatives, v.resourceType)
+		}
+		output += fmt.Sprintf("# NOTE: this Resource can also be imported into: %s\n", strings.Join(alternatives, ", "))
+	}
+
+	output += fmt.Sprintf("import {\n  to = %s\n  id = %s\n}\n\n", address, quote(item.Id))
+	output += fmt.Sprintf("resource %q %q {\n", matcher.resourceType, label)
+	output += g.generateArguments(item, matcher.resource)
+	output += "}\n"
+
+	g.addresses[strings.ToLower(item.Id)] = address
+	return output
+}
+
+func (g *configGenerator) generateArguments(item azureResource, resource *schema.Resource) string {
+	values := g.knownValues(item)
+
+	attributes := make([]string, 0)
+	todo := make([]string, 0)
+	var tagsBlock string
+
+	fieldNames := make([]string, 0)
+	for k := range resource.Schema {
+		fieldNames = append(fieldNames, k)
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		field := resource.Schema[fieldName]
+		if field.Computed && !field.Optional && !field.Required {
+			continue
+		}
+
+		if fieldName == "tags" {
+			if len(item.Tags) > 0 && field.Type == schema.TypeMap {
+				tagsBlock = generateMap(fieldName, item.Tags)
+			}
+			continue
+		}
+
+		if _, ok := values[fieldName]; ok && field.Type == schema.TypeString {
+			attributes = append(attributes, fieldName)
+			continue
+		}
+
+		if !field.Required {
+			continue
+		}
+
+		if _, isBlock := field.Elem.(*schema.Resource); isBlock {
+			todo = append(todo, fmt.Sprintf("  # TODO: the `%s` block is Required", fieldName))
+		} else {
+			todo = append(todo, fmt.Sprintf("  # TODO: the `%s` field is Required", fieldName))
+		}
+	}
+
+	output := ""
+	longest := 0
+	for _, k := range attributes {
+		if len(k) > longest {
+			longest = len(k)
+		}
+	}
+	for _, k := range sortAttributes(attributes) {
+		output += fmt.Sprintf("  %s%s = %s\n", k, strings.Repeat(" ", longest-len(k)), values[k])
+	}
+
+	if len(todo) > 0 {
+		if output != "" {
+			output += "\n"
+		}
+		output += strings.Join(todo, "\n") + "\n"
+	}
+
+	if tagsBlock != "" {
+		if output != "" {
+			output += "\n"
+		}
+		output += tagsBlock
+	}
+
+	return output
+}
+
+// knownValues returns the values which can be determined from the Resource ID and the Resource itself, where the
+// Resource ID is parsed using the Resource ID Parser from the Service Package - and the value for each `{segment}_name`
+// and `{segment}_id` field is determined from the fields within the parsed Resource ID (for example `virtual_network_name`
+// from `VirtualNetworkName`) - which are expressions
+func (g *configGenerator) knownValues(item azureResource) map[string]string {
+	output := map[string]string{
+		"name": quote(item.Name),
+	}
+	if item.Location != "" {
+		output["location"] = quote(item.Location)
+	}
+
+	id := parseResourceId(item)
+	if id == nil {
+		log.Printf("[DEBUG] No Resource ID Parser was found for %q (%s)", item.Id, item.Type)
+		return output
+	}
+
+	// the segments within the ID are in the order they appear (as are the fields within the parsed Resource ID),
+	// so the ID for each parent can be determined
+	segments := strings.Split(strings.TrimPrefix(item.Id, "/"), "/")
+	segmentIndex := 0
+
+	value := reflect.ValueOf(id).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		fieldName := value.Type().Field(i).Name
+		fieldValue, ok := value.Field(i).Interface().(string)
+		if !ok || fieldValue == "" {
+			continue
+		}
+
+		switch fieldName {
+		case "SubscriptionId":
+			continue
+
+		case "Name":
+			// the name of this Resource, rather than the `{parent}/{name}` returned for nested Resources
+			output["name"] = quote(fieldValue)
+			continue
+		}
+
+		parentId := ""
+		for ; segmentIndex+1 < len(segments); segmentIndex += 2 {
+			if segments[segmentIndex] != "providers" && segments[segmentIndex+1] == fieldValue {
+				parentId = "/" + strings.Join(segments[:segmentIndex+2], "/")
+				segmentIndex += 2
+				break
+			}
+		}
+
+		fieldPrefix := fieldNameForParsedField(fieldName)
+		output[fieldPrefix+"_name"] = quote(fieldValue)
+		if parentId == "" {
+			continue
+		}
+		if address, ok := g.addresses[strings.ToLower(parentId)]; ok {
+			output[fieldPrefix+"_id"] = fmt.Sprintf("%s.id", address)
+			output[fieldPrefix+"_name"] = fmt.Sprintf("%s.name", address)
+		} else {
+			output[fieldPrefix+"_id"] = quote(parentId)
+		}
+	}
+
+	return output
+}
+
+// parseResourceId parses the Resource ID using the Resource ID Parsers (from the Service Packages) for the ARM
+// Resource Type, returning a pointer to the parsed Resource ID - or nil if none of these could parse it
+func parseResourceId(item azureResource) interface{} {
+	for _, parser := range parsers.ResourceIdParsers[strings.ToLower(item.Type)] {
+		id, err := parser(item.Id)
+		if err != nil {
+			continue
+		}
+
+		if v := reflect.ValueOf(id); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+			return id
+		}
+	}
+
+	return nil
+}
+
+// labelFor returns a unique Terraform Label for this Resource based on its name
+func (g *configGenerator) labelFor(resourceType, name string) string {
+	label := strings.ToLower(regexp.MustCompile(`[^A-Za-z0-9_]+`).ReplaceAllString(name, "_"))
+	label = strings.Trim(label, "_")
+	if label == "" {
+		label = "resource"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+
+	existing, ok := g.labels[resourceType]
+	if !ok {
+		existing = make(map[string]struct{})
+		g.labels[resourceType] = existing
+	}
+
+	candidate := label
+	for i := 2; ; i++ {
+		if _, used := existing[candidate]; !used {
+			break
+		}
+		candidate = fmt.Sprintf("%s_%d", label, i)
+	}
+	existing[candidate] = struct{}{}
+	return candidate
+}
+
+// fieldNameForParsedField returns the field name (without the `_name` suffix) for a field within a parsed
+// Resource ID, for example `VirtualNetworkName` becomes `virtual_network` and `SQLServerName` becomes `sql_server`
+func fieldNameForParsedField(input string) string {
+	runes := []rune(strings.TrimSuffix(input, "Name"))
+
+	output := ""
+	for i, c := range runes {
+		if unicode.IsUpper(c) && i > 0 {
+			// an acronym is a single word, which ends before the start of the next word
+			previousIsLower := unicode.IsLower(runes[i-1])
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if previousIsLower || nextIsLower {
+				output += "_"
+			}
+		}
+		output += string(unicode.ToLower(c))
+	}
+	return output
+}
+
+// sortAttributes sorts the attributes in the same order used in the documentation
+func sortAttributes(input []string) []string {
+	priority := map[string]int{
+		"name":                0,
+		"resource_group_name": 1,
+		"location":            2,
+	}
+	sort.SliceStable(input, func(i, j int) bool {
+		iPriority, iOk := priority[input[i]]
+		jPriority, jOk := priority[input[j]]
+		if iOk && jOk {
+			return iPriority < jPriority
+		}
+		if iOk != jOk {
+			return iOk
+		}
+		return input[i] < input[j]
+	})
+	return input
+}
+
+func generateMap(fieldName string, input map[string]string) string {
+	keys := make([]string, 0)
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	renderedKeys := make(map[string]string)
+	longest := 0
+	for _, k := range keys {
+		rendered := k
+		if !regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`).MatchString(k) {
+			rendered = quote(k)
+		}
+		renderedKeys[k] = rendered
+		if len(rendered) > longest {
+			longest = len(rendered)
+		}
+	}
+
+	output := fmt.Sprintf("  %s = {\n", fieldName)
+	for _, k := range keys {
+		rendered := renderedKeys[k]
+		output += fmt.Sprintf("    %s%s = %s\n", rendered, strings.Repeat(" ", longest-len(rendered)), quote(input[k]))
+	}
+	output += "  }\n"
+	return output
+}
+
+// quote returns the input as a HCL string, escaping any interpolation sequences
+func quote(input string) string {
+	output := strconv.Quote(input)
+	output = strings.ReplaceAll(output, "${", "$${")
+	output = strings.ReplaceAll(output, "%{", "%%{")
+	return output
+}
+
+func stringValue(input *string) string {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
+
+func stringMapValue(input map[string]*string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = stringValue(v)
+	}
+	return output
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	input := []azureResource{
+		{
+			Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Name: "subnet1",
+			Type: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			Id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Name:     "network1",
+			Type:     "Microsoft.Network/virtualNetworks",
+			Location: "westeurope",
+			Tags: map[string]string{
+				"environment": "production",
+				"cost centre": "${var.example}",
+			},
+		},
+		{
+			Id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Name:     "group1",
+			Type:     "Microsoft.Resources/resourceGroups",
+			Location: "westeurope",
+		},
+		{
+			Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Unknown.Provider/things/thing1",
+			Name: "thing1",
+			Type: "Unknown.Provider/things",
+		},
+	}
+
+	actual := newConfigGenerator(buildResourceMatchers()).generate(input)
+
+	expected := []string{
+		`#  - /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Unknown.Provider/things/thing1 (Unknown.Provider/things)`,
+		`import {
+  to = azurerm_resource_group.group1
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+}
+
+resource "azurerm_resource_group" "group1" {
+  name     = "group1"
+  location = "westeurope"
+}
+`,
+		`resource "azurerm_virtual_network" "network1" {
+  name                = "network1"
+  resource_group_name = azurerm_resource_group.group1.name
+  location            = "westeurope"
+
+  # TODO: the ` + "`address_space`" + ` field is Required
+
+  tags = {
+    "cost centre" = "$${var.example}"
+    environment   = "production"
+  }
+}
+`,
+		`import {
+  to = azurerm_subnet.subnet1
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+}
+
+resource "azurerm_subnet" "subnet1" {
+  name                 = "subnet1"
+  resource_group_name  = azurerm_resource_group.group1.name
+  virtual_network_name = azurerm_virtual_network.network1.name
+}
+`,
+	}
+	for _, v := range expected {
+		if !strings.Contains(actual, v) {
+			t.Fatalf("expected the generated configuration to contain:\n\n%s\n\nbut got:\n\n%s", v, actual)
+		}
+	}
+
+	// Resources must be generated before the Resources which reference them
+	if strings.Index(actual, `resource "azurerm_resource_group"`) > strings.Index(actual, `resource "azurerm_virtual_network"`) {
+		t.Fatalf("expected the Resource Group to be generated before the Virtual Network but got:\n\n%s", actual)
+	}
+}
+
+func TestResourceTypesFor(t *testing.T) {
+	// the App Service Resources are behind a Feature Flag
+	os.Setenv("ARM_THREEPOINTZERO_BETA_RESOURCES", "true")
+	defer os.Unsetenv("ARM_THREEPOINTZERO_BETA_RESOURCES")
+
+	testData := []struct {
+		input    azureResource
+		expected []string
+	}{
+		{
+			input: azureResource{
+				Id:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+				Type:   "Microsoft.Compute/virtualMachines",
+				OSType: "Linux",
+			},
+			expected: []string{"azurerm_linux_virtual_machine", "azurerm_virtual_machine"},
+		},
+		{
+			input: azureResource{
+				Id:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+				Type:   "Microsoft.Compute/virtualMachines",
+				OSType: "Windows",
+			},
+			expected: []string{"azurerm_windows_virtual_machine", "azurerm_virtual_machine"},
+		},
+		{
+			input: azureResource{
+				Id:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+				Type:   "Microsoft.Web/sites",
+				Kind:   "functionapp,linux",
+				OSType: "Linux",
+			},
+			expected: []string{"azurerm_linux_function_app", "azurerm_function_app"},
+		},
+		{
+			input: azureResource{
+				Id:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+				Type:   "Microsoft.Web/sites",
+				Kind:   "app",
+				OSType: "Windows",
+			},
+			expected: []string{"azurerm_windows_web_app", "azurerm_app_service"},
+		},
+		{
+			// Resources which manage a part of another Resource are matched after it
+			input: azureResource{
+				Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+				Type: "Microsoft.Storage/storageAccounts",
+			},
+			expected: []string{"azurerm_storage_account", "azurerm_storage_account_network_rules"},
+		},
+		{
+			input: azureResource{
+				Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkProfiles/profile1",
+				Type: "Microsoft.Network/networkProfiles",
+			},
+			expected: []string{"azurerm_network_profile"},
+		},
+		{
+			input: azureResource{
+				Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Unknown.Provider/things/thing1",
+				Type: "Unknown.Provider/things",
+			},
+			expected: []string{},
+		},
+	}
+
+	generator := newConfigGenerator(buildResourceMatchers())
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q (Kind %q / OS Type %q)..", v.input.Type, v.input.Kind, v.input.OSType)
+
+		actual := make([]string, 0)
+		for _, matcher := range generator.resourceTypesFor(v.input) {
+			actual = append(actual, matcher.resourceType)
+		}
+		if strings.Join(actual, ", ") != strings.Join(v.expected, ", ") {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestARMResourceTypeVariantsAreRegistered(t *testing.T) {
+	// the App Service Resources are behind a Feature Flag
+	os.Setenv("ARM_THREEPOINTZERO_BETA_RESOURCES", "true")
+	defer os.Unsetenv("ARM_THREEPOINTZERO_BETA_RESOURCES")
+
+	matchers := make(map[string]resourceMatcher)
+	for _, matcher := range buildResourceMatchers() {
+		matchers[matcher.resourceType] = matcher
+	}
+
+	for armResourceType, variants := range armResourceTypeVariants {
+		for _, variant := range variants {
+			matcher, ok := matchers[variant.resourceType]
+			if !ok {
+				t.Fatalf("%q is mapped to %q which isn't a Terraform Resource supporting import", armResourceType, variant.resourceType)
+			}
+			if !matcher.validatesId {
+				t.Fatalf("%q is mapped to %q which doesn't validate the Resource ID during import", armResourceType, variant.resourceType)
+			}
+		}
+	}
+}
+
+func TestKnownValues(t *testing.T) {
+	generator := newConfigGenerator(nil)
+	generator.addresses["/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1"] = "azurerm_resource_group.group1"
+
+	actual := generator.knownValues(azureResource{
+		Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+		Name: "server1/database1",
+		Type: "Microsoft.Sql/servers/databases",
+	})
+	expected := map[string]string{
+		"name":                `"database1"`,
+		"resource_group_id":   "azurerm_resource_group.group1.id",
+		"resource_group_name": "azurerm_resource_group.group1.name",
+		"server_id":           `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1"`,
+		"server_name":         `"server1"`,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	// ARM Resource Types without a Resource ID Parser only use the values from the Resource itself
+	actual = generator.knownValues(azureResource{
+		Id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Unknown.Provider/things/thing1",
+		Name:     "thing1",
+		Type:     "Unknown.Provider/things",
+		Location: "westeurope",
+	})
+	expected = map[string]string{
+		"name":     `"thing1"`,
+		"location": `"westeurope"`,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestLabelFor(t *testing.T) {
+	testData := []struct {
+		name     string
+		expected string
+	}{
+		{name: "example", expected: "example"},
+		{name: "Example-Resource.1", expected: "example_resource_1"},
+		{name: "1example", expected: "_1example"},
+		{name: "example", expected: "example_2"},
+		{name: "---", expected: "resource"},
+	}
+
+	generator := newConfigGenerator(nil)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := generator.labelFor("azurerm_example", v.name)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestFieldNameForParsedField(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{input: "ServerName", expected: "server"},
+		{input: "ResourceGroup", expected: "resource_group"},
+		{input: "VirtualNetworkName", expected: "virtual_network"},
+		{input: "SQLServerName", expected: "sql_server"},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		if actual := fieldNameForParsedField(v.input); actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
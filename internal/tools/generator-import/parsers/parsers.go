@@ -0,0 +1,1820 @@
+package parsers
+
+// NOTE: this is Generated from the Resource ID's declared within each Service Package - manual changes will be lost
+//       to re-generate this file, run 'make generate' in the root of the repository
+
+import (
+	apimanagementParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	applicationinsightsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	appserviceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	attestationParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/parse"
+	automationParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	azurestackhciParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	batchParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	botParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	cdnParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	cognitiveParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/parse"
+	communicationParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/parse"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	consumptionParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/parse"
+	containersParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	cosmosParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	costmanagementParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
+	customprovidersParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/customproviders/parse"
+	databasemigrationParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/databasemigration/parse"
+	databoxedgeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/databoxedge/parse"
+	databricksParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/parse"
+	datafactoryParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	datalakeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/datalake/parse"
+	dataprotectionParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
+	datashareParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	devspaceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/devspace/parse"
+	devtestlabsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/parse"
+	digitaltwinsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/parse"
+	dnsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	domainservicesParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/parse"
+	eventgridParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	firewallParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	frontdoorParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
+	hdinsightParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/parse"
+	healthcareParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/parse"
+	hpccacheParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/parse"
+	hsmParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/hsm/parse"
+	iotcentralParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	iothubParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
+	iottimeseriesinsightsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/iottimeseriesinsights/parse"
+	keyvaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	kustoParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	loadbalancerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	logicParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	logzParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/logz/parse"
+	machinelearningParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	maintenanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
+	managedapplicationsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/parse"
+	mariadbParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mariadb/parse"
+	mediaParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/media/parse"
+	mixedrealityParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mixedreality/parse"
+	monitorParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	mssqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	mysqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	netappParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/parse"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	notificationhubParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/parse"
+	policyParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	portalParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/portal/parse"
+	postgresParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
+	privatednsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	purviewParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	recoveryservicesParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	redisParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/parse"
+	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	searchParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	securitycenterParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/parse"
+	sentinelParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	servicebusParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	servicefabricParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabric/parse"
+	servicefabricmanagedParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/parse"
+	servicefabricmeshParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmesh/parse"
+	signalrParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/parse"
+	springcloudParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
+	sqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	streamanalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	synapseParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	trafficmanagerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	videoanalyzerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/videoanalyzer/parse"
+	webParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+)
+
+type ResourceIdParser func(input string) (interface{}, error)
+
+// ResourceIdParsers are the Resource ID Parsers from each Service Package, keyed by the ARM Resource Type (lower-cased)
+var ResourceIdParsers = map[string][]ResourceIdParser{
+	"microsoft.aad/domainservices/initialreplicasetid": {
+		func(input string) (interface{}, error) { return domainservicesParse.DomainServiceID(input) },
+	},
+	"microsoft.aad/domainservices/replicasets": {
+		func(input string) (interface{}, error) { return domainservicesParse.DomainServiceReplicaSetID(input) },
+	},
+	"microsoft.alertsmanagement/actionrules": {
+		func(input string) (interface{}, error) { return monitorParse.ActionRuleID(input) },
+	},
+	"microsoft.alertsmanagement/smartdetectoralertrules": {
+		func(input string) (interface{}, error) { return monitorParse.SmartDetectorAlertRuleID(input) },
+	},
+	"microsoft.apimanagement/service": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiManagementID(input) },
+	},
+	"microsoft.apimanagement/service/apis": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiID(input) },
+	},
+	"microsoft.apimanagement/service/apis/diagnostics": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiDiagnosticID(input) },
+	},
+	"microsoft.apimanagement/service/apis/operations": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiOperationID(input) },
+	},
+	"microsoft.apimanagement/service/apis/operations/policies": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiOperationPolicyID(input) },
+	},
+	"microsoft.apimanagement/service/apis/operations/tags": {
+		func(input string) (interface{}, error) { return apimanagementParse.OperationTagID(input) },
+	},
+	"microsoft.apimanagement/service/apis/policies": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiPolicyID(input) },
+	},
+	"microsoft.apimanagement/service/apis/releases": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiReleaseID(input) },
+	},
+	"microsoft.apimanagement/service/apis/schemas": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiSchemaID(input) },
+	},
+	"microsoft.apimanagement/service/apis/tags": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiTagID(input) },
+	},
+	"microsoft.apimanagement/service/apiversionsets": {
+		func(input string) (interface{}, error) { return apimanagementParse.ApiVersionSetID(input) },
+	},
+	"microsoft.apimanagement/service/authorizationservers": {
+		func(input string) (interface{}, error) { return apimanagementParse.AuthorizationServerID(input) },
+	},
+	"microsoft.apimanagement/service/backends": {
+		func(input string) (interface{}, error) { return apimanagementParse.BackendID(input) },
+	},
+	"microsoft.apimanagement/service/caches": {
+		func(input string) (interface{}, error) { return apimanagementParse.RedisCacheID(input) },
+	},
+	"microsoft.apimanagement/service/certificates": {
+		func(input string) (interface{}, error) { return apimanagementParse.CertificateID(input) },
+	},
+	"microsoft.apimanagement/service/customdomains": {
+		func(input string) (interface{}, error) { return apimanagementParse.CustomDomainID(input) },
+	},
+	"microsoft.apimanagement/service/diagnostics": {
+		func(input string) (interface{}, error) { return apimanagementParse.DiagnosticID(input) },
+	},
+	"microsoft.apimanagement/service/gateways": {
+		func(input string) (interface{}, error) { return apimanagementParse.GatewayID(input) },
+	},
+	"microsoft.apimanagement/service/gateways/apis": {
+		func(input string) (interface{}, error) { return apimanagementParse.GatewayApiID(input) },
+	},
+	"microsoft.apimanagement/service/groups": {
+		func(input string) (interface{}, error) { return apimanagementParse.GroupID(input) },
+	},
+	"microsoft.apimanagement/service/groups/users": {
+		func(input string) (interface{}, error) { return apimanagementParse.GroupUserID(input) },
+	},
+	"microsoft.apimanagement/service/identityproviders": {
+		func(input string) (interface{}, error) { return apimanagementParse.IdentityProviderID(input) },
+	},
+	"microsoft.apimanagement/service/loggers": {
+		func(input string) (interface{}, error) { return apimanagementParse.LoggerID(input) },
+	},
+	"microsoft.apimanagement/service/namedvalues": {
+		func(input string) (interface{}, error) { return apimanagementParse.NamedValueID(input) },
+		func(input string) (interface{}, error) { return apimanagementParse.PropertyID(input) },
+	},
+	"microsoft.apimanagement/service/notifications/recipientemails": {
+		func(input string) (interface{}, error) { return apimanagementParse.NotificationRecipientEmailID(input) },
+	},
+	"microsoft.apimanagement/service/notifications/recipientusers": {
+		func(input string) (interface{}, error) { return apimanagementParse.NotificationRecipientUserID(input) },
+	},
+	"microsoft.apimanagement/service/openidconnectproviders": {
+		func(input string) (interface{}, error) { return apimanagementParse.OpenIDConnectProviderID(input) },
+	},
+	"microsoft.apimanagement/service/policies": {
+		func(input string) (interface{}, error) { return apimanagementParse.PolicyID(input) },
+	},
+	"microsoft.apimanagement/service/products": {
+		func(input string) (interface{}, error) { return apimanagementParse.ProductID(input) },
+	},
+	"microsoft.apimanagement/service/products/apis": {
+		func(input string) (interface{}, error) { return apimanagementParse.ProductApiID(input) },
+	},
+	"microsoft.apimanagement/service/products/groups": {
+		func(input string) (interface{}, error) { return apimanagementParse.ProductGroupID(input) },
+	},
+	"microsoft.apimanagement/service/products/policies": {
+		func(input string) (interface{}, error) { return apimanagementParse.ProductPolicyID(input) },
+	},
+	"microsoft.apimanagement/service/subscriptions": {
+		func(input string) (interface{}, error) { return apimanagementParse.SubscriptionID(input) },
+	},
+	"microsoft.apimanagement/service/tags": {
+		func(input string) (interface{}, error) { return apimanagementParse.TagID(input) },
+	},
+	"microsoft.apimanagement/service/templates": {
+		func(input string) (interface{}, error) { return apimanagementParse.EmailTemplateID(input) },
+	},
+	"microsoft.apimanagement/service/users": {
+		func(input string) (interface{}, error) { return apimanagementParse.UserID(input) },
+	},
+	"microsoft.appplatform/spring": {
+		func(input string) (interface{}, error) { return springcloudParse.SpringCloudServiceID(input) },
+	},
+	"microsoft.appplatform/spring/apps": {
+		func(input string) (interface{}, error) { return springcloudParse.SpringCloudAppID(input) },
+	},
+	"microsoft.appplatform/spring/apps/bindings": {
+		func(input string) (interface{}, error) { return springcloudParse.SpringCloudAppAssociationID(input) },
+	},
+	"microsoft.appplatform/spring/apps/deployments": {
+		func(input string) (interface{}, error) { return springcloudParse.SpringCloudDeploymentID(input) },
+	},
+	"microsoft.appplatform/spring/apps/domains": {
+		func(input string) (interface{}, error) { return springcloudParse.SpringCloudCustomDomainID(input) },
+	},
+	"microsoft.appplatform/spring/certificates": {
+		func(input string) (interface{}, error) { return springcloudParse.SpringCloudCertificateID(input) },
+	},
+	"microsoft.appplatform/spring/storages": {
+		func(input string) (interface{}, error) { return springcloudParse.SpringCloudStorageID(input) },
+	},
+	"microsoft.attestation/attestationproviders": {
+		func(input string) (interface{}, error) { return attestationParse.ProviderID(input) },
+	},
+	"microsoft.authorization/policyassignments": {
+		func(input string) (interface{}, error) { return policyParse.ResourceGroupAssignmentID(input) },
+		func(input string) (interface{}, error) { return policyParse.SubscriptionAssignmentID(input) },
+	},
+	"microsoft.automation/automationaccounts": {
+		func(input string) (interface{}, error) { return automationParse.AutomationAccountID(input) },
+	},
+	"microsoft.automation/automationaccounts/certificates": {
+		func(input string) (interface{}, error) { return automationParse.CertificateID(input) },
+	},
+	"microsoft.automation/automationaccounts/configurations": {
+		func(input string) (interface{}, error) { return automationParse.ConfigurationID(input) },
+	},
+	"microsoft.automation/automationaccounts/connections": {
+		func(input string) (interface{}, error) { return automationParse.ConnectionID(input) },
+	},
+	"microsoft.automation/automationaccounts/credentials": {
+		func(input string) (interface{}, error) { return automationParse.CredentialID(input) },
+	},
+	"microsoft.automation/automationaccounts/jobschedules": {
+		func(input string) (interface{}, error) { return automationParse.JobScheduleID(input) },
+	},
+	"microsoft.automation/automationaccounts/modules": {
+		func(input string) (interface{}, error) { return automationParse.ModuleID(input) },
+	},
+	"microsoft.automation/automationaccounts/nodeconfigurations": {
+		func(input string) (interface{}, error) { return automationParse.NodeConfigurationID(input) },
+	},
+	"microsoft.automation/automationaccounts/runbooks": {
+		func(input string) (interface{}, error) { return automationParse.RunbookID(input) },
+	},
+	"microsoft.automation/automationaccounts/schedules": {
+		func(input string) (interface{}, error) { return automationParse.ScheduleID(input) },
+	},
+	"microsoft.automation/automationaccounts/variables": {
+		func(input string) (interface{}, error) { return automationParse.VariableID(input) },
+	},
+	"microsoft.automation/automationaccounts/webhooks": {
+		func(input string) (interface{}, error) { return automationParse.WebhookID(input) },
+	},
+	"microsoft.azurestackhci/clusters": {
+		func(input string) (interface{}, error) { return azurestackhciParse.ClusterID(input) },
+	},
+	"microsoft.batch/batchaccounts": {
+		func(input string) (interface{}, error) { return batchParse.AccountID(input) },
+	},
+	"microsoft.batch/batchaccounts/applications": {
+		func(input string) (interface{}, error) { return batchParse.ApplicationID(input) },
+	},
+	"microsoft.batch/batchaccounts/certificates": {
+		func(input string) (interface{}, error) { return batchParse.CertificateID(input) },
+	},
+	"microsoft.batch/batchaccounts/pools": {
+		func(input string) (interface{}, error) { return batchParse.PoolID(input) },
+	},
+	"microsoft.batch/batchaccounts/pools/jobs": {
+		func(input string) (interface{}, error) { return batchParse.JobID(input) },
+	},
+	"microsoft.botservice/botservices": {
+		func(input string) (interface{}, error) { return botParse.BotServiceID(input) },
+	},
+	"microsoft.botservice/botservices/channels": {
+		func(input string) (interface{}, error) { return botParse.BotChannelID(input) },
+	},
+	"microsoft.botservice/botservices/connections": {
+		func(input string) (interface{}, error) { return botParse.BotConnectionID(input) },
+	},
+	"microsoft.cache/redis": {
+		func(input string) (interface{}, error) { return redisParse.CacheID(input) },
+	},
+	"microsoft.cache/redis/firewallrules": {
+		func(input string) (interface{}, error) { return redisParse.FirewallRuleID(input) },
+	},
+	"microsoft.cache/redis/linkedservers": {
+		func(input string) (interface{}, error) { return redisParse.LinkedServerID(input) },
+	},
+	"microsoft.cdn/profiles": {
+		func(input string) (interface{}, error) { return cdnParse.ProfileID(input) },
+	},
+	"microsoft.cdn/profiles/endpoints": {
+		func(input string) (interface{}, error) { return cdnParse.EndpointID(input) },
+	},
+	"microsoft.cdn/profiles/endpoints/customdomains": {
+		func(input string) (interface{}, error) { return cdnParse.CustomDomainID(input) },
+	},
+	"microsoft.cognitiveservices/accounts": {
+		func(input string) (interface{}, error) { return cognitiveParse.AccountID(input) },
+	},
+	"microsoft.communication/communicationservices": {
+		func(input string) (interface{}, error) { return communicationParse.CommunicationServiceID(input) },
+	},
+	"microsoft.compute/availabilitysets": {
+		func(input string) (interface{}, error) { return computeParse.AvailabilitySetID(input) },
+	},
+	"microsoft.compute/diskaccesses": {
+		func(input string) (interface{}, error) { return computeParse.DiskAccessID(input) },
+	},
+	"microsoft.compute/diskencryptionsets": {
+		func(input string) (interface{}, error) { return computeParse.DiskEncryptionSetID(input) },
+	},
+	"microsoft.compute/disks": {
+		func(input string) (interface{}, error) { return computeParse.ManagedDiskID(input) },
+	},
+	"microsoft.compute/galleries": {
+		func(input string) (interface{}, error) { return computeParse.SharedImageGalleryID(input) },
+	},
+	"microsoft.compute/galleries/images": {
+		func(input string) (interface{}, error) { return computeParse.SharedImageID(input) },
+	},
+	"microsoft.compute/galleries/images/versions": {
+		func(input string) (interface{}, error) { return computeParse.SharedImageVersionID(input) },
+	},
+	"microsoft.compute/hostgroups": {
+		func(input string) (interface{}, error) { return computeParse.DedicatedHostGroupID(input) },
+		func(input string) (interface{}, error) { return computeParse.HostGroupID(input) },
+	},
+	"microsoft.compute/hostgroups/hosts": {
+		func(input string) (interface{}, error) { return computeParse.DedicatedHostID(input) },
+	},
+	"microsoft.compute/images": {
+		func(input string) (interface{}, error) { return computeParse.ImageID(input) },
+	},
+	"microsoft.compute/proximityplacementgroups": {
+		func(input string) (interface{}, error) { return computeParse.ProximityPlacementGroupID(input) },
+		func(input string) (interface{}, error) { return computeParse.ProximityPlacementGroupID(input) },
+	},
+	"microsoft.compute/snapshots": {
+		func(input string) (interface{}, error) { return computeParse.SnapshotID(input) },
+	},
+	"microsoft.compute/sshpublickeys": {
+		func(input string) (interface{}, error) { return computeParse.SSHPublicKeyID(input) },
+	},
+	"microsoft.compute/virtualmachines": {
+		func(input string) (interface{}, error) { return computeParse.VirtualMachineID(input) },
+	},
+	"microsoft.compute/virtualmachines/datadisks": {
+		func(input string) (interface{}, error) { return computeParse.DataDiskID(input) },
+	},
+	"microsoft.compute/virtualmachines/extensions": {
+		func(input string) (interface{}, error) { return computeParse.VirtualMachineExtensionID(input) },
+	},
+	"microsoft.compute/virtualmachinescalesets": {
+		func(input string) (interface{}, error) { return computeParse.VirtualMachineScaleSetID(input) },
+	},
+	"microsoft.compute/virtualmachinescalesets/extensions": {
+		func(input string) (interface{}, error) { return computeParse.VirtualMachineScaleSetExtensionID(input) },
+	},
+	"microsoft.consumption/budgets": {
+		func(input string) (interface{}, error) {
+			return consumptionParse.ConsumptionBudgetResourceGroupID(input)
+		},
+		func(input string) (interface{}, error) {
+			return consumptionParse.ConsumptionBudgetSubscriptionID(input)
+		},
+	},
+	"microsoft.containerinstance/containergroups": {
+		func(input string) (interface{}, error) { return containersParse.ContainerGroupID(input) },
+	},
+	"microsoft.containerregistry/registries": {
+		func(input string) (interface{}, error) { return containersParse.RegistryID(input) },
+	},
+	"microsoft.containerregistry/registries/scopemaps": {
+		func(input string) (interface{}, error) { return containersParse.ContainerRegistryScopeMapID(input) },
+	},
+	"microsoft.containerregistry/registries/tasks": {
+		func(input string) (interface{}, error) { return containersParse.ContainerRegistryTaskID(input) },
+	},
+	"microsoft.containerregistry/registries/tokens": {
+		func(input string) (interface{}, error) { return containersParse.ContainerRegistryTokenID(input) },
+	},
+	"microsoft.containerregistry/registries/webhooks": {
+		func(input string) (interface{}, error) { return containersParse.WebhookID(input) },
+	},
+	"microsoft.containerservice/managedclusters": {
+		func(input string) (interface{}, error) { return containersParse.ClusterID(input) },
+		func(input string) (interface{}, error) { return machinelearningParse.KubernetesClusterID(input) },
+	},
+	"microsoft.containerservice/managedclusters/agentpools": {
+		func(input string) (interface{}, error) { return containersParse.NodePoolID(input) },
+	},
+	"microsoft.costmanagement/exports": {
+		func(input string) (interface{}, error) {
+			return costmanagementParse.SubscriptionCostManagementExportID(input)
+		},
+		func(input string) (interface{}, error) {
+			return costmanagementParse.ResourceGroupCostManagementExportID(input)
+		},
+	},
+	"microsoft.customproviders/resourceproviders": {
+		func(input string) (interface{}, error) { return customprovidersParse.ResourceProviderID(input) },
+	},
+	"microsoft.databoxedge/databoxedgedevices": {
+		func(input string) (interface{}, error) { return databoxedgeParse.DeviceID(input) },
+	},
+	"microsoft.databoxedge/databoxedgedevices/orders": {
+		func(input string) (interface{}, error) { return databoxedgeParse.OrderID(input) },
+	},
+	"microsoft.databricks/customermangagedkey": {
+		func(input string) (interface{}, error) { return databricksParse.CustomerManagedKeyID(input) },
+	},
+	"microsoft.databricks/workspaces": {
+		func(input string) (interface{}, error) { return databricksParse.WorkspaceID(input) },
+	},
+	"microsoft.datafactory/factories": {
+		func(input string) (interface{}, error) { return datafactoryParse.DataFactoryID(input) },
+	},
+	"microsoft.datafactory/factories/dataflows": {
+		func(input string) (interface{}, error) { return datafactoryParse.DataFlowID(input) },
+	},
+	"microsoft.datafactory/factories/datasets": {
+		func(input string) (interface{}, error) { return datafactoryParse.DataSetID(input) },
+	},
+	"microsoft.datafactory/factories/integrationruntimes": {
+		func(input string) (interface{}, error) { return datafactoryParse.IntegrationRuntimeID(input) },
+	},
+	"microsoft.datafactory/factories/linkedservices": {
+		func(input string) (interface{}, error) { return datafactoryParse.LinkedServiceID(input) },
+	},
+	"microsoft.datafactory/factories/managedvirtualnetworks/managedprivateendpoints": {
+		func(input string) (interface{}, error) { return datafactoryParse.ManagedPrivateEndpointID(input) },
+	},
+	"microsoft.datafactory/factories/pipelines": {
+		func(input string) (interface{}, error) { return datafactoryParse.PipelineID(input) },
+	},
+	"microsoft.datafactory/factories/triggers": {
+		func(input string) (interface{}, error) { return datafactoryParse.TriggerID(input) },
+	},
+	"microsoft.datalakeanalytics/accounts": {
+		func(input string) (interface{}, error) { return datalakeParse.AnalyticsAccountID(input) },
+	},
+	"microsoft.datalakeanalytics/accounts/firewallrules": {
+		func(input string) (interface{}, error) { return datalakeParse.AnalyticsFirewallRuleID(input) },
+	},
+	"microsoft.datalakestore/accounts": {
+		func(input string) (interface{}, error) { return datalakeParse.AccountID(input) },
+	},
+	"microsoft.datalakestore/accounts/firewallrules": {
+		func(input string) (interface{}, error) { return datalakeParse.FirewallRuleID(input) },
+	},
+	"microsoft.datalakestore/accounts/virtualnetworkrules": {
+		func(input string) (interface{}, error) { return datalakeParse.VirtualNetworkRuleID(input) },
+	},
+	"microsoft.datamigration/services": {
+		func(input string) (interface{}, error) { return databasemigrationParse.ServiceID(input) },
+	},
+	"microsoft.datamigration/services/projects": {
+		func(input string) (interface{}, error) { return databasemigrationParse.ProjectID(input) },
+	},
+	"microsoft.dataprotection/backupvaults": {
+		func(input string) (interface{}, error) { return dataprotectionParse.BackupVaultID(input) },
+	},
+	"microsoft.dataprotection/backupvaults/backupinstances": {
+		func(input string) (interface{}, error) { return dataprotectionParse.BackupInstanceID(input) },
+	},
+	"microsoft.dataprotection/backupvaults/backuppolicies": {
+		func(input string) (interface{}, error) { return dataprotectionParse.BackupPolicyID(input) },
+	},
+	"microsoft.datashare/accounts": {
+		func(input string) (interface{}, error) { return datashareParse.AccountID(input) },
+	},
+	"microsoft.datashare/accounts/shares": {
+		func(input string) (interface{}, error) { return datashareParse.ShareID(input) },
+	},
+	"microsoft.datashare/accounts/shares/datasets": {
+		func(input string) (interface{}, error) { return datashareParse.DataSetID(input) },
+	},
+	"microsoft.dbformariadb/servers": {
+		func(input string) (interface{}, error) { return mariadbParse.ServerID(input) },
+	},
+	"microsoft.dbformariadb/servers/configurations": {
+		func(input string) (interface{}, error) { return mariadbParse.MariaDBConfigurationID(input) },
+	},
+	"microsoft.dbformariadb/servers/databases": {
+		func(input string) (interface{}, error) { return mariadbParse.MariaDBDatabaseID(input) },
+	},
+	"microsoft.dbformariadb/servers/firewallrules": {
+		func(input string) (interface{}, error) { return mariadbParse.MariaDBFirewallRuleID(input) },
+	},
+	"microsoft.dbformariadb/servers/virtualnetworkrules": {
+		func(input string) (interface{}, error) { return mariadbParse.MariaDBVirtualNetworkRuleID(input) },
+	},
+	"microsoft.dbformysql/flexibleservers": {
+		func(input string) (interface{}, error) { return mysqlParse.FlexibleServerID(input) },
+	},
+	"microsoft.dbformysql/flexibleservers/configurations": {
+		func(input string) (interface{}, error) { return mysqlParse.FlexibleServerConfigurationID(input) },
+	},
+	"microsoft.dbformysql/flexibleservers/databases": {
+		func(input string) (interface{}, error) { return mysqlParse.FlexibleDatabaseID(input) },
+	},
+	"microsoft.dbformysql/flexibleservers/firewallrules": {
+		func(input string) (interface{}, error) { return mysqlParse.FlexibleServerFirewallRuleID(input) },
+	},
+	"microsoft.dbformysql/servers": {
+		func(input string) (interface{}, error) { return mysqlParse.ServerID(input) },
+	},
+	"microsoft.dbformysql/servers/administrators": {
+		func(input string) (interface{}, error) { return mysqlParse.AzureActiveDirectoryAdministratorID(input) },
+	},
+	"microsoft.dbformysql/servers/configurations": {
+		func(input string) (interface{}, error) { return mysqlParse.ConfigurationID(input) },
+	},
+	"microsoft.dbformysql/servers/databases": {
+		func(input string) (interface{}, error) { return mysqlParse.DatabaseID(input) },
+	},
+	"microsoft.dbformysql/servers/firewallrules": {
+		func(input string) (interface{}, error) { return mysqlParse.FirewallRuleID(input) },
+	},
+	"microsoft.dbformysql/servers/keys": {
+		func(input string) (interface{}, error) { return mysqlParse.KeyID(input) },
+	},
+	"microsoft.dbformysql/servers/virtualnetworkrules": {
+		func(input string) (interface{}, error) { return mysqlParse.VirtualNetworkRuleID(input) },
+	},
+	"microsoft.dbforpostgresql/flexibleservers": {
+		func(input string) (interface{}, error) { return postgresParse.FlexibleServerID(input) },
+	},
+	"microsoft.dbforpostgresql/flexibleservers/configurations": {
+		func(input string) (interface{}, error) { return postgresParse.FlexibleServerConfigurationID(input) },
+	},
+	"microsoft.dbforpostgresql/flexibleservers/databases": {
+		func(input string) (interface{}, error) { return postgresParse.FlexibleServerDatabaseID(input) },
+	},
+	"microsoft.dbforpostgresql/flexibleservers/firewallrules": {
+		func(input string) (interface{}, error) { return postgresParse.FlexibleServerFirewallRuleID(input) },
+	},
+	"microsoft.dbforpostgresql/servers": {
+		func(input string) (interface{}, error) { return postgresParse.ServerID(input) },
+	},
+	"microsoft.dbforpostgresql/servers/administrators": {
+		func(input string) (interface{}, error) {
+			return postgresParse.AzureActiveDirectoryAdministratorID(input)
+		},
+	},
+	"microsoft.dbforpostgresql/servers/configurations": {
+		func(input string) (interface{}, error) { return postgresParse.ConfigurationID(input) },
+	},
+	"microsoft.dbforpostgresql/servers/databases": {
+		func(input string) (interface{}, error) { return postgresParse.DatabaseID(input) },
+	},
+	"microsoft.dbforpostgresql/servers/firewallrules": {
+		func(input string) (interface{}, error) { return postgresParse.FirewallRuleID(input) },
+	},
+	"microsoft.dbforpostgresql/servers/keys": {
+		func(input string) (interface{}, error) { return postgresParse.ServerKeyID(input) },
+	},
+	"microsoft.dbforpostgresql/servers/virtualnetworkrules": {
+		func(input string) (interface{}, error) { return postgresParse.VirtualNetworkRuleID(input) },
+	},
+	"microsoft.devices/iothubs": {
+		func(input string) (interface{}, error) { return iothubParse.IotHubID(input) },
+	},
+	"microsoft.devices/iothubs/endpoints": {
+		func(input string) (interface{}, error) { return iothubParse.EndpointStorageContainerID(input) },
+		func(input string) (interface{}, error) { return iothubParse.EndpointServiceBusTopicID(input) },
+		func(input string) (interface{}, error) { return iothubParse.EndpointServiceBusQueueID(input) },
+		func(input string) (interface{}, error) { return iothubParse.EndpointEventhubID(input) },
+	},
+	"microsoft.devices/iothubs/enrichments": {
+		func(input string) (interface{}, error) { return iothubParse.EnrichmentID(input) },
+	},
+	"microsoft.devices/iothubs/eventhubendpoints/consumergroups": {
+		func(input string) (interface{}, error) { return iothubParse.ConsumerGroupID(input) },
+	},
+	"microsoft.devices/iothubs/fallbackroute": {
+		func(input string) (interface{}, error) { return iothubParse.FallbackRouteID(input) },
+	},
+	"microsoft.devices/iothubs/iothubkeys": {
+		func(input string) (interface{}, error) { return iothubParse.SharedAccessPolicyID(input) },
+	},
+	"microsoft.devices/iothubs/routes": {
+		func(input string) (interface{}, error) { return iothubParse.RouteID(input) },
+	},
+	"microsoft.devices/provisioningservices": {
+		func(input string) (interface{}, error) { return iothubParse.IotHubDpsID(input) },
+	},
+	"microsoft.devices/provisioningservices/certificates": {
+		func(input string) (interface{}, error) { return iothubParse.DpsCertificateID(input) },
+	},
+	"microsoft.devices/provisioningservices/keys": {
+		func(input string) (interface{}, error) { return iothubParse.DpsSharedAccessPolicyID(input) },
+	},
+	"microsoft.devspaces/controllers": {
+		func(input string) (interface{}, error) { return devspaceParse.ControllerID(input) },
+	},
+	"microsoft.devtestlab/labs": {
+		func(input string) (interface{}, error) { return devtestlabsParse.DevTestLabID(input) },
+	},
+	"microsoft.devtestlab/labs/policysets/policies": {
+		func(input string) (interface{}, error) { return devtestlabsParse.DevTestLabPolicyID(input) },
+	},
+	"microsoft.devtestlab/labs/schedules": {
+		func(input string) (interface{}, error) { return devtestlabsParse.DevTestLabScheduleID(input) },
+	},
+	"microsoft.devtestlab/labs/virtualmachines": {
+		func(input string) (interface{}, error) { return devtestlabsParse.DevTestVirtualMachineID(input) },
+	},
+	"microsoft.devtestlab/labs/virtualnetworks": {
+		func(input string) (interface{}, error) { return devtestlabsParse.DevTestVirtualNetworkID(input) },
+	},
+	"microsoft.devtestlab/schedules": {
+		func(input string) (interface{}, error) { return devtestlabsParse.ScheduleID(input) },
+	},
+	"microsoft.digitaltwins/digitaltwinsinstances": {
+		func(input string) (interface{}, error) { return digitaltwinsParse.DigitalTwinsInstanceID(input) },
+	},
+	"microsoft.digitaltwins/digitaltwinsinstances/endpoints": {
+		func(input string) (interface{}, error) { return digitaltwinsParse.DigitalTwinsEndpointID(input) },
+	},
+	"microsoft.documentdb/cassandraclusters": {
+		func(input string) (interface{}, error) { return cosmosParse.CassandraClusterID(input) },
+	},
+	"microsoft.documentdb/cassandraclusters/datacenters": {
+		func(input string) (interface{}, error) { return cosmosParse.CassandraDatacenterID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts": {
+		func(input string) (interface{}, error) { return cosmosParse.DatabaseAccountID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/cassandrakeyspaces": {
+		func(input string) (interface{}, error) { return cosmosParse.CassandraKeyspaceID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/cassandrakeyspaces/tables": {
+		func(input string) (interface{}, error) { return cosmosParse.CassandraTableID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/gremlindatabases": {
+		func(input string) (interface{}, error) { return cosmosParse.GremlinDatabaseID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/gremlindatabases/graphs": {
+		func(input string) (interface{}, error) { return cosmosParse.GremlinGraphID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/mongodbdatabases": {
+		func(input string) (interface{}, error) { return cosmosParse.MongodbDatabaseID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/mongodbdatabases/collections": {
+		func(input string) (interface{}, error) { return cosmosParse.MongodbCollectionID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/notebookworkspaces": {
+		func(input string) (interface{}, error) { return cosmosParse.NotebookWorkspaceID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/sqldatabases": {
+		func(input string) (interface{}, error) { return cosmosParse.SqlDatabaseID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/sqldatabases/containers": {
+		func(input string) (interface{}, error) { return cosmosParse.SqlContainerID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/sqldatabases/containers/storedprocedures": {
+		func(input string) (interface{}, error) { return cosmosParse.SqlStoredProcedureID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/sqldatabases/containers/triggers": {
+		func(input string) (interface{}, error) { return cosmosParse.SqlTriggerID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/sqldatabases/containers/userdefinedfunctions": {
+		func(input string) (interface{}, error) { return cosmosParse.SqlFunctionID(input) },
+	},
+	"microsoft.documentdb/databaseaccounts/tables": {
+		func(input string) (interface{}, error) { return cosmosParse.TableID(input) },
+	},
+	"microsoft.documentdb/locations/restorabledatabaseaccounts": {
+		func(input string) (interface{}, error) { return cosmosParse.RestorableDatabaseAccountID(input) },
+	},
+	"microsoft.eventgrid/domains": {
+		func(input string) (interface{}, error) { return eventgridParse.DomainID(input) },
+	},
+	"microsoft.eventgrid/domains/topics": {
+		func(input string) (interface{}, error) { return eventgridParse.DomainTopicID(input) },
+	},
+	"microsoft.eventgrid/systemtopics": {
+		func(input string) (interface{}, error) { return eventgridParse.SystemTopicID(input) },
+	},
+	"microsoft.eventgrid/systemtopics/eventsubscriptions": {
+		func(input string) (interface{}, error) { return eventgridParse.SystemTopicEventSubscriptionID(input) },
+	},
+	"microsoft.eventgrid/topics": {
+		func(input string) (interface{}, error) { return eventgridParse.TopicID(input) },
+	},
+	"microsoft.guestconfiguration/guestconfigurationassignments": {
+		func(input string) (interface{}, error) {
+			return policyParse.VirtualMachineConfigurationAssignmentID(input)
+		},
+		func(input string) (interface{}, error) {
+			return policyParse.VirtualMachineConfigurationPolicyAssignmentID(input)
+		},
+	},
+	"microsoft.hardwaresecuritymodules/dedicatedhsms": {
+		func(input string) (interface{}, error) { return hsmParse.DedicatedHardwareSecurityModuleID(input) },
+	},
+	"microsoft.hdinsight/clusters": {
+		func(input string) (interface{}, error) { return hdinsightParse.ClusterID(input) },
+	},
+	"microsoft.healthbot/healthbots": {
+		func(input string) (interface{}, error) { return botParse.BotHealthbotID(input) },
+	},
+	"microsoft.healthcareapis/services": {
+		func(input string) (interface{}, error) { return healthcareParse.ServiceID(input) },
+	},
+	"microsoft.hybridcompute/machines": {
+		func(input string) (interface{}, error) { return computeParse.HybridMachineID(input) },
+	},
+	"microsoft.insights/actiongroups": {
+		func(input string) (interface{}, error) { return monitorParse.ActionGroupID(input) },
+	},
+	"microsoft.insights/activitylogalerts": {
+		func(input string) (interface{}, error) { return monitorParse.ActivityLogAlertID(input) },
+	},
+	"microsoft.insights/autoscalesettings": {
+		func(input string) (interface{}, error) { return monitorParse.AutoscaleSettingID(input) },
+	},
+	"microsoft.insights/components": {
+		func(input string) (interface{}, error) { return applicationinsightsParse.ComponentID(input) },
+	},
+	"microsoft.insights/components/analyticsitems": {
+		func(input string) (interface{}, error) { return applicationinsightsParse.AnalyticsSharedItemID(input) },
+	},
+	"microsoft.insights/components/apikeys": {
+		func(input string) (interface{}, error) { return applicationinsightsParse.ApiKeyID(input) },
+	},
+	"microsoft.insights/components/myanalyticsitems": {
+		func(input string) (interface{}, error) { return applicationinsightsParse.AnalyticsUserItemID(input) },
+	},
+	"microsoft.insights/components/smartdetectionrule": {
+		func(input string) (interface{}, error) { return applicationinsightsParse.SmartDetectionRuleID(input) },
+	},
+	"microsoft.insights/logprofiles": {
+		func(input string) (interface{}, error) { return monitorParse.LogProfileID(input) },
+	},
+	"microsoft.insights/metricalerts": {
+		func(input string) (interface{}, error) { return monitorParse.MetricAlertID(input) },
+	},
+	"microsoft.insights/privatelinkscopes": {
+		func(input string) (interface{}, error) { return monitorParse.PrivateLinkScopeID(input) },
+	},
+	"microsoft.insights/privatelinkscopes/scopedresources": {
+		func(input string) (interface{}, error) { return monitorParse.PrivateLinkScopedServiceID(input) },
+	},
+	"microsoft.insights/scheduledqueryrules": {
+		func(input string) (interface{}, error) { return monitorParse.ScheduledQueryRulesID(input) },
+	},
+	"microsoft.insights/webtests": {
+		func(input string) (interface{}, error) { return applicationinsightsParse.WebTestID(input) },
+	},
+	"microsoft.iotcentral/iotapps": {
+		func(input string) (interface{}, error) { return iotcentralParse.ApplicationID(input) },
+	},
+	"microsoft.keyvault/managedhsms": {
+		func(input string) (interface{}, error) { return keyvaultParse.ManagedHSMID(input) },
+	},
+	"microsoft.keyvault/vaults": {
+		func(input string) (interface{}, error) { return keyvaultParse.VaultID(input) },
+	},
+	"microsoft.kusto/clusters": {
+		func(input string) (interface{}, error) { return kustoParse.ClusterID(input) },
+	},
+	"microsoft.kusto/clusters/attacheddatabaseconfigurations": {
+		func(input string) (interface{}, error) { return kustoParse.AttachedDatabaseConfigurationID(input) },
+	},
+	"microsoft.kusto/clusters/databases": {
+		func(input string) (interface{}, error) { return kustoParse.DatabaseID(input) },
+	},
+	"microsoft.kusto/clusters/databases/dataconnections": {
+		func(input string) (interface{}, error) { return kustoParse.DataConnectionID(input) },
+	},
+	"microsoft.kusto/clusters/databases/principalassignments": {
+		func(input string) (interface{}, error) { return kustoParse.DatabasePrincipalAssignmentID(input) },
+	},
+	"microsoft.kusto/clusters/databases/role/fqn": {
+		func(input string) (interface{}, error) { return kustoParse.DatabasePrincipalID(input) },
+	},
+	"microsoft.kusto/clusters/databases/scripts": {
+		func(input string) (interface{}, error) { return kustoParse.ScriptID(input) },
+	},
+	"microsoft.kusto/clusters/principalassignments": {
+		func(input string) (interface{}, error) { return kustoParse.ClusterPrincipalAssignmentID(input) },
+	},
+	"microsoft.logic/integrationaccounts": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountID(input) },
+	},
+	"microsoft.logic/integrationaccounts/agreements": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountAgreementID(input) },
+	},
+	"microsoft.logic/integrationaccounts/assemblies": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountAssemblyID(input) },
+	},
+	"microsoft.logic/integrationaccounts/batchconfigurations": {
+		func(input string) (interface{}, error) {
+			return logicParse.IntegrationAccountBatchConfigurationID(input)
+		},
+	},
+	"microsoft.logic/integrationaccounts/certificates": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountCertificateID(input) },
+	},
+	"microsoft.logic/integrationaccounts/maps": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountMapID(input) },
+	},
+	"microsoft.logic/integrationaccounts/partners": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountPartnerID(input) },
+	},
+	"microsoft.logic/integrationaccounts/schemas": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountSchemaID(input) },
+	},
+	"microsoft.logic/integrationaccounts/sessions": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationAccountSessionID(input) },
+	},
+	"microsoft.logic/integrationserviceenvironments": {
+		func(input string) (interface{}, error) { return logicParse.IntegrationServiceEnvironmentID(input) },
+	},
+	"microsoft.logic/workflows": {
+		func(input string) (interface{}, error) { return logicParse.WorkflowID(input) },
+	},
+	"microsoft.logic/workflows/actions": {
+		func(input string) (interface{}, error) { return logicParse.ActionID(input) },
+	},
+	"microsoft.logic/workflows/triggers": {
+		func(input string) (interface{}, error) { return logicParse.TriggerID(input) },
+	},
+	"microsoft.logz/monitors": {
+		func(input string) (interface{}, error) { return logzParse.LogzMonitorID(input) },
+	},
+	"microsoft.logz/monitors/tagrules": {
+		func(input string) (interface{}, error) { return logzParse.LogzTagRuleID(input) },
+	},
+	"microsoft.machinelearningservices/workspaces": {
+		func(input string) (interface{}, error) { return machinelearningParse.WorkspaceID(input) },
+	},
+	"microsoft.machinelearningservices/workspaces/computes": {
+		func(input string) (interface{}, error) { return machinelearningParse.ComputeClusterID(input) },
+		func(input string) (interface{}, error) { return machinelearningParse.InferenceClusterID(input) },
+		func(input string) (interface{}, error) { return machinelearningParse.ComputeID(input) },
+	},
+	"microsoft.maintenance/maintenanceconfigurations": {
+		func(input string) (interface{}, error) { return maintenanceParse.MaintenanceConfigurationID(input) },
+	},
+	"microsoft.marketplaceordering/agreements/offers/plans": {
+		func(input string) (interface{}, error) { return computeParse.PlanID(input) },
+	},
+	"microsoft.media/mediaservices": {
+		func(input string) (interface{}, error) { return mediaParse.MediaServiceID(input) },
+	},
+	"microsoft.media/mediaservices/assets": {
+		func(input string) (interface{}, error) { return mediaParse.AssetID(input) },
+	},
+	"microsoft.media/mediaservices/assets/assetfilters": {
+		func(input string) (interface{}, error) { return mediaParse.AssetFilterID(input) },
+	},
+	"microsoft.media/mediaservices/contentkeypolicies": {
+		func(input string) (interface{}, error) { return mediaParse.ContentKeyPolicyID(input) },
+	},
+	"microsoft.media/mediaservices/liveevents": {
+		func(input string) (interface{}, error) { return mediaParse.LiveEventID(input) },
+	},
+	"microsoft.media/mediaservices/liveevents/liveoutputs": {
+		func(input string) (interface{}, error) { return mediaParse.LiveOutputID(input) },
+	},
+	"microsoft.media/mediaservices/streamingendpoints": {
+		func(input string) (interface{}, error) { return mediaParse.StreamingEndpointID(input) },
+	},
+	"microsoft.media/mediaservices/streaminglocators": {
+		func(input string) (interface{}, error) { return mediaParse.StreamingLocatorID(input) },
+	},
+	"microsoft.media/mediaservices/streamingpolicies": {
+		func(input string) (interface{}, error) { return mediaParse.StreamingPolicyID(input) },
+	},
+	"microsoft.media/mediaservices/transforms": {
+		func(input string) (interface{}, error) { return mediaParse.TransformID(input) },
+	},
+	"microsoft.media/mediaservices/transforms/jobs": {
+		func(input string) (interface{}, error) { return mediaParse.JobID(input) },
+	},
+	"microsoft.media/videoanalyzers": {
+		func(input string) (interface{}, error) { return videoanalyzerParse.VideoAnalyzerID(input) },
+	},
+	"microsoft.media/videoanalyzers/edgemodules": {
+		func(input string) (interface{}, error) { return videoanalyzerParse.EdgeModuleID(input) },
+	},
+	"microsoft.mixedreality/spatialanchorsaccounts": {
+		func(input string) (interface{}, error) { return mixedrealityParse.SpatialAnchorsAccountID(input) },
+	},
+	"microsoft.netapp/netappaccounts": {
+		func(input string) (interface{}, error) { return netappParse.AccountID(input) },
+	},
+	"microsoft.netapp/netappaccounts/capacitypools": {
+		func(input string) (interface{}, error) { return netappParse.CapacityPoolID(input) },
+	},
+	"microsoft.netapp/netappaccounts/capacitypools/volumes": {
+		func(input string) (interface{}, error) { return netappParse.VolumeID(input) },
+	},
+	"microsoft.netapp/netappaccounts/capacitypools/volumes/snapshots": {
+		func(input string) (interface{}, error) { return netappParse.SnapshotID(input) },
+	},
+	"microsoft.netapp/netappaccounts/snapshotpolicies": {
+		func(input string) (interface{}, error) { return netappParse.SnapshotPolicyID(input) },
+	},
+	"microsoft.network/applicationgateways": {
+		func(input string) (interface{}, error) { return networkParse.ApplicationGatewayID(input) },
+	},
+	"microsoft.network/applicationgateways/authenticationcertificates": {
+		func(input string) (interface{}, error) { return networkParse.AuthenticationCertificateID(input) },
+	},
+	"microsoft.network/applicationgateways/backendaddresspools": {
+		func(input string) (interface{}, error) { return networkParse.BackendAddressPoolID(input) },
+	},
+	"microsoft.network/applicationgateways/backendhttpsettingscollection": {
+		func(input string) (interface{}, error) { return networkParse.BackendHttpSettingsCollectionID(input) },
+	},
+	"microsoft.network/applicationgateways/frontendipconfigurations": {
+		func(input string) (interface{}, error) { return networkParse.FrontendIPConfigurationID(input) },
+	},
+	"microsoft.network/applicationgateways/frontendports": {
+		func(input string) (interface{}, error) { return networkParse.FrontendPortID(input) },
+	},
+	"microsoft.network/applicationgateways/httplisteners": {
+		func(input string) (interface{}, error) { return networkParse.ApplicationGatewayHTTPListenerID(input) },
+		func(input string) (interface{}, error) { return networkParse.HttpListenerID(input) },
+	},
+	"microsoft.network/applicationgateways/probes": {
+		func(input string) (interface{}, error) { return networkParse.ProbeID(input) },
+	},
+	"microsoft.network/applicationgateways/redirectconfigurations": {
+		func(input string) (interface{}, error) { return networkParse.RedirectConfigurationsID(input) },
+	},
+	"microsoft.network/applicationgateways/rewriterulesets": {
+		func(input string) (interface{}, error) { return networkParse.RewriteRuleSetID(input) },
+	},
+	"microsoft.network/applicationgateways/sslcertificates": {
+		func(input string) (interface{}, error) { return networkParse.SslCertificateID(input) },
+	},
+	"microsoft.network/applicationgateways/sslprofiles": {
+		func(input string) (interface{}, error) { return networkParse.SslProfileID(input) },
+	},
+	"microsoft.network/applicationgateways/trustedclientcertificates": {
+		func(input string) (interface{}, error) { return networkParse.TrustedClientCertificateID(input) },
+	},
+	"microsoft.network/applicationgateways/trustedrootcertificates": {
+		func(input string) (interface{}, error) { return networkParse.TrustedRootCertificateID(input) },
+	},
+	"microsoft.network/applicationgateways/urlpathmaps": {
+		func(input string) (interface{}, error) { return networkParse.UrlPathMapID(input) },
+	},
+	"microsoft.network/applicationgateways/urlpathmaps/pathrules": {
+		func(input string) (interface{}, error) {
+			return networkParse.ApplicationGatewayURLPathMapPathRuleID(input)
+		},
+	},
+	"microsoft.network/applicationgatewaywebapplicationfirewallpolicies": {
+		func(input string) (interface{}, error) {
+			return networkParse.ApplicationGatewayWebApplicationFirewallPolicyID(input)
+		},
+	},
+	"microsoft.network/applicationsecuritygroups": {
+		func(input string) (interface{}, error) { return networkParse.ApplicationSecurityGroupID(input) },
+	},
+	"microsoft.network/azurefirewalls": {
+		func(input string) (interface{}, error) { return firewallParse.FirewallID(input) },
+	},
+	"microsoft.network/azurefirewalls/applicationrulecollections": {
+		func(input string) (interface{}, error) {
+			return firewallParse.FirewallApplicationRuleCollectionID(input)
+		},
+	},
+	"microsoft.network/azurefirewalls/natrulecollections": {
+		func(input string) (interface{}, error) { return firewallParse.FirewallNatRuleCollectionID(input) },
+	},
+	"microsoft.network/azurefirewalls/networkrulecollections": {
+		func(input string) (interface{}, error) { return firewallParse.FirewallNetworkRuleCollectionID(input) },
+	},
+	"microsoft.network/bastionhosts": {
+		func(input string) (interface{}, error) { return networkParse.BastionHostID(input) },
+	},
+	"microsoft.network/connections": {
+		func(input string) (interface{}, error) { return networkParse.NetworkGatewayConnectionID(input) },
+	},
+	"microsoft.network/ddosprotectionplans": {
+		func(input string) (interface{}, error) { return networkParse.DdosProtectionPlanID(input) },
+	},
+	"microsoft.network/dnsforwardingrulesets": {
+		func(input string) (interface{}, error) {
+			return privatednsParse.PrivateDnsResolverDnsForwardingRulesetID(input)
+		},
+	},
+	"microsoft.network/dnsforwardingrulesets/forwardingrules": {
+		func(input string) (interface{}, error) {
+			return privatednsParse.PrivateDnsResolverForwardingRuleID(input)
+		},
+	},
+	"microsoft.network/dnsforwardingrulesets/virtualnetworklinks": {
+		func(input string) (interface{}, error) {
+			return privatednsParse.PrivateDnsResolverVirtualNetworkLinkID(input)
+		},
+	},
+	"microsoft.network/dnsresolvers": {
+		func(input string) (interface{}, error) { return privatednsParse.PrivateDnsResolverID(input) },
+	},
+	"microsoft.network/dnsresolvers/inboundendpoints": {
+		func(input string) (interface{}, error) {
+			return privatednsParse.PrivateDnsResolverInboundEndpointID(input)
+		},
+	},
+	"microsoft.network/dnsresolvers/outboundendpoints": {
+		func(input string) (interface{}, error) {
+			return privatednsParse.PrivateDnsResolverOutboundEndpointID(input)
+		},
+	},
+	"microsoft.network/dnszones": {
+		func(input string) (interface{}, error) { return dnsParse.DnsZoneID(input) },
+	},
+	"microsoft.network/dnszones/a": {
+		func(input string) (interface{}, error) { return dnsParse.ARecordID(input) },
+	},
+	"microsoft.network/dnszones/aaaa": {
+		func(input string) (interface{}, error) { return dnsParse.AaaaRecordID(input) },
+	},
+	"microsoft.network/dnszones/caa": {
+		func(input string) (interface{}, error) { return dnsParse.CaaRecordID(input) },
+	},
+	"microsoft.network/dnszones/cname": {
+		func(input string) (interface{}, error) { return dnsParse.CnameRecordID(input) },
+	},
+	"microsoft.network/dnszones/mx": {
+		func(input string) (interface{}, error) { return dnsParse.MxRecordID(input) },
+	},
+	"microsoft.network/dnszones/ns": {
+		func(input string) (interface{}, error) { return dnsParse.NsRecordID(input) },
+	},
+	"microsoft.network/dnszones/ptr": {
+		func(input string) (interface{}, error) { return dnsParse.PtrRecordID(input) },
+	},
+	"microsoft.network/dnszones/srv": {
+		func(input string) (interface{}, error) { return dnsParse.SrvRecordID(input) },
+	},
+	"microsoft.network/dnszones/txt": {
+		func(input string) (interface{}, error) { return dnsParse.TxtRecordID(input) },
+	},
+	"microsoft.network/expressroutecircuits": {
+		func(input string) (interface{}, error) { return networkParse.ExpressRouteCircuitID(input) },
+	},
+	"microsoft.network/expressroutecircuits/authorizations": {
+		func(input string) (interface{}, error) { return networkParse.ExpressRouteCircuitAuthorizationID(input) },
+	},
+	"microsoft.network/expressroutecircuits/peerings": {
+		func(input string) (interface{}, error) { return networkParse.ExpressRouteCircuitPeeringID(input) },
+	},
+	"microsoft.network/expressroutecircuits/peerings/connections": {
+		func(input string) (interface{}, error) { return networkParse.ExpressRouteCircuitConnectionID(input) },
+	},
+	"microsoft.network/expressroutegateways": {
+		func(input string) (interface{}, error) { return networkParse.ExpressRouteGatewayID(input) },
+	},
+	"microsoft.network/expressroutegateways/expressrouteconnections": {
+		func(input string) (interface{}, error) { return networkParse.ExpressRouteConnectionID(input) },
+	},
+	"microsoft.network/expressrouteports": {
+		func(input string) (interface{}, error) { return networkParse.ExpressRoutePortID(input) },
+	},
+	"microsoft.network/firewallpolicies": {
+		func(input string) (interface{}, error) { return firewallParse.FirewallPolicyID(input) },
+	},
+	"microsoft.network/firewallpolicies/rulecollectiongroups": {
+		func(input string) (interface{}, error) {
+			return firewallParse.FirewallPolicyRuleCollectionGroupID(input)
+		},
+	},
+	"microsoft.network/firewallpolicies/rulecollectiongroups/rulecollections/rules": {
+		func(input string) (interface{}, error) { return firewallParse.FirewallPolicyRuleID(input) },
+	},
+	"microsoft.network/frontdoors": {
+		func(input string) (interface{}, error) { return frontdoorParse.FrontDoorID(input) },
+	},
+	"microsoft.network/frontdoors/backendpools": {
+		func(input string) (interface{}, error) { return frontdoorParse.BackendPoolID(input) },
+	},
+	"microsoft.network/frontdoors/customhttpsconfiguration": {
+		func(input string) (interface{}, error) { return frontdoorParse.CustomHttpsConfigurationID(input) },
+	},
+	"microsoft.network/frontdoors/frontendendpoints": {
+		func(input string) (interface{}, error) { return frontdoorParse.FrontendEndpointID(input) },
+	},
+	"microsoft.network/frontdoors/healthprobesettings": {
+		func(input string) (interface{}, error) { return frontdoorParse.HealthProbeID(input) },
+	},
+	"microsoft.network/frontdoors/loadbalancingsettings": {
+		func(input string) (interface{}, error) { return frontdoorParse.LoadBalancingID(input) },
+	},
+	"microsoft.network/frontdoors/routingrules": {
+		func(input string) (interface{}, error) { return frontdoorParse.RoutingRuleID(input) },
+	},
+	"microsoft.network/frontdoors/rulesengines": {
+		func(input string) (interface{}, error) { return frontdoorParse.RulesEngineID(input) },
+	},
+	"microsoft.network/frontdoorwebapplicationfirewallpolicies": {
+		func(input string) (interface{}, error) { return frontdoorParse.WebApplicationFirewallPolicyID(input) },
+	},
+	"microsoft.network/ipgroups": {
+		func(input string) (interface{}, error) { return networkParse.IpGroupID(input) },
+	},
+	"microsoft.network/loadbalancers": {
+		func(input string) (interface{}, error) { return loadbalancerParse.LoadBalancerID(input) },
+	},
+	"microsoft.network/loadbalancers/backendaddresspools": {
+		func(input string) (interface{}, error) {
+			return loadbalancerParse.LoadBalancerBackendAddressPoolID(input)
+		},
+		func(input string) (interface{}, error) { return networkParse.LoadBalancerBackendAddressPoolID(input) },
+	},
+	"microsoft.network/loadbalancers/backendaddresspools/addresses": {
+		func(input string) (interface{}, error) { return loadbalancerParse.BackendAddressPoolAddressID(input) },
+	},
+	"microsoft.network/loadbalancers/frontendipconfigurations": {
+		func(input string) (interface{}, error) {
+			return loadbalancerParse.LoadBalancerFrontendIpConfigurationID(input)
+		},
+	},
+	"microsoft.network/loadbalancers/inboundnatpools": {
+		func(input string) (interface{}, error) { return loadbalancerParse.LoadBalancerInboundNatPoolID(input) },
+	},
+	"microsoft.network/loadbalancers/inboundnatrules": {
+		func(input string) (interface{}, error) { return loadbalancerParse.LoadBalancerInboundNatRuleID(input) },
+		func(input string) (interface{}, error) { return networkParse.InboundNatRuleID(input) },
+	},
+	"microsoft.network/loadbalancers/loadbalancingrules": {
+		func(input string) (interface{}, error) { return frontdoorParse.LoadBalancingRuleID(input) },
+		func(input string) (interface{}, error) { return loadbalancerParse.LoadBalancingRuleID(input) },
+	},
+	"microsoft.network/loadbalancers/outboundrules": {
+		func(input string) (interface{}, error) { return loadbalancerParse.LoadBalancerOutboundRuleID(input) },
+	},
+	"microsoft.network/loadbalancers/probes": {
+		func(input string) (interface{}, error) { return loadbalancerParse.LoadBalancerProbeID(input) },
+	},
+	"microsoft.network/localnetworkgateways": {
+		func(input string) (interface{}, error) { return networkParse.LocalNetworkGatewayID(input) },
+	},
+	"microsoft.network/natgateways": {
+		func(input string) (interface{}, error) { return networkParse.NatGatewayID(input) },
+	},
+	"microsoft.network/networkinterfaces": {
+		func(input string) (interface{}, error) { return networkParse.NetworkInterfaceID(input) },
+	},
+	"microsoft.network/networkinterfaces/ipconfigurations": {
+		func(input string) (interface{}, error) { return networkParse.NetworkInterfaceIpConfigurationID(input) },
+	},
+	"microsoft.network/networkmanagers": {
+		func(input string) (interface{}, error) { return networkParse.NetworkManagerID(input) },
+	},
+	"microsoft.network/networkmanagers/connectivityconfigurations": {
+		func(input string) (interface{}, error) {
+			return networkParse.NetworkManagerConnectivityConfigurationID(input)
+		},
+	},
+	"microsoft.network/networkmanagers/locations/scopeaccesses": {
+		func(input string) (interface{}, error) { return networkParse.NetworkManagerDeploymentID(input) },
+	},
+	"microsoft.network/networkmanagers/networkgroups": {
+		func(input string) (interface{}, error) { return networkParse.NetworkManagerNetworkGroupID(input) },
+	},
+	"microsoft.network/networkmanagers/networkgroups/staticmembers": {
+		func(input string) (interface{}, error) { return networkParse.NetworkManagerStaticMemberID(input) },
+	},
+	"microsoft.network/networkmanagers/securityadminconfigurations": {
+		func(input string) (interface{}, error) {
+			return networkParse.NetworkManagerSecurityAdminConfigurationID(input)
+		},
+	},
+	"microsoft.network/networkmanagers/securityadminconfigurations/rulecollections": {
+		func(input string) (interface{}, error) {
+			return networkParse.NetworkManagerAdminRuleCollectionID(input)
+		},
+	},
+	"microsoft.network/networkmanagers/securityadminconfigurations/rulecollections/rules": {
+		func(input string) (interface{}, error) { return networkParse.NetworkManagerAdminRuleID(input) },
+	},
+	"microsoft.network/networkprofiles": {
+		func(input string) (interface{}, error) { return networkParse.NetworkProfileID(input) },
+	},
+	"microsoft.network/networksecuritygroups": {
+		func(input string) (interface{}, error) { return networkParse.NetworkSecurityGroupID(input) },
+	},
+	"microsoft.network/networksecuritygroups/securityrules": {
+		func(input string) (interface{}, error) { return networkParse.SecurityRuleID(input) },
+	},
+	"microsoft.network/networkwatchers": {
+		func(input string) (interface{}, error) { return networkParse.NetworkWatcherID(input) },
+	},
+	"microsoft.network/networkwatchers/connectionmonitors": {
+		func(input string) (interface{}, error) { return networkParse.ConnectionMonitorID(input) },
+	},
+	"microsoft.network/networkwatchers/flowlogs": {
+		func(input string) (interface{}, error) { return networkParse.FlowLogID(input) },
+	},
+	"microsoft.network/networkwatchers/packetcaptures": {
+		func(input string) (interface{}, error) { return networkParse.PacketCaptureID(input) },
+	},
+	"microsoft.network/p2svpngateways": {
+		func(input string) (interface{}, error) { return networkParse.PointToSiteVpnGatewayID(input) },
+	},
+	"microsoft.network/privatednszones": {
+		func(input string) (interface{}, error) { return privatednsParse.PrivateDnsZoneID(input) },
+	},
+	"microsoft.network/privatednszones/a": {
+		func(input string) (interface{}, error) { return privatednsParse.ARecordID(input) },
+	},
+	"microsoft.network/privatednszones/aaaa": {
+		func(input string) (interface{}, error) { return privatednsParse.AaaaRecordID(input) },
+	},
+	"microsoft.network/privatednszones/cname": {
+		func(input string) (interface{}, error) { return privatednsParse.CnameRecordID(input) },
+	},
+	"microsoft.network/privatednszones/mx": {
+		func(input string) (interface{}, error) { return privatednsParse.MxRecordID(input) },
+	},
+	"microsoft.network/privatednszones/ptr": {
+		func(input string) (interface{}, error) { return privatednsParse.PtrRecordID(input) },
+	},
+	"microsoft.network/privatednszones/srv": {
+		func(input string) (interface{}, error) { return privatednsParse.SrvRecordID(input) },
+	},
+	"microsoft.network/privatednszones/txt": {
+		func(input string) (interface{}, error) { return privatednsParse.TxtRecordID(input) },
+	},
+	"microsoft.network/privatednszones/virtualnetworklinks": {
+		func(input string) (interface{}, error) { return privatednsParse.VirtualNetworkLinkID(input) },
+	},
+	"microsoft.network/privateendpoints": {
+		func(input string) (interface{}, error) { return networkParse.PrivateEndpointID(input) },
+	},
+	"microsoft.network/privateendpoints/privatednszonegroups": {
+		func(input string) (interface{}, error) { return networkParse.PrivateDnsZoneGroupID(input) },
+	},
+	"microsoft.network/privateendpoints/privatednszonegroups/privatednszoneconfigs": {
+		func(input string) (interface{}, error) { return networkParse.PrivateDnsZoneConfigID(input) },
+	},
+	"microsoft.network/privatelinkservices": {
+		func(input string) (interface{}, error) { return networkParse.PrivateLinkServiceID(input) },
+	},
+	"microsoft.network/publicipaddresses": {
+		func(input string) (interface{}, error) { return networkParse.PublicIpAddressID(input) },
+	},
+	"microsoft.network/publicipprefixes": {
+		func(input string) (interface{}, error) { return networkParse.PublicIpPrefixID(input) },
+	},
+	"microsoft.network/routefilters": {
+		func(input string) (interface{}, error) { return networkParse.RouteFilterID(input) },
+	},
+	"microsoft.network/routetables": {
+		func(input string) (interface{}, error) { return networkParse.RouteTableID(input) },
+	},
+	"microsoft.network/routetables/routes": {
+		func(input string) (interface{}, error) { return networkParse.RouteID(input) },
+	},
+	"microsoft.network/securitypartnerproviders": {
+		func(input string) (interface{}, error) { return networkParse.SecurityPartnerProviderID(input) },
+	},
+	"microsoft.network/serviceendpointpolicies": {
+		func(input string) (interface{}, error) {
+			return networkParse.SubnetServiceEndpointStoragePolicyID(input)
+		},
+	},
+	"microsoft.network/trafficmanagerprofiles": {
+		func(input string) (interface{}, error) { return trafficmanagerParse.TrafficManagerProfileID(input) },
+	},
+	"microsoft.network/trafficmanagerprofiles/azureendpoints": {
+		func(input string) (interface{}, error) { return trafficmanagerParse.AzureEndpointID(input) },
+	},
+	"microsoft.network/trafficmanagerprofiles/externalendpoints": {
+		func(input string) (interface{}, error) { return trafficmanagerParse.ExternalEndpointID(input) },
+	},
+	"microsoft.network/trafficmanagerprofiles/nestedendpoints": {
+		func(input string) (interface{}, error) { return trafficmanagerParse.NestedEndpointID(input) },
+	},
+	"microsoft.network/virtualhubs": {
+		func(input string) (interface{}, error) { return networkParse.VirtualHubID(input) },
+	},
+	"microsoft.network/virtualhubs/bgpconnections": {
+		func(input string) (interface{}, error) { return networkParse.BgpConnectionID(input) },
+	},
+	"microsoft.network/virtualhubs/hubroutetables": {
+		func(input string) (interface{}, error) { return networkParse.HubRouteTableID(input) },
+	},
+	"microsoft.network/virtualhubs/hubroutetables/routes": {
+		func(input string) (interface{}, error) { return networkParse.HubRouteTableRouteID(input) },
+	},
+	"microsoft.network/virtualhubs/hubvirtualnetworkconnections": {
+		func(input string) (interface{}, error) { return networkParse.HubVirtualNetworkConnectionID(input) },
+	},
+	"microsoft.network/virtualhubs/ipconfigurations": {
+		func(input string) (interface{}, error) { return networkParse.VirtualHubIpConfigurationID(input) },
+	},
+	"microsoft.network/virtualnetworkgateways": {
+		func(input string) (interface{}, error) { return networkParse.VirtualNetworkGatewayID(input) },
+	},
+	"microsoft.network/virtualnetworkgateways/ipconfigurations": {
+		func(input string) (interface{}, error) {
+			return networkParse.VirtualNetworkGatewayIpConfigurationID(input)
+		},
+	},
+	"microsoft.network/virtualnetworks": {
+		func(input string) (interface{}, error) { return networkParse.VirtualNetworkID(input) },
+	},
+	"microsoft.network/virtualnetworks/dnsservers": {
+		func(input string) (interface{}, error) { return networkParse.VirtualNetworkDnsServersID(input) },
+	},
+	"microsoft.network/virtualnetworks/subnets": {
+		func(input string) (interface{}, error) { return networkParse.SubnetID(input) },
+	},
+	"microsoft.network/virtualnetworks/virtualnetworkpeerings": {
+		func(input string) (interface{}, error) { return networkParse.VirtualNetworkPeeringID(input) },
+	},
+	"microsoft.network/virtualwans": {
+		func(input string) (interface{}, error) { return networkParse.VirtualWanID(input) },
+	},
+	"microsoft.network/vpngateways": {
+		func(input string) (interface{}, error) { return networkParse.VpnGatewayID(input) },
+	},
+	"microsoft.network/vpngateways/natrules": {
+		func(input string) (interface{}, error) { return networkParse.VpnGatewayNatRuleID(input) },
+	},
+	"microsoft.network/vpngateways/vpnconnections": {
+		func(input string) (interface{}, error) { return networkParse.VpnConnectionID(input) },
+	},
+	"microsoft.network/vpnserverconfigurations": {
+		func(input string) (interface{}, error) { return networkParse.VpnServerConfigurationID(input) },
+	},
+	"microsoft.network/vpnsites": {
+		func(input string) (interface{}, error) { return networkParse.VpnSiteID(input) },
+	},
+	"microsoft.network/vpnsites/vpnsitelinks": {
+		func(input string) (interface{}, error) { return networkParse.VpnSiteLinkID(input) },
+	},
+	"microsoft.notificationhubs/namespaces": {
+		func(input string) (interface{}, error) { return notificationhubParse.NamespaceID(input) },
+	},
+	"microsoft.notificationhubs/namespaces/notificationhubs": {
+		func(input string) (interface{}, error) { return notificationhubParse.NotificationHubID(input) },
+	},
+	"microsoft.notificationhubs/namespaces/notificationhubs/authorizationrules": {
+		func(input string) (interface{}, error) {
+			return notificationhubParse.NotificationHubAuthorizationRuleID(input)
+		},
+	},
+	"microsoft.operationalinsights/clusters": {
+		func(input string) (interface{}, error) { return loganalyticsParse.LogAnalyticsClusterID(input) },
+	},
+	"microsoft.operationalinsights/workspaces": {
+		func(input string) (interface{}, error) { return loganalyticsParse.LogAnalyticsWorkspaceID(input) },
+	},
+	"microsoft.operationalinsights/workspaces/dataexports": {
+		func(input string) (interface{}, error) { return loganalyticsParse.LogAnalyticsDataExportID(input) },
+	},
+	"microsoft.operationalinsights/workspaces/datasources": {
+		func(input string) (interface{}, error) { return loganalyticsParse.DataSourceID(input) },
+	},
+	"microsoft.operationalinsights/workspaces/linkedservices": {
+		func(input string) (interface{}, error) { return loganalyticsParse.LogAnalyticsLinkedServiceID(input) },
+	},
+	"microsoft.operationalinsights/workspaces/linkedstorageaccounts": {
+		func(input string) (interface{}, error) {
+			return loganalyticsParse.LogAnalyticsLinkedStorageAccountID(input)
+		},
+	},
+	"microsoft.operationalinsights/workspaces/savedsearches": {
+		func(input string) (interface{}, error) { return loganalyticsParse.LogAnalyticsSavedSearchID(input) },
+	},
+	"microsoft.operationalinsights/workspaces/storageinsightconfigs": {
+		func(input string) (interface{}, error) { return loganalyticsParse.LogAnalyticsStorageInsightsID(input) },
+	},
+	"microsoft.operationsmanagement/solutions": {
+		func(input string) (interface{}, error) { return loganalyticsParse.LogAnalyticsSolutionID(input) },
+	},
+	"microsoft.portal/dashboards": {
+		func(input string) (interface{}, error) { return portalParse.DashboardID(input) },
+	},
+	"microsoft.purview/accounts": {
+		func(input string) (interface{}, error) { return purviewParse.AccountID(input) },
+	},
+	"microsoft.recoveryservices/vaults": {
+		func(input string) (interface{}, error) { return recoveryservicesParse.VaultID(input) },
+	},
+	"microsoft.recoveryservices/vaults/backupfabrics/protectioncontainers": {
+		func(input string) (interface{}, error) { return recoveryservicesParse.ProtectionContainerID(input) },
+	},
+	"microsoft.recoveryservices/vaults/backupfabrics/protectioncontainers/protecteditems": {
+		func(input string) (interface{}, error) { return recoveryservicesParse.ProtectedItemID(input) },
+	},
+	"microsoft.recoveryservices/vaults/backuppolicies": {
+		func(input string) (interface{}, error) { return recoveryservicesParse.BackupPolicyID(input) },
+	},
+	"microsoft.recoveryservices/vaults/replicationfabrics": {
+		func(input string) (interface{}, error) { return recoveryservicesParse.ReplicationFabricID(input) },
+	},
+	"microsoft.recoveryservices/vaults/replicationfabrics/replicationnetworks/replicationnetworkmappings": {
+		func(input string) (interface{}, error) {
+			return recoveryservicesParse.ReplicationNetworkMappingID(input)
+		},
+	},
+	"microsoft.recoveryservices/vaults/replicationfabrics/replicationprotectioncontainers": {
+		func(input string) (interface{}, error) {
+			return recoveryservicesParse.ReplicationProtectionContainerID(input)
+		},
+	},
+	"microsoft.recoveryservices/vaults/replicationfabrics/replicationprotectioncontainers/replicationprotecteditems": {
+		func(input string) (interface{}, error) {
+			return recoveryservicesParse.ReplicationProtectedItemID(input)
+		},
+	},
+	"microsoft.recoveryservices/vaults/replicationfabrics/replicationprotectioncontainers/replicationprotectioncontainermappings": {
+		func(input string) (interface{}, error) {
+			return recoveryservicesParse.ReplicationProtectionContainerMappingsID(input)
+		},
+	},
+	"microsoft.recoveryservices/vaults/replicationpolicies": {
+		func(input string) (interface{}, error) { return recoveryservicesParse.ReplicationPolicyID(input) },
+	},
+	"microsoft.resources/deployments": {
+		func(input string) (interface{}, error) { return resourceParse.ResourceGroupTemplateDeploymentID(input) },
+		func(input string) (interface{}, error) { return resourceParse.SubscriptionTemplateDeploymentID(input) },
+	},
+	"microsoft.resources/resourcegroups": {
+		func(input string) (interface{}, error) { return resourceParse.ResourceGroupID(input) },
+	},
+	"microsoft.resources/templatespecs/versions": {
+		func(input string) (interface{}, error) { return resourceParse.TemplateSpecVersionID(input) },
+	},
+	"microsoft.search/searchservices": {
+		func(input string) (interface{}, error) { return searchParse.SearchServiceID(input) },
+	},
+	"microsoft.security/assessmentmetadata": {
+		func(input string) (interface{}, error) { return securitycenterParse.AssessmentMetadataID(input) },
+	},
+	"microsoft.security/automations": {
+		func(input string) (interface{}, error) { return securitycenterParse.AutomationID(input) },
+	},
+	"microsoft.security/iotsecuritysolutions": {
+		func(input string) (interface{}, error) { return securitycenterParse.IotSecuritySolutionID(input) },
+	},
+	"microsoft.security/pricings": {
+		func(input string) (interface{}, error) { return securitycenterParse.PricingID(input) },
+	},
+	"microsoft.security/securitycontacts": {
+		func(input string) (interface{}, error) { return securitycenterParse.ContactID(input) },
+	},
+	"microsoft.security/settings": {
+		func(input string) (interface{}, error) { return securitycenterParse.SettingID(input) },
+	},
+	"microsoft.security/workspacesettings": {
+		func(input string) (interface{}, error) { return securitycenterParse.WorkspaceID(input) },
+	},
+	"microsoft.securityinsights/alertrules": {
+		func(input string) (interface{}, error) { return sentinelParse.AlertRuleID(input) },
+	},
+	"microsoft.securityinsights/alertruletemplates": {
+		func(input string) (interface{}, error) { return sentinelParse.SentinelAlertRuleTemplateID(input) },
+	},
+	"microsoft.securityinsights/automationrules": {
+		func(input string) (interface{}, error) { return sentinelParse.AutomationRuleID(input) },
+	},
+	"microsoft.securityinsights/dataconnectors": {
+		func(input string) (interface{}, error) { return sentinelParse.DataConnectorID(input) },
+	},
+	"microsoft.securityinsights/watchlists": {
+		func(input string) (interface{}, error) { return sentinelParse.WatchlistID(input) },
+	},
+	"microsoft.securityinsights/watchlists/watchlistitems": {
+		func(input string) (interface{}, error) { return sentinelParse.WatchlistItemID(input) },
+	},
+	"microsoft.servicebus/namespaces": {
+		func(input string) (interface{}, error) { return servicebusParse.NamespaceID(input) },
+	},
+	"microsoft.servicebus/namespaces/authorizationrules": {
+		func(input string) (interface{}, error) { return servicebusParse.NamespaceAuthorizationRuleID(input) },
+	},
+	"microsoft.servicebus/namespaces/disasterrecoveryconfigs": {
+		func(input string) (interface{}, error) {
+			return servicebusParse.NamespaceDisasterRecoveryConfigID(input)
+		},
+	},
+	"microsoft.servicebus/namespaces/networkrulesets": {
+		func(input string) (interface{}, error) { return servicebusParse.NamespaceNetworkRuleSetID(input) },
+	},
+	"microsoft.servicebus/namespaces/queues": {
+		func(input string) (interface{}, error) { return servicebusParse.QueueID(input) },
+	},
+	"microsoft.servicebus/namespaces/queues/authorizationrules": {
+		func(input string) (interface{}, error) { return servicebusParse.QueueAuthorizationRuleID(input) },
+	},
+	"microsoft.servicebus/namespaces/topics": {
+		func(input string) (interface{}, error) { return servicebusParse.TopicID(input) },
+	},
+	"microsoft.servicebus/namespaces/topics/authorizationrules": {
+		func(input string) (interface{}, error) { return servicebusParse.TopicAuthorizationRuleID(input) },
+	},
+	"microsoft.servicebus/namespaces/topics/subscriptions": {
+		func(input string) (interface{}, error) { return servicebusParse.SubscriptionID(input) },
+	},
+	"microsoft.servicebus/namespaces/topics/subscriptions/rules": {
+		func(input string) (interface{}, error) { return servicebusParse.SubscriptionRuleID(input) },
+	},
+	"microsoft.servicefabric/clusters": {
+		func(input string) (interface{}, error) { return servicefabricParse.ClusterID(input) },
+	},
+	"microsoft.servicefabric/managedclusters": {
+		func(input string) (interface{}, error) {
+			return servicefabricmanagedParse.ServiceFabricManagedClusterID(input)
+		},
+	},
+	"microsoft.servicefabricmesh/applications": {
+		func(input string) (interface{}, error) { return servicefabricmeshParse.ApplicationID(input) },
+	},
+	"microsoft.servicefabricmesh/networks": {
+		func(input string) (interface{}, error) { return servicefabricmeshParse.NetworkID(input) },
+	},
+	"microsoft.servicefabricmesh/secrets": {
+		func(input string) (interface{}, error) { return servicefabricmeshParse.SecretID(input) },
+	},
+	"microsoft.servicefabricmesh/secrets/values": {
+		func(input string) (interface{}, error) { return servicefabricmeshParse.SecretValueID(input) },
+	},
+	"microsoft.signalrservice/webpubsub": {
+		func(input string) (interface{}, error) { return signalrParse.WebPubsubID(input) },
+	},
+	"microsoft.signalrservice/webpubsub/hubs": {
+		func(input string) (interface{}, error) { return signalrParse.WebPubsubHubID(input) },
+	},
+	"microsoft.solutions/applicationdefinitions": {
+		func(input string) (interface{}, error) {
+			return managedapplicationsParse.ApplicationDefinitionID(input)
+		},
+	},
+	"microsoft.solutions/applications": {
+		func(input string) (interface{}, error) { return managedapplicationsParse.ApplicationID(input) },
+	},
+	"microsoft.sql/locations/instancefailovergroups": {
+		func(input string) (interface{}, error) { return sqlParse.InstanceFailoverGroupID(input) },
+	},
+	"microsoft.sql/managedinstances": {
+		func(input string) (interface{}, error) { return sqlParse.ManagedInstanceID(input) },
+	},
+	"microsoft.sql/managedinstances/administrators": {
+		func(input string) (interface{}, error) {
+			return sqlParse.ManagedInstanceAzureActiveDirectoryAdministratorID(input)
+		},
+	},
+	"microsoft.sql/managedinstances/databases": {
+		func(input string) (interface{}, error) { return sqlParse.ManagedDatabaseID(input) },
+	},
+	"microsoft.sql/servers": {
+		func(input string) (interface{}, error) { return mssqlParse.ServerID(input) },
+		func(input string) (interface{}, error) { return sqlParse.ServerID(input) },
+	},
+	"microsoft.sql/servers/administrators": {
+		func(input string) (interface{}, error) { return sqlParse.AzureActiveDirectoryAdministratorID(input) },
+	},
+	"microsoft.sql/servers/databases": {
+		func(input string) (interface{}, error) { return mssqlParse.DatabaseID(input) },
+		func(input string) (interface{}, error) { return sqlParse.DatabaseID(input) },
+	},
+	"microsoft.sql/servers/databases/extendedauditingsettings": {
+		func(input string) (interface{}, error) { return mssqlParse.DatabaseExtendedAuditingPolicyID(input) },
+	},
+	"microsoft.sql/servers/databases/vulnerabilityassessments/rules/baselines": {
+		func(input string) (interface{}, error) {
+			return mssqlParse.DatabaseVulnerabilityAssessmentRuleBaselineID(input)
+		},
+	},
+	"microsoft.sql/servers/elasticpools": {
+		func(input string) (interface{}, error) { return mssqlParse.ElasticPoolID(input) },
+		func(input string) (interface{}, error) { return sqlParse.ElasticPoolID(input) },
+	},
+	"microsoft.sql/servers/encryptionprotector": {
+		func(input string) (interface{}, error) { return mssqlParse.EncryptionProtectorID(input) },
+	},
+	"microsoft.sql/servers/extendedauditingsettings": {
+		func(input string) (interface{}, error) { return mssqlParse.ServerExtendedAuditingPolicyID(input) },
+	},
+	"microsoft.sql/servers/failovergroups": {
+		func(input string) (interface{}, error) { return mssqlParse.FailoverGroupID(input) },
+		func(input string) (interface{}, error) { return sqlParse.FailoverGroupID(input) },
+	},
+	"microsoft.sql/servers/firewallrules": {
+		func(input string) (interface{}, error) { return mssqlParse.FirewallRuleID(input) },
+		func(input string) (interface{}, error) { return sqlParse.FirewallRuleID(input) },
+	},
+	"microsoft.sql/servers/jobagents": {
+		func(input string) (interface{}, error) { return mssqlParse.JobAgentID(input) },
+	},
+	"microsoft.sql/servers/jobagents/credentials": {
+		func(input string) (interface{}, error) { return mssqlParse.JobCredentialID(input) },
+	},
+	"microsoft.sql/servers/recoverabledatabases": {
+		func(input string) (interface{}, error) { return mssqlParse.RecoverableDatabaseID(input) },
+	},
+	"microsoft.sql/servers/securityalertpolicies": {
+		func(input string) (interface{}, error) { return mssqlParse.ServerSecurityAlertPolicyID(input) },
+	},
+	"microsoft.sql/servers/virtualnetworkrules": {
+		func(input string) (interface{}, error) { return mssqlParse.VirtualNetworkRuleID(input) },
+		func(input string) (interface{}, error) { return sqlParse.VirtualNetworkRuleID(input) },
+	},
+	"microsoft.sql/servers/vulnerabilityassessments": {
+		func(input string) (interface{}, error) { return mssqlParse.ServerVulnerabilityAssessmentID(input) },
+	},
+	"microsoft.sqlvirtualmachine/sqlvirtualmachines": {
+		func(input string) (interface{}, error) { return mssqlParse.SqlVirtualMachineID(input) },
+	},
+	"microsoft.storage/storageaccounts": {
+		func(input string) (interface{}, error) { return storageParse.StorageAccountID(input) },
+	},
+	"microsoft.storage/storageaccounts/blobservices/containers": {
+		func(input string) (interface{}, error) { return storageParse.StorageContainerResourceManagerID(input) },
+	},
+	"microsoft.storage/storageaccounts/encryptionscopes": {
+		func(input string) (interface{}, error) { return storageParse.EncryptionScopeID(input) },
+	},
+	"microsoft.storage/storageaccounts/fileservices/fileshares": {
+		func(input string) (interface{}, error) { return storageParse.StorageShareResourceManagerID(input) },
+	},
+	"microsoft.storage/storageaccounts/inventorypolicies": {
+		func(input string) (interface{}, error) { return storageParse.BlobInventoryPolicyID(input) },
+	},
+	"microsoft.storage/storageaccounts/managementpolicies": {
+		func(input string) (interface{}, error) { return storageParse.StorageAccountManagementPolicyID(input) },
+	},
+	"microsoft.storagecache/caches": {
+		func(input string) (interface{}, error) { return hpccacheParse.CacheID(input) },
+	},
+	"microsoft.storagecache/caches/cacheaccesspolicies": {
+		func(input string) (interface{}, error) { return hpccacheParse.CacheAccessPolicyID(input) },
+	},
+	"microsoft.storagecache/caches/storagetargets": {
+		func(input string) (interface{}, error) { return hpccacheParse.StorageTargetID(input) },
+	},
+	"microsoft.storagesync/storagesyncservices": {
+		func(input string) (interface{}, error) { return storageParse.StorageSyncServiceID(input) },
+	},
+	"microsoft.storagesync/storagesyncservices/syncgroups": {
+		func(input string) (interface{}, error) { return storageParse.StorageSyncGroupID(input) },
+	},
+	"microsoft.storagesync/storagesyncservices/syncgroups/cloudendpoints": {
+		func(input string) (interface{}, error) { return storageParse.StorageSyncCloudEndpointID(input) },
+	},
+	"microsoft.streamanalytics/clusters": {
+		func(input string) (interface{}, error) { return streamanalyticsParse.ClusterID(input) },
+	},
+	"microsoft.streamanalytics/clusters/privateendpoints": {
+		func(input string) (interface{}, error) { return streamanalyticsParse.PrivateEndpointID(input) },
+	},
+	"microsoft.streamanalytics/streamingjobs": {
+		func(input string) (interface{}, error) { return streamanalyticsParse.StreamingJobID(input) },
+	},
+	"microsoft.streamanalytics/streamingjobs/functions": {
+		func(input string) (interface{}, error) { return streamanalyticsParse.FunctionID(input) },
+	},
+	"microsoft.streamanalytics/streamingjobs/inputs": {
+		func(input string) (interface{}, error) { return streamanalyticsParse.StreamInputID(input) },
+	},
+	"microsoft.streamanalytics/streamingjobs/outputs": {
+		func(input string) (interface{}, error) { return streamanalyticsParse.OutputID(input) },
+	},
+	"microsoft.synapse/privatelinkhubs": {
+		func(input string) (interface{}, error) { return synapseParse.PrivateLinkHubID(input) },
+	},
+	"microsoft.synapse/workspaces": {
+		func(input string) (interface{}, error) { return synapseParse.WorkspaceID(input) },
+	},
+	"microsoft.synapse/workspaces/administrators": {
+		func(input string) (interface{}, error) { return synapseParse.WorkspaceAADAdminID(input) },
+	},
+	"microsoft.synapse/workspaces/bigdatapools": {
+		func(input string) (interface{}, error) { return synapseParse.SparkPoolID(input) },
+	},
+	"microsoft.synapse/workspaces/extendedauditingsettings": {
+		func(input string) (interface{}, error) { return synapseParse.WorkspaceExtendedAuditingPolicyID(input) },
+	},
+	"microsoft.synapse/workspaces/firewallrules": {
+		func(input string) (interface{}, error) { return synapseParse.FirewallRuleID(input) },
+	},
+	"microsoft.synapse/workspaces/integrationruntimes": {
+		func(input string) (interface{}, error) { return synapseParse.IntegrationRuntimeID(input) },
+	},
+	"microsoft.synapse/workspaces/keys": {
+		func(input string) (interface{}, error) { return synapseParse.WorkspaceKeysID(input) },
+	},
+	"microsoft.synapse/workspaces/linkedservices": {
+		func(input string) (interface{}, error) { return synapseParse.LinkedServiceID(input) },
+	},
+	"microsoft.synapse/workspaces/managedvirtualnetworks/managedprivateendpoints": {
+		func(input string) (interface{}, error) { return synapseParse.ManagedPrivateEndpointID(input) },
+	},
+	"microsoft.synapse/workspaces/securityalertpolicies": {
+		func(input string) (interface{}, error) { return synapseParse.WorkspaceSecurityAlertPolicyID(input) },
+	},
+	"microsoft.synapse/workspaces/sqladministrators": {
+		func(input string) (interface{}, error) { return synapseParse.WorkspaceSqlAADAdminID(input) },
+	},
+	"microsoft.synapse/workspaces/sqlpools": {
+		func(input string) (interface{}, error) { return synapseParse.SqlPoolID(input) },
+	},
+	"microsoft.synapse/workspaces/sqlpools/extendedauditingsettings": {
+		func(input string) (interface{}, error) { return synapseParse.SqlPoolExtendedAuditingPolicyID(input) },
+	},
+	"microsoft.synapse/workspaces/sqlpools/securityalertpolicies": {
+		func(input string) (interface{}, error) { return synapseParse.SqlPoolSecurityAlertPolicyID(input) },
+	},
+	"microsoft.synapse/workspaces/sqlpools/vulnerabilityassessments": {
+		func(input string) (interface{}, error) { return synapseParse.SqlPoolVulnerabilityAssessmentID(input) },
+	},
+	"microsoft.synapse/workspaces/sqlpools/vulnerabilityassessments/rules/baselines": {
+		func(input string) (interface{}, error) {
+			return synapseParse.SqlPoolVulnerabilityAssessmentBaselineID(input)
+		},
+	},
+	"microsoft.synapse/workspaces/sqlpools/workloadgroups": {
+		func(input string) (interface{}, error) { return synapseParse.SqlPoolWorkloadGroupID(input) },
+	},
+	"microsoft.synapse/workspaces/sqlpools/workloadgroups/workloadclassifiers": {
+		func(input string) (interface{}, error) { return synapseParse.SqlPoolWorkloadClassifierID(input) },
+	},
+	"microsoft.synapse/workspaces/vulnerabilityassessments": {
+		func(input string) (interface{}, error) { return synapseParse.WorkspaceVulnerabilityAssessmentID(input) },
+	},
+	"microsoft.timeseriesinsights/environments": {
+		func(input string) (interface{}, error) { return iottimeseriesinsightsParse.EnvironmentID(input) },
+	},
+	"microsoft.timeseriesinsights/environments/accesspolicies": {
+		func(input string) (interface{}, error) { return iottimeseriesinsightsParse.AccessPolicyID(input) },
+	},
+	"microsoft.timeseriesinsights/environments/eventsources": {
+		func(input string) (interface{}, error) { return iottimeseriesinsightsParse.EventSourceID(input) },
+	},
+	"microsoft.timeseriesinsights/environments/referencedatasets": {
+		func(input string) (interface{}, error) { return iottimeseriesinsightsParse.ReferenceDataSetID(input) },
+	},
+	"microsoft.web/certificateorders": {
+		func(input string) (interface{}, error) { return webParse.CertificateOrderID(input) },
+	},
+	"microsoft.web/certificates": {
+		func(input string) (interface{}, error) { return webParse.CertificateID(input) },
+		func(input string) (interface{}, error) { return webParse.ManagedCertificateID(input) },
+	},
+	"microsoft.web/hostingenvironments": {
+		func(input string) (interface{}, error) { return appserviceParse.AppServiceEnvironmentID(input) },
+		func(input string) (interface{}, error) { return webParse.AppServiceEnvironmentID(input) },
+	},
+	"microsoft.web/serverfarms": {
+		func(input string) (interface{}, error) { return appserviceParse.ServicePlanID(input) },
+		func(input string) (interface{}, error) { return webParse.AppServicePlanID(input) },
+	},
+	"microsoft.web/sites": {
+		func(input string) (interface{}, error) { return appserviceParse.WebAppID(input) },
+		func(input string) (interface{}, error) { return appserviceParse.FunctionAppID(input) },
+		func(input string) (interface{}, error) { return logicParse.LogicAppStandardID(input) },
+		func(input string) (interface{}, error) { return webParse.AppServiceID(input) },
+		func(input string) (interface{}, error) { return webParse.FunctionAppID(input) },
+	},
+	"microsoft.web/sites/config": {
+		func(input string) (interface{}, error) { return webParse.VirtualNetworkSwiftConnectionID(input) },
+	},
+	"microsoft.web/sites/hostnamebindings": {
+		func(input string) (interface{}, error) { return webParse.HostnameBindingID(input) },
+	},
+	"microsoft.web/sites/hybridconnectionnamespaces/relays": {
+		func(input string) (interface{}, error) { return webParse.HybridConnectionID(input) },
+	},
+	"microsoft.web/sites/publiccertificates": {
+		func(input string) (interface{}, error) { return webParse.PublicCertificateID(input) },
+	},
+	"microsoft.web/sites/slots": {
+		func(input string) (interface{}, error) { return appserviceParse.WebAppSlotID(input) },
+		func(input string) (interface{}, error) { return appserviceParse.FunctionAppSlotID(input) },
+		func(input string) (interface{}, error) { return webParse.AppServiceSlotID(input) },
+		func(input string) (interface{}, error) { return webParse.FunctionAppSlotID(input) },
+	},
+	"microsoft.web/sites/slots/config": {
+		func(input string) (interface{}, error) { return webParse.SlotVirtualNetworkSwiftConnectionID(input) },
+	},
+	"microsoft.web/sites/slots/hostnamebindings": {
+		func(input string) (interface{}, error) { return webParse.AppServiceSlotCustomHostnameBindingID(input) },
+	},
+	"microsoft.web/staticsites": {
+		func(input string) (interface{}, error) { return webParse.StaticSiteID(input) },
+	},
+	"microsoft.web/staticsites/customdomains": {
+		func(input string) (interface{}, error) { return webParse.StaticSiteCustomDomainID(input) },
+	},
+}
@@ -13,6 +13,7 @@ import (
 	"unicode"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"gopkg.in/yaml.v2"
 )
 
 var packagesUsingAlias = map[string]struct{}{
@@ -26,6 +27,8 @@ func main() {
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
+	specPath := flag.String("spec", "", "The relative path to a Spec file defining all of the Resource IDs for this service package")
+	check := flag.Bool("check", false, "Should the generated files be compared to the existing files, rather than written? (only supported with `spec`)")
 	showHelp := flag.Bool("help", false, "Display this message")
 
 	flag.Parse()
@@ -35,6 +38,14 @@ func main() {
 		return
 	}
 
+	if *specPath != "" {
+		if err := runForSpec(*servicePackagePath, *specPath, *check); err != nil {
+			fmt.Fprintf(os.Stderr, "%+v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
 		panic(err)
 	}
@@ -46,22 +57,10 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
 	}
 
-	parsersPath := path.Join(servicePackagePath, "/parse")
-	if err := os.Mkdir(parsersPath, 0o755); err != nil && !os.IsExist(err) {
-		return fmt.Errorf("creating parse directory at %q: %+v", parsersPath, err)
-	}
-
-	validatorPath := path.Join(servicePackagePath, "/validate")
-	if err := os.Mkdir(validatorPath, 0o755); err != nil && !os.IsExist(err) {
-		return fmt.Errorf("creating validate directory at %q: %+v", validatorPath, err)
+	if err := ensureDirectoriesExist(servicePackagePath); err != nil {
+		return err
 	}
 
-	fileName := convertToSnakeCase(name)
-	validatorFileName := fmt.Sprintf("%s_id", fileName)
-	if strings.HasSuffix(fileName, "_test") {
-		// e.g. "webtest" in applicationInsights
-		fileName += "_id"
-	}
 	resourceId, err := NewResourceID(name, *servicePackage, id)
 	if err != nil {
 		return err
@@ -72,24 +71,78 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		ShouldRewrite: shouldRewrite,
 	}
 
-	parserFilePath := fmt.Sprintf("%s/%s.go", parsersPath, fileName)
-	if err := goFmtAndWriteToFile(parserFilePath, generator.Code()); err != nil {
-		return fmt.Errorf("generating Parser at %q: %+v", parserFilePath, err)
+	for _, file := range generator.Files(servicePackagePath) {
+		if err := goFmtAndWriteToFile(file.Path, file.Contents); err != nil {
+			return fmt.Errorf("generating %s at %q: %+v", file.Description, file.Path, err)
+		}
 	}
 
-	parserTestsFilePath := fmt.Sprintf("%s/%s_test.go", parsersPath, fileName)
-	if err := goFmtAndWriteToFile(parserTestsFilePath, generator.TestCode()); err != nil {
-		return fmt.Errorf("generating Parser Tests at %q: %+v", parserTestsFilePath, err)
+	return nil
+}
+
+// runForSpec generates (or when `check` is set, compares against the existing files) the Resource IDs
+// defined in the Spec file for this service package in a single pass
+func runForSpec(servicePackagePath, specPath string, check bool) error {
+	servicePackage, err := parseServicePackageName(servicePackagePath)
+	if err != nil {
+		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
 	}
 
-	validatorFilePath := fmt.Sprintf("%s/%s.go", validatorPath, validatorFileName)
-	if err := goFmtAndWriteToFile(validatorFilePath, generator.ValidatorCode()); err != nil {
-		return fmt.Errorf("generating Validator at %q: %+v", validatorFilePath, err)
+	spec, err := loadSpec(specPath)
+	if err != nil {
+		return fmt.Errorf("loading Spec from %q: %+v", specPath, err)
 	}
 
-	validatorTestsFilePath := fmt.Sprintf("%s/%s_test.go", validatorPath, validatorFileName)
-	if err := goFmtAndWriteToFile(validatorTestsFilePath, generator.ValidatorTestCode()); err != nil {
-		return fmt.Errorf("generating Validator Tests at %q: %+v", validatorTestsFilePath, err)
+	generators, err := spec.Generators(*servicePackage)
+	if err != nil {
+		return fmt.Errorf("building Resource IDs from the Spec %q: %+v", specPath, err)
+	}
+
+	if check {
+		drifted := make([]string, 0)
+		for _, generator := range generators {
+			for _, file := range generator.Files(servicePackagePath) {
+				matches, err := fileMatchesGeneratedCode(file.Path, file.Contents)
+				if err != nil {
+					return fmt.Errorf("comparing %s at %q: %+v", file.Description, file.Path, err)
+				}
+				if !matches {
+					drifted = append(drifted, file.Path)
+				}
+			}
+		}
+
+		if len(drifted) > 0 {
+			return fmt.Errorf("the following files don't match the Spec %q - run `make generate` to regenerate them:\n\n* %s", specPath, strings.Join(drifted, "\n* "))
+		}
+
+		return nil
+	}
+
+	if err := ensureDirectoriesExist(servicePackagePath); err != nil {
+		return err
+	}
+
+	for _, generator := range generators {
+		for _, file := range generator.Files(servicePackagePath) {
+			if err := goFmtAndWriteToFile(file.Path, file.Contents); err != nil {
+				return fmt.Errorf("generating %s at %q: %+v", file.Description, file.Path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ensureDirectoriesExist(servicePackagePath string) error {
+	parsersPath := path.Join(servicePackagePath, "/parse")
+	if err := os.Mkdir(parsersPath, 0o755); err != nil && !os.IsExist(err) {
+		return fmt.Errorf("creating parse directory at %q: %+v", parsersPath, err)
+	}
+
+	validatorPath := path.Join(servicePackagePath, "/validate")
+	if err := os.Mkdir(validatorPath, 0o755); err != nil && !os.IsExist(err) {
+		return fmt.Errorf("creating validate directory at %q: %+v", validatorPath, err)
 	}
 
 	return nil
@@ -162,6 +215,113 @@ func convertToSnakeCase(input string) string {
 	return strings.Join(out, "_")
 }
 
+// Spec defines each of the Resource IDs used within a service package, allowing these to be generated in a single pass
+type Spec struct {
+	ResourceIds []ResourceIdSpec `yaml:"resource_ids"`
+}
+
+type ResourceIdSpec struct {
+	// Name is the name of this Resource Type, without the Service Name
+	Name string `yaml:"name"`
+
+	// Id is an example of this Resource ID
+	Id string `yaml:"id"`
+
+	// Rewrite specifies whether an insensitive parser should also be generated, to workaround an API bug
+	Rewrite bool `yaml:"rewrite"`
+
+	// Parent is the Name of the Resource ID (defined within this Spec) which this Resource ID is nested within
+	Parent string `yaml:"parent"`
+
+	// Segments optionally overrides the generated Field Name and/or validates the user-specified value for a segment
+	Segments []ResourceIdSegmentSpec `yaml:"segments"`
+}
+
+type ResourceIdSegmentSpec struct {
+	// Key is the Segment Key in the Resource ID e.g. `resourceGroups`
+	Key string `yaml:"key"`
+
+	// Value is the value for this Segment within the example Resource ID, which is only required when
+	// the Key is present multiple times within the Resource ID
+	Value string `yaml:"value"`
+
+	// FieldName overrides the name of the Field generated for this segment
+	FieldName string `yaml:"field_name"`
+
+	// Validation is a Regular Expression which the value for this segment must match
+	Validation string `yaml:"validation"`
+
+	// InvalidValue is an example of a value which doesn't match the Validation, used in the generated tests
+	InvalidValue string `yaml:"invalid_value"`
+}
+
+func loadSpec(filePath string) (*Spec, error) {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := yaml.UnmarshalStrict(contents, &spec); err != nil {
+		return nil, fmt.Errorf("parsing: %+v", err)
+	}
+
+	return &spec, nil
+}
+
+// Generators validates the Spec and returns a ResourceIdGenerator for each Resource ID defined within it
+func (s Spec) Generators(servicePackageName string) ([]ResourceIdGenerator, error) {
+	if len(s.ResourceIds) == 0 {
+		return nil, fmt.Errorf("no Resource IDs were defined")
+	}
+
+	resourceIds := make(map[string]*ResourceId)
+	for _, v := range s.ResourceIds {
+		if v.Name == "" {
+			return nil, fmt.Errorf("a `name` must be specified for each Resource ID")
+		}
+		if _, exists := resourceIds[v.Name]; exists {
+			return nil, fmt.Errorf("the Resource ID %q is defined multiple times", v.Name)
+		}
+
+		resourceId, err := NewResourceID(v.Name, servicePackageName, v.Id)
+		if err != nil {
+			return nil, fmt.Errorf("building Resource ID %q: %+v", v.Name, err)
+		}
+
+		for _, segmentSpec := range v.Segments {
+			if err := resourceId.applySegmentSpec(segmentSpec); err != nil {
+				return nil, fmt.Errorf("applying segment %q to Resource ID %q: %+v", segmentSpec.Key, v.Name, err)
+			}
+		}
+
+		resourceIds[v.Name] = resourceId
+	}
+
+	generators := make([]ResourceIdGenerator, 0)
+	for _, v := range s.ResourceIds {
+		generator := ResourceIdGenerator{
+			ResourceId:    *resourceIds[v.Name],
+			ShouldRewrite: v.Rewrite,
+		}
+
+		if v.Parent != "" {
+			parent, ok := resourceIds[v.Parent]
+			if !ok {
+				return nil, fmt.Errorf("the Parent %q for the Resource ID %q isn't defined", v.Parent, v.Name)
+			}
+			if !generator.isNestedWithin(*parent) {
+				return nil, fmt.Errorf("the Resource ID %q isn't nested within the Parent %q", v.Name, v.Parent)
+			}
+			generator.Parent = parent
+		}
+
+		generators = append(generators, generator)
+	}
+
+	return generators, nil
+}
+
 type ResourceIdSegment struct {
 	// ArgumentName is the name which should be used when this segment is used in an Argument
 	ArgumentName string
@@ -174,6 +334,12 @@ type ResourceIdSegment struct {
 
 	// SegmentValue is the value for this segment used in the Resource ID
 	SegmentValue string
+
+	// Validation is an (optional) Regular Expression which the value for this segment must match
+	Validation string
+
+	// InvalidValue is an (optional) value which doesn't match the Validation, used in the generated tests
+	InvalidValue string
 }
 
 type ResourceId struct {
@@ -324,20 +490,167 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 	}, nil
 }
 
+func (id *ResourceId) applySegmentSpec(input ResourceIdSegmentSpec) error {
+	index := -1
+	for i, segment := range id.Segments {
+		if segment.SegmentKey != input.Key || (input.Value != "" && segment.SegmentValue != input.Value) {
+			continue
+		}
+		if index != -1 {
+			return fmt.Errorf("the key is present multiple times within the Resource ID - `value` must be specified")
+		}
+		index = i
+	}
+	if index == -1 {
+		return fmt.Errorf("the segment wasn't found within the Resource ID")
+	}
+
+	segment := id.Segments[index]
+	if input.FieldName != "" {
+		if segment.FieldName == "SubscriptionId" || segment.FieldName == "ResourceGroup" {
+			return fmt.Errorf("the `field_name` can't be overridden for the %q segment", segment.SegmentKey)
+		}
+		if !unicode.IsUpper([]rune(input.FieldName)[0]) {
+			return fmt.Errorf("the `field_name` %q must begin with an upper-case character", input.FieldName)
+		}
+		for i, v := range id.Segments {
+			if i != index && v.FieldName == input.FieldName {
+				return fmt.Errorf("the `field_name` %q is already used by the %q segment", input.FieldName, v.SegmentKey)
+			}
+		}
+
+		segment.FieldName = input.FieldName
+		segment.ArgumentName = strings.ToLower(input.FieldName[0:1]) + input.FieldName[1:]
+	}
+
+	if input.Validation != "" {
+		r, err := regexp.Compile(input.Validation)
+		if err != nil {
+			return fmt.Errorf("compiling the `validation` Regular Expression: %+v", err)
+		}
+		if !r.MatchString(segment.SegmentValue) {
+			return fmt.Errorf("the example value %q doesn't match the `validation` Regular Expression %q", segment.SegmentValue, input.Validation)
+		}
+		if input.InvalidValue != "" && r.MatchString(input.InvalidValue) {
+			return fmt.Errorf("the `invalid_value` %q matches the `validation` Regular Expression %q", input.InvalidValue, input.Validation)
+		}
+
+		segment.Validation = input.Validation
+		segment.InvalidValue = input.InvalidValue
+	} else if input.InvalidValue != "" {
+		return fmt.Errorf("`invalid_value` can only be specified when `validation` is specified")
+	}
+
+	id.Segments[index] = segment
+	return nil
+}
+
+// hasValidation returns whether any of the segments within this Resource ID validate the user-specified value
+func (id ResourceId) hasValidation() bool {
+	for _, segment := range id.Segments {
+		if segment.Validation != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// isNestedWithin returns whether this Resource ID is a child of the specified Resource ID, meaning that
+// the Parent's ID can be built using the first segments of this Resource ID
+func (id ResourceId) isNestedWithin(parent ResourceId) bool {
+	if len(parent.Segments) >= len(id.Segments) {
+		return false
+	}
+	if !strings.HasPrefix(id.IDFmt, parent.IDFmt+"/") {
+		return false
+	}
+
+	for i, segment := range parent.Segments {
+		if segment.SegmentKey != id.Segments[i].SegmentKey {
+			return false
+		}
+	}
+
+	return true
+}
+
+// valuesWith returns the example value for each segment, using the specified value for the segment at the specified index
+func (id ResourceId) valuesWith(index int, value string) []interface{} {
+	values := make([]interface{}, 0)
+	for i, segment := range id.Segments {
+		if i == index {
+			values = append(values, value)
+			continue
+		}
+		values = append(values, segment.SegmentValue)
+	}
+	return values
+}
+
 type ResourceIdGenerator struct {
 	ResourceId
 
+	// Parent is the (optional) Resource ID which this Resource ID is nested within
+	Parent *ResourceId
+
 	ShouldRewrite bool
 }
 
+type GeneratedFile struct {
+	Description string
+	Path        string
+	Contents    string
+}
+
+// Files returns the (unformatted) files which should be generated for this Resource ID
+func (id ResourceIdGenerator) Files(servicePackagePath string) []GeneratedFile {
+	parsersPath := path.Join(servicePackagePath, "/parse")
+	validatorPath := path.Join(servicePackagePath, "/validate")
+
+	fileName := convertToSnakeCase(id.TypeName)
+	validatorFileName := fmt.Sprintf("%s_id", fileName)
+	if strings.HasSuffix(fileName, "_test") {
+		// e.g. "webtest" in applicationInsights
+		fileName += "_id"
+	}
+
+	return []GeneratedFile{
+		{
+			Description: "Parser",
+			Path:        fmt.Sprintf("%s/%s.go", parsersPath, fileName),
+			Contents:    id.Code(),
+		},
+		{
+			Description: "Parser Tests",
+			Path:        fmt.Sprintf("%s/%s_test.go", parsersPath, fileName),
+			Contents:    id.TestCode(),
+		},
+		{
+			Description: "Validator",
+			Path:        fmt.Sprintf("%s/%s.go", validatorPath, validatorFileName),
+			Contents:    id.ValidatorCode(),
+		},
+		{
+			Description: "Validator Tests",
+			Path:        fmt.Sprintf("%s/%s_test.go", validatorPath, validatorFileName),
+			Contents:    id.ValidatorTestCode(),
+		},
+	}
+}
+
 func (id ResourceIdGenerator) Code() string {
+	regexpImport := ""
+	if id.hasValidation() {
+		regexpImport = "\n\t\"regexp\""
+	}
+
 	return fmt.Sprintf(`
 package parse
 
 // NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
 
 import (
-	"fmt"
+	"fmt"%s
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
@@ -349,7 +662,8 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+`, regexpImport, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParent(), id.codeForParser(), id.codeForParserInsensitive())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -440,6 +754,37 @@ func (id %[1]sId) ID() string {
 `, id.TypeName, id.IDFmt, formatKeysString)
 }
 
+func (id ResourceIdGenerator) codeForParent() string {
+	if id.Parent == nil {
+		return ""
+	}
+
+	arguments := make([]string, 0)
+	for i := range id.Parent.Segments {
+		arguments = append(arguments, fmt.Sprintf("id.%s", id.Segments[i].FieldName))
+	}
+	argumentsStr := strings.Join(arguments, ", ")
+	return fmt.Sprintf(`
+// %[2]sID returns the ID of the %[2]s which this %[1]s is nested within
+func (id %[1]sId) %[2]sID() %[2]sId {
+	return New%[2]sID(%[3]s)
+}
+`, id.TypeName, id.Parent.TypeName, argumentsStr)
+}
+
+// codeForSegmentValidation returns the code to validate the user-specified value for this segment, if required
+func (id ResourceIdGenerator) codeForSegmentValidation(segment ResourceIdSegment) string {
+	if segment.Validation == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+	if !regexp.MustCompile(%[2]q).MatchString(resourceId.%[1]s) {
+		return nil, fmt.Errorf("expected the value for the '%[3]s' element to match the Regular Expression %%q but got %%q", %[2]q, resourceId.%[1]s)
+	}
+`, segment.FieldName, segment.Validation, segment.SegmentKey)
+}
+
 func (id ResourceIdGenerator) codeForParser() string {
 	directAssignments := make([]string, 0)
 	if id.HasSubscriptionId {
@@ -459,12 +804,12 @@ func (id ResourceIdGenerator) codeForParser() string {
 	if resourceId.%[1]s == "" {
 		return nil, fmt.Errorf("ID was missing the '%[2]s' element")
 	}
-`, segment.FieldName, segment.SegmentKey))
+`, segment.FieldName, segment.SegmentKey)+id.codeForSegmentValidation(segment))
 			continue
 		}
 
 		fmtString := "\tif resourceId.%[1]s, err = id.PopSegment(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
+		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey)+id.codeForSegmentValidation(segment))
 	}
 	parserStatementsStr := strings.Join(parserStatements, "\n")
 	return fmt.Sprintf(`
@@ -514,7 +859,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 	if resourceId.%[1]s == "" {
 		return nil, fmt.Errorf("ID was missing the '%[2]s' element")
 	}
-`, segment.FieldName, segment.SegmentKey))
+`, segment.FieldName, segment.SegmentKey)+id.codeForSegmentValidation(segment))
 			continue
 		}
 
@@ -533,7 +878,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
     return nil, err
   }
 `
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
+		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey)+id.codeForSegmentValidation(segment))
 	}
 	parserStatementsStr := strings.Join(parserStatements, "\n")
 	return fmt.Sprintf(`
@@ -585,7 +930,60 @@ import (
 %s
 %s
 %s
-`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+%s
+`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForParent(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+}
+
+func (id ResourceIdGenerator) testCodeForParent() string {
+	if id.Parent == nil {
+		return ""
+	}
+
+	arguments := make([]string, 0)
+	parentValues := make([]interface{}, 0)
+	for i, segment := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
+		if i < len(id.Parent.Segments) {
+			parentValues = append(parentValues, segment.SegmentValue)
+		}
+	}
+	argumentsStr := strings.Join(arguments, ", ")
+
+	expected := fmt.Sprintf(id.Parent.IDFmt, parentValues...)
+
+	packagePrefix := ""
+	if id.TestPackageSuffix != "" {
+		packagePrefix = "parse."
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sIDParent(t *testing.T) {
+	actual := %[5]sNew%[1]sID(%[2]s).%[3]sID().ID()
+	expected := %[4]q
+	if actual != expected {
+		t.Fatalf("Expected %%q but got %%q", expected, actual)
+	}
+}
+`, id.TypeName, argumentsStr, id.Parent.TypeName, expected, packagePrefix)
+}
+
+// testCasesForInvalidValues returns a test case for each segment which specifies an invalid value
+func (id ResourceIdGenerator) testCasesForInvalidValues(errorField string) []string {
+	testCases := make([]string, 0)
+	for i, segment := range id.Segments {
+		if segment.InvalidValue == "" {
+			continue
+		}
+
+		input := fmt.Sprintf(id.IDFmt, id.valuesWith(i, segment.InvalidValue)...)
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// invalid value for %[1]s
+			Input: %[2]q,
+			%[3]s
+		},`, segment.FieldName, input, errorField))
+	}
+	return testCases
 }
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {
@@ -671,6 +1069,8 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 		},
 `, id.IDRaw, typeName, strings.Join(expectAssignments, "\n")))
 
+	testCases = append(testCases, id.testCasesForInvalidValues("Error: true,")...)
+
 	// add an intentionally failing upper-cased test case
 	testCases = append(testCases, fmt.Sprintf(`
 		{
@@ -965,6 +1365,8 @@ func (id ResourceIdGenerator) ValidatorTestCode() string {
 		},
 `, id.IDRaw))
 
+	testCases = append(testCases, id.testCasesForInvalidValues("Valid: false,")...)
+
 	// add an intentionally failing upper-cased test case
 	testCases = append(testCases, fmt.Sprintf(`
 		{
@@ -1045,6 +1447,24 @@ func goFmtAndWriteToFile(filePath, fileContents string) error {
 	return nil
 }
 
+// fileMatchesGeneratedCode returns whether the file at the specified path matches the (formatted) generated code
+func fileMatchesGeneratedCode(filePath, fileContents string) (bool, error) {
+	expected, err := GolangCodeFormatter{}.Format(fileContents)
+	if err != nil {
+		return false, err
+	}
+
+	existing, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return string(existing) == *expected, nil
+}
+
 type GolangCodeFormatter struct{}
 
 func (f GolangCodeFormatter) Format(input string) (*string, error) {
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -54,3 +57,284 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestSpecGenerators(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Spec  Spec
+		Error bool
+	}{
+		{
+			Name:  "empty",
+			Spec:  Spec{},
+			Error: true,
+		},
+		{
+			Name: "valid",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name: "Server",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+					},
+					{
+						Name:   "Database",
+						Id:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1",
+						Parent: "Server",
+						Segments: []ResourceIdSegmentSpec{
+							{
+								Key:          "databases",
+								Validation:   "^[a-z0-9]+$",
+								InvalidValue: "Database-1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "duplicate name",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name: "Server",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+					},
+					{
+						Name: "Server",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "undefined parent",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name:   "Database",
+						Id:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1",
+						Parent: "Server",
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "parent isn't a parent",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name: "Vault",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/vaults/vault1",
+					},
+					{
+						Name:   "Database",
+						Id:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1",
+						Parent: "Vault",
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "unknown segment",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name: "Server",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+						Segments: []ResourceIdSegmentSpec{
+							{
+								Key:       "databases",
+								FieldName: "DatabaseName",
+							},
+						},
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "example value doesn't match the validation",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name: "Server",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+						Segments: []ResourceIdSegmentSpec{
+							{
+								Key:        "servers",
+								Validation: "^[A-Z]+$",
+							},
+						},
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "duplicate segment key without a value",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name: "Subscription",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1",
+						Segments: []ResourceIdSegmentSpec{
+							{
+								Key:       "subscriptions",
+								FieldName: "TopicSubscriptionName",
+							},
+						},
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "duplicate segment key with a value",
+			Spec: Spec{
+				ResourceIds: []ResourceIdSpec{
+					{
+						Name: "Subscription",
+						Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1",
+						Segments: []ResourceIdSegmentSpec{
+							{
+								Key:       "subscriptions",
+								Value:     "subscription1",
+								FieldName: "TopicSubscriptionName",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		generators, err := v.Spec.Generators("example")
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if len(generators) != len(v.Spec.ResourceIds) {
+			t.Fatalf("expected %d generators but got %d", len(v.Spec.ResourceIds), len(generators))
+		}
+	}
+}
+
+func TestSpecGeneratedCode(t *testing.T) {
+	spec := Spec{
+		ResourceIds: []ResourceIdSpec{
+			{
+				Name: "Server",
+				Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+			},
+			{
+				Name:   "Database",
+				Id:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1",
+				Parent: "Server",
+				Segments: []ResourceIdSegmentSpec{
+					{
+						Key:       "servers",
+						FieldName: "SqlServerName",
+					},
+					{
+						Key:          "databases",
+						Validation:   "^[a-z0-9]+$",
+						InvalidValue: "Database-1",
+					},
+				},
+			},
+		},
+	}
+	generators, err := spec.Generators("example")
+	if err != nil {
+		t.Fatalf("building generators: %+v", err)
+	}
+
+	server := generators[0].Code()
+	if strings.Contains(server, "regexp") {
+		t.Fatalf("expected the Server Parser not to use `regexp` since no segments are validated:\n%s", server)
+	}
+
+	database := generators[1]
+	code := database.Code()
+	expectations := []string{
+		"\"regexp\"",
+		"SqlServerName\tstring",
+		"func (id DatabaseId) ServerID() ServerId {\n\treturn NewServerID(id.SubscriptionId, id.ResourceGroup, id.SqlServerName)\n}",
+		"if !regexp.MustCompile(\"^[a-z0-9]+$\").MatchString(resourceId.Name) {",
+	}
+	for _, expected := range expectations {
+		if !strings.Contains(code, expected) {
+			t.Fatalf("expected the Database Parser to contain %q:\n%s", expected, code)
+		}
+	}
+
+	testCode := database.TestCode()
+	testExpectations := []string{
+		"func TestDatabaseIDParent(t *testing.T) {",
+		"expected := \"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1\"",
+		"// invalid value for Name\n\t\t\tInput: \"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/Database-1\"",
+	}
+	for _, expected := range testExpectations {
+		if !strings.Contains(testCode, expected) {
+			t.Fatalf("expected the Database Parser Tests to contain %q:\n%s", expected, testCode)
+		}
+	}
+}
+
+func TestRunForSpecCheck(t *testing.T) {
+	servicePackagePath := filepath.Join(t.TempDir(), "services", "example")
+	if err := os.MkdirAll(servicePackagePath, 0o755); err != nil {
+		t.Fatalf("creating %q: %+v", servicePackagePath, err)
+	}
+
+	specPath := filepath.Join(servicePackagePath, "resourceids.yaml")
+	specContents := `resource_ids:
+  - name: Server
+    id: /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1
+`
+	if err := os.WriteFile(specPath, []byte(specContents), 0o644); err != nil {
+		t.Fatalf("writing Spec to %q: %+v", specPath, err)
+	}
+
+	if err := runForSpec(servicePackagePath, specPath, true); err == nil {
+		t.Fatalf("expected the check to fail prior to the files being generated")
+	}
+
+	if err := runForSpec(servicePackagePath, specPath, false); err != nil {
+		t.Fatalf("generating: %+v", err)
+	}
+	if err := runForSpec(servicePackagePath, specPath, true); err != nil {
+		t.Fatalf("expected the check to pass after the files were generated but got: %+v", err)
+	}
+
+	parserPath := filepath.Join(servicePackagePath, "parse", "server.go")
+	contents, err := os.ReadFile(parserPath)
+	if err != nil {
+		t.Fatalf("reading %q: %+v", parserPath, err)
+	}
+	contents = append(contents, []byte("\n// a manual change\n")...)
+	if err := os.WriteFile(parserPath, contents, 0o644); err != nil {
+		t.Fatalf("writing %q: %+v", parserPath, err)
+	}
+
+	err = runForSpec(servicePackagePath, specPath, true)
+	if err == nil {
+		t.Fatalf("expected the check to fail after the Parser was changed")
+	}
+	if !strings.Contains(err.Error(), parserPath) {
+		t.Fatalf("expected the error to reference %q but got: %+v", parserPath, err)
+	}
+}
@@ -0,0 +1,656 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"gopkg.in/yaml.v2"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+func main() {
+	f := flag.NewFlagSet("generator-schema-catalogue", flag.ExitOnError)
+
+	outputPath := f.String("output", "", "The path to the file where the catalogue should be written (defaults to stdout)")
+
+	_ = f.Parse(os.Args[1:])
+
+	if err := run(*outputPath); err != nil {
+		log.Print(err)
+		os.Exit(1)
+	}
+}
+
+func run(outputPath string) error {
+	// the Importers log each Resource ID being parsed, which isn't relevant here
+	log.SetOutput(io.Discard)
+	catalogue := buildCatalogue()
+	log.SetOutput(os.Stderr)
+
+	contents, err := json.MarshalIndent(catalogue, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing the catalogue: %+v", err)
+	}
+	contents = append(contents, '\n')
+
+	if outputPath == "" {
+		_, err := os.Stdout.Write(contents)
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, contents, 0o644); err != nil {
+		return fmt.Errorf("writing the catalogue to %q: %+v", outputPath, err)
+	}
+
+	return nil
+}
+
+// Catalogue describes each of the Data Sources and Resources within the Provider, keyed by the Terraform Resource Type
+type Catalogue struct {
+	DataSources map[string]CatalogueEntry `json:"data_sources"`
+	Resources   map[string]CatalogueEntry `json:"resources"`
+}
+
+type CatalogueEntry struct {
+	// Service is the name of the Service Registration containing this Data Source/Resource
+	Service string `json:"service"`
+
+	// Typed specifies whether this Data Source/Resource is implemented using the Typed SDK
+	Typed bool `json:"typed"`
+
+	// SourceFile is the path to the file (relative to the root of the repository) containing the Read function
+	SourceFile string `json:"source_file,omitempty"`
+
+	// AzureResourceType is the Azure Resource Type (e.g. `Microsoft.Network/virtualNetworks`) determined from the Resource ID
+	AzureResourceType string `json:"azure_resource_type,omitempty"`
+
+	// ResourceId is the Resource ID used by this Resource (which is determined using the Importer for the Resource)
+	ResourceId *CatalogueResourceId `json:"resource_id,omitempty"`
+
+	// ApiVersions are the API Versions of the Azure SDKs used by this Data Source/Resource
+	ApiVersions []CatalogueApiVersion `json:"api_versions"`
+
+	// ForceNewFields are the paths to each field which forces a new Resource to be created when changed
+	ForceNewFields []string `json:"force_new_fields"`
+
+	// SensitiveFields are the paths to each field which is Sensitive
+	SensitiveFields []string `json:"sensitive_fields"`
+
+	Schema map[string]CatalogueField `json:"schema"`
+}
+
+type CatalogueResourceId struct {
+	// Name is the name of the Resource ID declared within the Service Package's `resourceids.go`
+	Name string `json:"name"`
+
+	// Format is the format of this Resource ID, e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}`
+	Format string `json:"format"`
+
+	// Example is the example of this Resource ID declared within the Service Package's `resourceids.go`
+	Example string `json:"example"`
+}
+
+type CatalogueApiVersion struct {
+	ApiVersion string `json:"api_version"`
+	ImportPath string `json:"import_path"`
+}
+
+type CatalogueField struct {
+	Type        string                    `json:"type"`
+	ElementType string                    `json:"element_type,omitempty"`
+	Required    bool                      `json:"required,omitempty"`
+	Optional    bool                      `json:"optional,omitempty"`
+	Computed    bool                      `json:"computed,omitempty"`
+	ForceNew    bool                      `json:"force_new,omitempty"`
+	Sensitive   bool                      `json:"sensitive,omitempty"`
+	Default     interface{}               `json:"default,omitempty"`
+	Deprecated  string                    `json:"deprecated,omitempty"`
+	MinItems    int                       `json:"min_items,omitempty"`
+	MaxItems    int                       `json:"max_items,omitempty"`
+	Block       map[string]CatalogueField `json:"block,omitempty"`
+}
+
+func buildCatalogue() Catalogue {
+	catalogue := Catalogue{
+		DataSources: make(map[string]CatalogueEntry),
+		Resources:   make(map[string]CatalogueEntry),
+	}
+	declarations := newResourceIdDeclarations()
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, ds := range service.DataSources() {
+			wrapper := sdk.NewDataSourceWrapper(ds)
+			resource, err := wrapper.DataSource()
+			if err != nil {
+				log.Printf("[DEBUG] Skipping %q since the Data Source couldn't be built: %+v", ds.ResourceType(), err)
+				continue
+			}
+
+			catalogue.DataSources[ds.ResourceType()] = newCatalogueEntry(service.Name(), true, resource, sourceFileForTypedResource(ds))
+		}
+
+		for _, r := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				log.Printf("[DEBUG] Skipping %q since the Resource couldn't be built: %+v", r.ResourceType(), err)
+				continue
+			}
+
+			validateFunc := r.IDValidationFunc()
+			validate := func(id string) bool {
+				_, errors := validateFunc(id, "id")
+				return len(errors) == 0
+			}
+
+			sourceFile := sourceFileForTypedResource(r)
+			entry := newCatalogueEntry(service.Name(), true, resource, sourceFile)
+			entry.setResourceId(declarations.match(r.ResourceType(), sourceFile, validate))
+			catalogue.Resources[r.ResourceType()] = entry
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for resourceType, ds := range service.SupportedDataSources() {
+			catalogue.DataSources[resourceType] = newCatalogueEntry(service.Name(), false, ds, sourceFileForUntypedResource(service, resourceType, ds, "_data_source.go"))
+		}
+
+		for resourceType, r := range service.SupportedResources() {
+			sourceFile := sourceFileForUntypedResource(service, resourceType, r, "_resource.go")
+			entry := newCatalogueEntry(service.Name(), false, r, sourceFile)
+			if r.Importer != nil && r.Importer.StateContext != nil {
+				resource := r
+				entry.setResourceId(declarations.match(resourceType, sourceFile, func(id string) bool {
+					return pluginsdk.ImporterResourceIdError(resource, id) == nil
+				}))
+			}
+			catalogue.Resources[resourceType] = entry
+		}
+	}
+
+	// Data Sources can't be imported, however generally retrieve the same Azure Resource as the Resource of the same name
+	for resourceType, entry := range catalogue.DataSources {
+		if resource, ok := catalogue.Resources[resourceType]; ok {
+			entry.AzureResourceType = resource.AzureResourceType
+			entry.ResourceId = resource.ResourceId
+			catalogue.DataSources[resourceType] = entry
+		}
+	}
+
+	return catalogue
+}
+
+func newCatalogueEntry(serviceName string, typed bool, resource *schema.Resource, sourceFile string) CatalogueEntry {
+	entry := CatalogueEntry{
+		Service:         serviceName,
+		Typed:           typed,
+		ApiVersions:     apiVersionsForSourceFile(sourceFile),
+		ForceNewFields:  make([]string, 0),
+		SensitiveFields: make([]string, 0),
+		Schema:          flattenSchema(resource.Schema),
+	}
+
+	if index := strings.LastIndex(filepath.ToSlash(sourceFile), "internal/services/"); index != -1 {
+		entry.SourceFile = filepath.ToSlash(sourceFile)[index:]
+	}
+
+	walkSchema(resource.Schema, "", func(path string, field *schema.Schema) {
+		if field.ForceNew {
+			entry.ForceNewFields = append(entry.ForceNewFields, path)
+		}
+		if field.Sensitive {
+			entry.SensitiveFields = append(entry.SensitiveFields, path)
+		}
+	})
+	sort.Strings(entry.ForceNewFields)
+	sort.Strings(entry.SensitiveFields)
+
+	return entry
+}
+
+func (e *CatalogueEntry) setResourceId(input *resourceIdDeclaration) {
+	if input == nil {
+		return
+	}
+
+	e.ResourceId = &CatalogueResourceId{
+		Name:    input.name,
+		Format:  resourceIdFormat(input.example),
+		Example: input.example,
+	}
+	e.AzureResourceType = azureResourceType(input.example)
+}
+
+// flattenSchema returns the CatalogueField for each field within the Schema
+func flattenSchema(input map[string]*schema.Schema) map[string]CatalogueField {
+	output := make(map[string]CatalogueField)
+
+	for name, field := range input {
+		item := CatalogueField{
+			Type:       schemaTypeName(field.Type),
+			Required:   field.Required,
+			Optional:   field.Optional,
+			Computed:   field.Computed,
+			ForceNew:   field.ForceNew,
+			Sensitive:  field.Sensitive,
+			Default:    field.Default,
+			Deprecated: field.Deprecated,
+			MinItems:   field.MinItems,
+			MaxItems:   field.MaxItems,
+		}
+
+		switch v := field.Elem.(type) {
+		case *schema.Resource:
+			item.Block = flattenSchema(v.Schema)
+		case *schema.Schema:
+			item.ElementType = schemaTypeName(v.Type)
+		}
+
+		output[name] = item
+	}
+
+	return output
+}
+
+// walkSchema invokes the specified function for each field within the Schema (including those within blocks),
+// where the path is the dot-separated path to the field (e.g. `network.subnet_id`)
+func walkSchema(input map[string]*schema.Schema, parentPath string, fn func(path string, field *schema.Schema)) {
+	for name, field := range input {
+		path := name
+		if parentPath != "" {
+			path = fmt.Sprintf("%s.%s", parentPath, name)
+		}
+
+		fn(path, field)
+
+		if v, ok := field.Elem.(*schema.Resource); ok && v != nil {
+			walkSchema(v.Schema, path, fn)
+		}
+	}
+}
+
+func schemaTypeName(input schema.ValueType) string {
+	switch input {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeList:
+		return "list"
+	case schema.TypeMap:
+		return "map"
+	case schema.TypeSet:
+		return "set"
+	case schema.TypeString:
+		return "string"
+	}
+
+	return "unknown"
+}
+
+// sourceFileForTypedResource returns the path to the file containing the Read function for a Typed Data Source/Resource
+func sourceFileForTypedResource(input interface{}) string {
+	method, ok := reflect.TypeOf(input).MethodByName("Read")
+	if !ok {
+		return ""
+	}
+
+	return sourceFileForFunction(method.Func.Pointer())
+}
+
+// sourceFileForUntypedResource returns the path to the file containing the Read function for an Untyped Data Source/Resource
+func sourceFileForUntypedResource(service sdk.UntypedServiceRegistration, resourceType string, input *schema.Resource, fileSuffix string) string {
+	sourceFile := ""
+	switch {
+	case input.ReadContext != nil:
+		sourceFile = sourceFileForFunction(reflect.ValueOf(input.ReadContext).Pointer())
+	case input.ReadWithoutTimeout != nil:
+		sourceFile = sourceFileForFunction(reflect.ValueOf(input.ReadWithoutTimeout).Pointer())
+	case input.Read != nil: //nolint:staticcheck
+		sourceFile = sourceFileForFunction(reflect.ValueOf(input.Read).Pointer()) //nolint:staticcheck
+	}
+	if sourceFile == "" || serviceDirectory(sourceFile) != "" {
+		return sourceFile
+	}
+
+	// the Read function is wrapped outside of the Service Package (e.g. by `pluginsdk.EnablePrivateState`), in which
+	// case the file within the Service Package is determined from the Resource Type, by convention
+	method, ok := reflect.TypeOf(service).MethodByName("SupportedResources")
+	if !ok {
+		return ""
+	}
+	directory := serviceDirectory(sourceFileForFunction(method.Func.Pointer()))
+	if directory == "" {
+		return ""
+	}
+	sourceFile = filepath.Join(directory, strings.TrimPrefix(resourceType, "azurerm_")+fileSuffix)
+	if _, err := os.Stat(sourceFile); err != nil {
+		return ""
+	}
+	return sourceFile
+}
+
+func sourceFileForFunction(pc uintptr) string {
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+
+	file, _ := fn.FileLine(pc)
+	return file
+}
+
+// serviceDirectory returns the directory for the Service Package containing the specified file, which
+// is the closest directory containing a `registration.go`
+func serviceDirectory(sourceFile string) string {
+	if sourceFile == "" {
+		return ""
+	}
+
+	directory := filepath.Dir(sourceFile)
+	for {
+		if _, err := os.Stat(filepath.Join(directory, "registration.go")); err == nil {
+			return directory
+		}
+
+		parent := filepath.Dir(directory)
+		if parent == directory || filepath.Base(parent) == "internal" {
+			return ""
+		}
+		directory = parent
+	}
+}
+
+// apiVersionRegex matches the API Version within the import path for an Azure SDK, for example
+// `github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network`
+var apiVersionRegex = regexp.MustCompile(`/(\d{4}-\d{2}-\d{2}(?:-preview)?)(?:/|$)`)
+
+// apiVersionsForSourceFile returns the API Version for each Azure SDK imported by the specified file, falling
+// back to those imported by the Service Package's Client when the file doesn't import an Azure SDK directly
+func apiVersionsForSourceFile(sourceFile string) []CatalogueApiVersion {
+	if sourceFile == "" {
+		return make([]CatalogueApiVersion, 0)
+	}
+
+	output := apiVersionsForImports(sourceFile)
+	if len(output) > 0 {
+		return output
+	}
+
+	if directory := serviceDirectory(sourceFile); directory != "" {
+		return apiVersionsForImports(filepath.Join(directory, "client", "client.go"))
+	}
+
+	return output
+}
+
+func apiVersionsForImports(filePath string) []CatalogueApiVersion {
+	output := make([]CatalogueApiVersion, 0)
+
+	file, err := parser.ParseFile(token.NewFileSet(), filePath, nil, parser.ImportsOnly)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to parse the imports for %q: %+v", filePath, err)
+		return output
+	}
+
+	for _, v := range file.Imports {
+		importPath, err := strconv.Unquote(v.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		match := apiVersionRegex.FindStringSubmatch(importPath)
+		if match == nil {
+			continue
+		}
+
+		output = append(output, CatalogueApiVersion{
+			ApiVersion: match[1],
+			ImportPath: importPath,
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].ImportPath < output[j].ImportPath
+	})
+	return output
+}
+
+type resourceIdDeclaration struct {
+	name    string
+	example string
+}
+
+// resourceIdDeclarations are the Resource ID's declared within the `resourceids.go` file for each Service Package
+type resourceIdDeclarations struct {
+	// byDirectory are the Resource ID's declared for each Service Package, keyed by the directory
+	byDirectory map[string][]resourceIdDeclaration
+}
+
+func newResourceIdDeclarations() *resourceIdDeclarations {
+	return &resourceIdDeclarations{
+		byDirectory: make(map[string][]resourceIdDeclaration),
+	}
+}
+
+var (
+	goGenerateNameRegex = regexp.MustCompile(`\s-name=(\S+)`)
+	goGenerateIdRegex   = regexp.MustCompile(`\s-id=(\S+)`)
+	goGenerateSpecRegex = regexp.MustCompile(`\s-spec=(\S+)`)
+)
+
+// forDirectory returns the Resource ID's declared within the `resourceids.go` (either directly, or
+// using a Spec file) for the Service Package in the specified directory
+func (d *resourceIdDeclarations) forDirectory(directory string) []resourceIdDeclaration {
+	if v, ok := d.byDirectory[directory]; ok {
+		return v
+	}
+
+	output := make([]resourceIdDeclaration, 0)
+	contents, err := os.ReadFile(filepath.Join(directory, "resourceids.go"))
+	if err != nil {
+		d.byDirectory[directory] = output
+		return output
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		if !strings.HasPrefix(line, "//go:generate ") {
+			continue
+		}
+
+		if match := goGenerateSpecRegex.FindStringSubmatch(line); match != nil {
+			output = append(output, resourceIdDeclarationsFromSpec(filepath.Join(directory, match[1]))...)
+			continue
+		}
+
+		name := goGenerateNameRegex.FindStringSubmatch(line)
+		id := goGenerateIdRegex.FindStringSubmatch(line)
+		if name == nil || id == nil {
+			continue
+		}
+
+		output = append(output, resourceIdDeclaration{
+			name:    name[1],
+			example: id[1],
+		})
+	}
+
+	d.byDirectory[directory] = output
+	return output
+}
+
+func resourceIdDeclarationsFromSpec(filePath string) []resourceIdDeclaration {
+	output := make([]resourceIdDeclaration, 0)
+
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read the Spec %q: %+v", filePath, err)
+		return output
+	}
+
+	var spec struct {
+		ResourceIds []struct {
+			Name string `yaml:"name"`
+			Id   string `yaml:"id"`
+		} `yaml:"resource_ids"`
+	}
+	if err := yaml.Unmarshal(contents, &spec); err != nil {
+		log.Printf("[DEBUG] Unable to parse the Spec %q: %+v", filePath, err)
+		return output
+	}
+
+	for _, v := range spec.ResourceIds {
+		output = append(output, resourceIdDeclaration{
+			name:    v.Name,
+			example: v.Id,
+		})
+	}
+
+	return output
+}
+
+// match returns the Resource ID declaration (from the Service Package containing the specified file) which
+// is valid for the specified Resource, if one can be determined
+func (d *resourceIdDeclarations) match(resourceType, sourceFile string, validate func(id string) bool) *resourceIdDeclaration {
+	// when the Resource ID isn't validated during import, any Resource ID would match
+	if validate(pluginsdk.UnknownResourceId) {
+		return nil
+	}
+
+	directory := serviceDirectory(sourceFile)
+	if directory == "" {
+		return nil
+	}
+
+	candidates := make([]resourceIdDeclaration, 0)
+	for _, declaration := range d.forDirectory(directory) {
+		if validate(declaration.example) {
+			candidates = append(candidates, declaration)
+		}
+	}
+
+	return bestResourceIdDeclaration(resourceType, candidates)
+}
+
+// bestResourceIdDeclaration returns the only candidate - or where multiple Resource ID's are valid (for example
+// when these differ only by the Segment Keys) the candidate with the longest name matching the Resource Type
+func bestResourceIdDeclaration(resourceType string, candidates []resourceIdDeclaration) *resourceIdDeclaration {
+	if len(candidates) == 1 {
+		return &candidates[0]
+	}
+
+	name := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(resourceType, "azurerm_"), "_", ""))
+	var output *resourceIdDeclaration
+	for i, candidate := range candidates {
+		if !strings.HasSuffix(name, strings.ToLower(candidate.name)) {
+			continue
+		}
+		if output == nil || len(candidate.name) > len(output.name) {
+			output = &candidates[i]
+		}
+	}
+
+	return output
+}
+
+// resourceIdSegments returns the key/value pairs within the Resource ID
+func resourceIdSegments(input string) [][2]string {
+	split := strings.Split(strings.Trim(input, "/"), "/")
+	output := make([][2]string, 0)
+	for i := 0; i+1 < len(split); i += 2 {
+		output = append(output, [2]string{split[i], split[i+1]})
+	}
+	return output
+}
+
+// resourceIdFormat returns the format of the Resource ID, replacing the user-specified values within
+// the example with a placeholder, e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}`
+func resourceIdFormat(example string) string {
+	output := ""
+	for _, segment := range resourceIdSegments(example) {
+		key := segment[0]
+		value := segment[1]
+
+		switch {
+		case key == "providers":
+			// the Resource Provider is a fixed value
+		case key == "subscriptions" && !strings.Contains(output, "{subscriptionId}"):
+			value = "{subscriptionId}"
+		case strings.EqualFold(key, "resourceGroups"):
+			value = "{resourceGroupName}"
+		default:
+			value = fmt.Sprintf("{%sName}", singularCamelCase(key))
+		}
+
+		output += fmt.Sprintf("/%s/%s", key, value)
+	}
+
+	return output
+}
+
+func singularCamelCase(input string) string {
+	switch {
+	case strings.HasSuffix(input, "ies"):
+		input = strings.TrimSuffix(input, "ies") + "y"
+	case strings.HasSuffix(input, "sses"):
+		input = strings.TrimSuffix(input, "es")
+	case strings.HasSuffix(input, "s"):
+		input = strings.TrimSuffix(input, "s")
+	}
+
+	if input == "" {
+		return input
+	}
+
+	runes := []rune(input)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// azureResourceType returns the Azure Resource Type for the Resource ID, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func azureResourceType(example string) string {
+	segments := resourceIdSegments(example)
+
+	providerIndex := -1
+	for i, segment := range segments {
+		if segment[0] == "providers" {
+			providerIndex = i
+		}
+	}
+
+	if providerIndex == -1 {
+		if len(segments) == 0 {
+			return ""
+		}
+
+		// Subscriptions and Resource Groups are a part of the Resources Resource Provider
+		return fmt.Sprintf("Microsoft.Resources/%s", segments[len(segments)-1][0])
+	}
+
+	types := []string{segments[providerIndex][1]}
+	for _, segment := range segments[providerIndex+1:] {
+		types = append(types, segment[0])
+	}
+	return strings.Join(types, "/")
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+func TestResourceIdFormat(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: "/subscriptions/{subscriptionId}",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/address1",
+			Expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/publicIPAddresses/{publicIPAddressName}",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/images/image1",
+			Expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/galleries/{galleryName}/images/{imageName}",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1",
+			Expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{subscriptionName}",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := resourceIdFormat(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestAzureResourceType(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: "Microsoft.Resources/subscriptions",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: "Microsoft.Resources/resourceGroups",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1/providers/Microsoft.Maintenance/configurationAssignments/assignment1",
+			Expected: "Microsoft.Maintenance/configurationAssignments",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := azureResourceType(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestBestResourceIdDeclaration(t *testing.T) {
+	server := resourceIdDeclaration{
+		name:    "Server",
+		example: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+	}
+	sqlServer := resourceIdDeclaration{
+		name:    "SqlServer",
+		example: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+	}
+	database := resourceIdDeclaration{
+		name:    "Database",
+		example: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+	}
+
+	testData := []struct {
+		ResourceType string
+		Candidates   []resourceIdDeclaration
+		Expected     *resourceIdDeclaration
+	}{
+		{
+			ResourceType: "azurerm_sql_server",
+			Candidates:   []resourceIdDeclaration{},
+			Expected:     nil,
+		},
+		{
+			ResourceType: "azurerm_sql_database",
+			Candidates:   []resourceIdDeclaration{database},
+			Expected:     &database,
+		},
+		{
+			ResourceType: "azurerm_sql_server",
+			Candidates:   []resourceIdDeclaration{server, sqlServer},
+			Expected:     &sqlServer,
+		},
+		{
+			ResourceType: "azurerm_sql_elasticpool",
+			Candidates:   []resourceIdDeclaration{server, sqlServer},
+			Expected:     nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.ResourceType)
+
+		actual := bestResourceIdDeclaration(v.ResourceType, v.Candidates)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestResourceIdDeclarationsForDirectory(t *testing.T) {
+	directory := t.TempDir()
+
+	resourceIds := `package example
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Server -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -rewrite=true -name=Database -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -spec=./resourceids.yaml
+`
+	if err := os.WriteFile(filepath.Join(directory, "resourceids.go"), []byte(resourceIds), 0o644); err != nil {
+		t.Fatalf("writing resourceids.go: %+v", err)
+	}
+
+	spec := `resource_ids:
+  - name: Vault
+    id: /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/vaults/vault1
+`
+	if err := os.WriteFile(filepath.Join(directory, "resourceids.yaml"), []byte(spec), 0o644); err != nil {
+		t.Fatalf("writing resourceids.yaml: %+v", err)
+	}
+
+	expected := []resourceIdDeclaration{
+		{
+			name:    "Server",
+			example: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+		},
+		{
+			name:    "Database",
+			example: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1",
+		},
+		{
+			name:    "Vault",
+			example: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/vaults/vault1",
+		},
+	}
+
+	actual := newResourceIdDeclarations().forDirectory(directory)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestApiVersionsForImports(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "example_resource.go")
+	contents := `package example
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2020-01-13-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/example/sdk/2021-03-01/widgets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+`
+	if err := os.WriteFile(filePath, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing %q: %+v", filePath, err)
+	}
+
+	expected := []CatalogueApiVersion{
+		{
+			ApiVersion: "2021-05-01",
+			ImportPath: "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network",
+		},
+		{
+			ApiVersion: "2020-01-13-preview",
+			ImportPath: "github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2020-01-13-preview/automation",
+		},
+		{
+			ApiVersion: "2021-03-01",
+			ImportPath: "github.com/hashicorp/terraform-provider-azurerm/internal/services/example/sdk/2021-03-01/widgets",
+		},
+	}
+
+	actual := apiVersionsForImports(filePath)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestSourceFileForUntypedResource(t *testing.T) {
+	for _, service := range provider.SupportedUntypedServices() {
+		for resourceType, r := range service.SupportedResources() {
+			if resourceType != "azurerm_kubernetes_cluster" {
+				continue
+			}
+
+			// the Read function is wrapped by `pluginsdk.EnablePrivateState`
+			actual := filepath.ToSlash(sourceFileForUntypedResource(service, resourceType, r, "_resource.go"))
+			if !strings.HasSuffix(actual, "internal/services/containers/kubernetes_cluster_resource.go") {
+				t.Fatalf("expected the Source File to be `kubernetes_cluster_resource.go` but got %q", actual)
+			}
+			return
+		}
+	}
+
+	t.Fatalf("`azurerm_kubernetes_cluster` wasn't registered")
+}
+
+func TestNewCatalogueEntry(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"admin_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"shared_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	entry := newCatalogueEntry("Example", false, resource, "")
+
+	if expected := []string{"name", "network.subnet_id"}; !reflect.DeepEqual(entry.ForceNewFields, expected) {
+		t.Fatalf("Expected the ForceNew fields to be %+v but got %+v", expected, entry.ForceNewFields)
+	}
+	if expected := []string{"admin_password", "network.shared_key"}; !reflect.DeepEqual(entry.SensitiveFields, expected) {
+		t.Fatalf("Expected the Sensitive fields to be %+v but got %+v", expected, entry.SensitiveFields)
+	}
+	if v := entry.Schema["network"]; v.Type != "list" || v.MaxItems != 1 || !v.Block["subnet_id"].ForceNew {
+		t.Fatalf("Expected `network` to be a block containing `subnet_id` but got %+v", v)
+	}
+	if v := entry.Schema["zones"]; v.Type != "list" || v.ElementType != "string" {
+		t.Fatalf("Expected `zones` to be a list of strings but got %+v", v)
+	}
+}
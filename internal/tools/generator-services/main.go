@@ -3,14 +3,17 @@ package main
 import (
 	"flag"
 	"fmt"
+	"go/format"
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"gopkg.in/yaml.v2"
 )
 
 // Packages in this list are deprecated and cannot be run due to breaking API changes
@@ -36,6 +39,7 @@ func main() {
 
 	generators := []generator{
 		githubLabelsGenerator{},
+		importResourceIdParsersGenerator{},
 		teamCityServicesListGenerator{},
 		websiteCategoriesGenerator{},
 	}
@@ -235,6 +239,198 @@ func (websiteCategoriesGenerator) run(outputFileName string, _ map[string]struct
 	return writeToFile(outputFileName, fileContents)
 }
 
+type importResourceIdParsersGenerator struct{}
+
+func (importResourceIdParsersGenerator) outputPath(rootDirectory string) string {
+	return fmt.Sprintf("%s/internal/tools/generator-import/parsers/parsers.go", rootDirectory)
+}
+
+func (importResourceIdParsersGenerator) run(outputFileName string, _ map[string]struct{}) error {
+	template := `package parsers
+
+// NOTE: this is Generated from the Resource ID's declared within each Service Package - manual changes will be lost
+//       to re-generate this file, run 'make generate' in the root of the repository
+
+import (
+%s
+)
+
+type ResourceIdParser func(input string) (interface{}, error)
+
+// ResourceIdParsers are the Resource ID Parsers from each Service Package, keyed by the ARM Resource Type (lower-cased)
+var ResourceIdParsers = map[string][]ResourceIdParser{
+%s
+}
+`
+	servicesDirectory := filepath.Join(filepath.Dir(outputFileName), "..", "..", "..", "services")
+	directories, err := os.ReadDir(servicesDirectory)
+	if err != nil {
+		return err
+	}
+
+	imports := make([]string, 0)
+	parsers := make(map[string][]string)
+	for _, directory := range directories {
+		packageName := directory.Name()
+		declarations, err := resourceIdDeclarationsForDirectory(filepath.Join(servicesDirectory, packageName))
+		if err != nil {
+			return fmt.Errorf("retrieving the Resource ID's declared within %q: %+v", packageName, err)
+		}
+		if len(declarations) == 0 {
+			continue
+		}
+
+		parseFunctions, err := parseFunctionsForDirectory(filepath.Join(servicesDirectory, packageName, "parse"))
+		if err != nil {
+			return fmt.Errorf("retrieving the Parsers within %q: %+v", packageName, err)
+		}
+
+		alias := fmt.Sprintf("%sParse", packageName)
+		used := false
+		for _, declaration := range declarations {
+			functionName := fmt.Sprintf("%sID", declaration.Name)
+			if _, ok := parseFunctions[functionName]; !ok {
+				// the Parser hasn't been generated for this Resource ID
+				continue
+			}
+
+			armResourceType := strings.ToLower(armResourceTypeForResourceId(declaration.Id))
+			parsers[armResourceType] = append(parsers[armResourceType], fmt.Sprintf("func(input string) (interface{}, error) { return %s.%s(input) },", alias, functionName))
+			used = true
+		}
+
+		if used {
+			imports = append(imports, fmt.Sprintf("%s \"github.com/hashicorp/terraform-provider-azurerm/internal/services/%s/parse\"", alias, packageName))
+		}
+	}
+
+	armResourceTypes := make([]string, 0)
+	for k := range parsers {
+		armResourceTypes = append(armResourceTypes, k)
+	}
+	sort.Strings(armResourceTypes)
+
+	items := make([]string, 0)
+	for _, armResourceType := range armResourceTypes {
+		items = append(items, fmt.Sprintf("%q: {\n%s\n},", armResourceType, strings.Join(parsers[armResourceType], "\n")))
+	}
+
+	formatted, err := format.Source([]byte(fmt.Sprintf(template, strings.Join(imports, "\n"), strings.Join(items, "\n"))))
+	if err != nil {
+		return fmt.Errorf("formatting the Resource ID Parsers: %+v", err)
+	}
+	return writeToFile(outputFileName, string(formatted))
+}
+
+type resourceIdDeclaration struct {
+	Name string `yaml:"name"`
+	Id   string `yaml:"id"`
+}
+
+var (
+	goGenerateNameRegex = regexp.MustCompile(`\s-name=(\S+)`)
+	goGenerateIdRegex   = regexp.MustCompile(`\s-id=(\S+)`)
+	goGenerateSpecRegex = regexp.MustCompile(`\s-spec=(\S+)`)
+)
+
+// resourceIdDeclarationsForDirectory returns the Resource ID's declared within the `resourceids.go` (either directly,
+// or using a Spec file) for the Service Package in the specified directory
+func resourceIdDeclarationsForDirectory(directory string) ([]resourceIdDeclaration, error) {
+	output := make([]resourceIdDeclaration, 0)
+
+	contents, err := os.ReadFile(filepath.Join(directory, "resourceids.go"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return output, nil
+		}
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		if !strings.HasPrefix(line, "//go:generate ") {
+			continue
+		}
+
+		if match := goGenerateSpecRegex.FindStringSubmatch(line); match != nil {
+			specContents, err := os.ReadFile(filepath.Join(directory, match[1]))
+			if err != nil {
+				return nil, err
+			}
+
+			var spec struct {
+				ResourceIds []resourceIdDeclaration `yaml:"resource_ids"`
+			}
+			if err := yaml.Unmarshal(specContents, &spec); err != nil {
+				return nil, fmt.Errorf("parsing the Spec %q: %+v", match[1], err)
+			}
+
+			output = append(output, spec.ResourceIds...)
+			continue
+		}
+
+		name := goGenerateNameRegex.FindStringSubmatch(line)
+		id := goGenerateIdRegex.FindStringSubmatch(line)
+		if name == nil || id == nil {
+			continue
+		}
+
+		output = append(output, resourceIdDeclaration{
+			Name: name[1],
+			Id:   id[1],
+		})
+	}
+
+	return output, nil
+}
+
+var parseFunctionRegex = regexp.MustCompile(`(?m)^func ([A-Za-z0-9]+ID)\(input string\) \(`)
+
+// parseFunctionsForDirectory returns the names of the functions which parse a Resource ID within the Parse Package
+func parseFunctionsForDirectory(directory string) (map[string]struct{}, error) {
+	output := make(map[string]struct{})
+
+	files, err := filepath.Glob(filepath.Join(directory, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range parseFunctionRegex.FindAllStringSubmatch(string(contents), -1) {
+			output[match[1]] = struct{}{}
+		}
+	}
+
+	return output, nil
+}
+
+// armResourceTypeForResourceId returns the ARM Resource Type for the Resource ID, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func armResourceTypeForResourceId(input string) string {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+
+	types := make([]string, 0)
+	for i := 0; i+1 < len(segments); i += 2 {
+		if segments[i] == "providers" {
+			types = []string{segments[i+1]}
+			continue
+		}
+		types = append(types, segments[i])
+	}
+
+	// Subscriptions and Resource Groups are a part of the Resources Resource Provider
+	if !strings.Contains(input, "/providers/") && len(types) > 0 {
+		return fmt.Sprintf("Microsoft.Resources/%s", types[len(types)-1])
+	}
+
+	return strings.Join(types, "/")
+}
+
 func writeToFile(filePath string, contents string) error {
 	outputPath, err := filepath.Abs(filePath)
 	if err != nil {
@@ -3,10 +3,12 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"math"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
@@ -27,6 +30,7 @@ func main() {
 	resourceId := f.String("resource-id", "", "An Azure Resource ID showing an example of how to Import this Resource")
 	resourceType := f.String("type", "", "Whether this is a Data Source (data) or a Resource (resource)")
 	websitePath := f.String("website-path", "", "The relative path to the website folder")
+	check := f.Bool("check", false, "Check the existing documentation is consistent with the Schema, rather than generating it")
 
 	_ = f.Parse(os.Args[1:])
 
@@ -35,6 +39,31 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *check {
+		if websitePath == nil || *websitePath == "" {
+			quitWithError("The Relative Website Path must be specified via `-website-path`")
+			return
+		}
+
+		if *resourceType != "" && *resourceType != "data" && *resourceType != "resource" {
+			quitWithError("The type of the Data Source/Resource specified via `-type` must be either `data` or `resource`")
+			return
+		}
+
+		issues, err := runCheck(*resourceName, *resourceType, *websitePath)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, issue := range issues {
+			fmt.Println(issue)
+		}
+		if len(issues) > 0 {
+			quitWithError(fmt.Sprintf("Found %d inconsistencies between the Schema and the Documentation", len(issues)))
+		}
+		return
+	}
+
 	if resourceName == nil || *resourceName == "" {
 		quitWithError("The name of the Data Source/Resource must be specified via `-name`")
 		return
@@ -921,3 +950,517 @@ func (gen documentationGenerator) uniqueBlockNamesForAttribute(fields map[string
 
 	return blockNames, blocks
 }
+
+// consistency checks
+
+// runCheck compares the Schema for each registered Data Source/Resource (optionally filtered by name and type)
+// with the existing Documentation, returning a human-readable description of each inconsistency
+func runCheck(resourceName, resourceType, websitePath string) ([]string, error) {
+	azureProvider := provider.AzureProvider()
+
+	// the Importers log each Resource ID being parsed, which isn't relevant here
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	checkers := make([]documentationChecker, 0)
+	if resourceType == "" || resourceType == "data" {
+		for name, ds := range azureProvider.DataSourcesMap {
+			if resourceName != "" && name != resourceName {
+				continue
+			}
+
+			checkers = append(checkers, documentationChecker{
+				resourceName: name,
+				resource:     ds,
+				isDataSource: true,
+			})
+		}
+	}
+	if resourceType == "" || resourceType == "resource" {
+		for name, rs := range azureProvider.ResourcesMap {
+			if resourceName != "" && name != resourceName {
+				continue
+			}
+
+			checkers = append(checkers, documentationChecker{
+				resourceName: name,
+				resource:     rs,
+				isDataSource: false,
+			})
+		}
+	}
+
+	if resourceName != "" && len(checkers) == 0 {
+		return nil, fmt.Errorf("%q was not registered!", resourceName)
+	}
+
+	sort.Slice(checkers, func(i, j int) bool {
+		if checkers[i].resourceName != checkers[j].resourceName {
+			return checkers[i].resourceName < checkers[j].resourceName
+		}
+		return checkers[i].isDataSource
+	})
+
+	output := make([]string, 0)
+	for _, checker := range checkers {
+		filePath := checker.documentationPath(websitePath)
+		contents, err := os.ReadFile(filePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				output = append(output, fmt.Sprintf("%s: no documentation exists at %q", checker.displayName(), filePath))
+				continue
+			}
+
+			return nil, fmt.Errorf("reading documentation for %s from %q: %+v", checker.displayName(), filePath, err)
+		}
+
+		for _, issue := range checker.check(string(contents)) {
+			output = append(output, fmt.Sprintf("%s: %s", checker.displayName(), issue))
+		}
+	}
+
+	return output, nil
+}
+
+var (
+	// documentedBlockRegex matches the line introducing the fields within a block, for example
+	// "A `foo` block supports the following:", "`foo` exports the following:" or "### `foo` Block"
+	documentedBlockRegex = regexp.MustCompile("^(#+\\s*)?[^*]*?`([a-zA-Z0-9_]+)`\\s+(?:block|blocks|Block|Blocks|supports|exports)\\b")
+
+	// documentedDefaultRegex matches the default value within the description of a field, for example "Defaults to `foo`."
+	documentedDefaultRegex = regexp.MustCompile("Defaults to `([^`]*)`")
+
+	// documentedFieldRegex matches a field, for example "* `foo` - (Optional) The foo."
+	documentedFieldRegex = regexp.MustCompile("^\\*\\s+`([a-zA-Z0-9_]+)`\\s*-\\s*(.*)$")
+
+	// documentedImportRegex matches the example of importing a Resource, for example "terraform import azurerm_foo.example /subscriptions/..."
+	documentedImportRegex = regexp.MustCompile("^(?:\\$\\s*)?terraform import\\s+([a-zA-Z0-9_]+)\\.\\S+\\s+(.+)$")
+)
+
+// documentedField is a field parsed from either the Arguments or Attributes Reference within the Documentation
+type documentedField struct {
+	required     bool
+	optional     bool
+	forceNew     bool
+	defaultValue *string
+}
+
+// documentationSections are the sections of the Documentation which are compared with the Schema
+type documentationSections struct {
+	// arguments are the fields documented within the Arguments Reference, keyed by the block name (which is
+	// empty for top-level fields) and then the field name
+	arguments map[string]map[string]documentedField
+
+	// attributes are the fields documented within the Attributes Reference, keyed by the block name (which is
+	// empty for top-level fields) and then the field name
+	attributes map[string]map[string]documentedField
+
+	// imports are the Resource ID's used in the examples within the Import section, keyed by Resource Type
+	imports map[string][]string
+
+	hasArguments  bool
+	hasAttributes bool
+	hasImport     bool
+}
+
+func parseDocumentation(input string) documentationSections {
+	output := documentationSections{
+		arguments:  make(map[string]map[string]documentedField),
+		attributes: make(map[string]map[string]documentedField),
+		imports:    make(map[string][]string),
+	}
+
+	var fields map[string]map[string]documentedField
+	inImport := false
+	blockName := ""
+
+	// descriptions can span multiple lines, so the field is parsed once the description is complete
+	fieldName := ""
+	description := ""
+	parseField := func() {
+		if fieldName == "" {
+			return
+		}
+
+		field := documentedField{
+			required: strings.HasPrefix(description, "(Required"),
+			optional: strings.HasPrefix(description, "(Optional"),
+			forceNew: strings.Contains(strings.ToLower(description), "changing this forces a new"),
+		}
+		if v := documentedDefaultRegex.FindStringSubmatch(description); v != nil {
+			defaultValue := v[1]
+			field.defaultValue = &defaultValue
+		}
+
+		if _, ok := fields[blockName]; !ok {
+			fields[blockName] = make(map[string]documentedField)
+		}
+		fields[blockName][fieldName] = field
+
+		fieldName = ""
+		description = ""
+	}
+
+	for _, line := range strings.Split(input, "\n") {
+		line = strings.TrimSpace(line)
+
+		if strings.HasPrefix(line, "## ") {
+			parseField()
+
+			heading := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, "## ")))
+			fields = nil
+			inImport = false
+			blockName = ""
+
+			switch {
+			case strings.HasPrefix(heading, "argument"):
+				fields = output.arguments
+				output.hasArguments = true
+			case strings.HasPrefix(heading, "attribute"):
+				fields = output.attributes
+				output.hasAttributes = true
+			case strings.HasPrefix(heading, "import"):
+				inImport = true
+				output.hasImport = true
+			}
+			continue
+		}
+
+		if inImport {
+			if match := documentedImportRegex.FindStringSubmatch(line); match != nil {
+				id := strings.Trim(strings.TrimSpace(match[2]), "\"'")
+				output.imports[match[1]] = append(output.imports[match[1]], id)
+			}
+			continue
+		}
+
+		if fields == nil {
+			continue
+		}
+
+		if match := documentedFieldRegex.FindStringSubmatch(line); match != nil {
+			parseField()
+			fieldName = match[1]
+			description = match[2]
+			continue
+		}
+
+		// the fields within a block are introduced by either a heading or a sentence ending in a colon
+		if match := documentedBlockRegex.FindStringSubmatch(line); match != nil && (match[1] != "" || strings.HasSuffix(line, ":")) {
+			parseField()
+			blockName = match[2]
+			if _, ok := fields[blockName]; !ok {
+				fields[blockName] = make(map[string]documentedField)
+			}
+			continue
+		}
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "-") || strings.HasPrefix(line, "*") {
+			parseField()
+			continue
+		}
+
+		if fieldName != "" {
+			description += " " + line
+		}
+	}
+	parseField()
+
+	return output
+}
+
+// schemaFields are the fields within the Schema, keyed by the block name (which is empty for top-level fields)
+// and then the field name. Since the Documentation refers to blocks by name, the fields for blocks which share
+// a name are combined.
+type schemaFields struct {
+	arguments  map[string]map[string]*schema.Schema
+	attributes map[string]map[string]*schema.Schema
+}
+
+func flattenSchemaFields(input map[string]*schema.Schema) schemaFields {
+	output := schemaFields{
+		arguments:  make(map[string]map[string]*schema.Schema),
+		attributes: make(map[string]map[string]*schema.Schema),
+	}
+
+	var flatten func(input map[string]*schema.Schema, blockName string, computedOnly bool)
+	flatten = func(input map[string]*schema.Schema, blockName string, computedOnly bool) {
+		for name, field := range input {
+			isArgument := !computedOnly && (field.Optional || field.Required)
+			fields := output.attributes
+			if isArgument {
+				fields = output.arguments
+			}
+			if _, ok := fields[blockName]; !ok {
+				fields[blockName] = make(map[string]*schema.Schema)
+			}
+			if _, exists := fields[blockName][name]; !exists {
+				fields[blockName][name] = field
+			}
+
+			if v, ok := field.Elem.(*schema.Resource); ok && v != nil {
+				flatten(v.Schema, name, !isArgument)
+			}
+		}
+	}
+	flatten(input, "", false)
+
+	return output
+}
+
+type documentationChecker struct {
+	resource *schema.Resource
+
+	// resourceName is the name of the resource e.g. `azurerm_resource_group`
+	resourceName string
+
+	// isDataSource defines if this is a Data Source (if not it's a Resource)
+	isDataSource bool
+}
+
+func (c documentationChecker) displayName() string {
+	if c.isDataSource {
+		return fmt.Sprintf("Data Source %q", c.resourceName)
+	}
+
+	return fmt.Sprintf("Resource %q", c.resourceName)
+}
+
+func (c documentationChecker) documentationPath(websitePath string) string {
+	resourceKind := "r"
+	if c.isDataSource {
+		resourceKind = "d"
+	}
+
+	fileName := strings.TrimPrefix(c.resourceName, "azurerm_")
+	return filepath.Join(websitePath, "docs", resourceKind, fmt.Sprintf("%s.html.markdown", fileName))
+}
+
+func (c documentationChecker) check(contents string) []string {
+	docs := parseDocumentation(contents)
+	fields := flattenSchemaFields(c.resource.Schema)
+
+	issues := make([]string, 0)
+	issues = append(issues, c.checkArguments(docs, fields)...)
+	issues = append(issues, c.checkAttributes(docs, fields)...)
+	issues = append(issues, c.checkImport(docs)...)
+	return issues
+}
+
+func (c documentationChecker) checkArguments(docs documentationSections, fields schemaFields) []string {
+	if !docs.hasArguments {
+		if len(fields.arguments) == 0 {
+			return nil
+		}
+
+		return []string{"the `Arguments Reference` section is missing"}
+	}
+
+	issues := make([]string, 0)
+	for _, blockName := range sortedKeys(fields.arguments) {
+		arguments := fields.arguments[blockName]
+		documented, blockIsDocumented := docs.arguments[blockName]
+		if !blockIsDocumented {
+			issues = append(issues, fmt.Sprintf("the block `%s` isn't documented in the `Arguments Reference`", blockName))
+			continue
+		}
+
+		for _, name := range sortedKeys(arguments) {
+			field := arguments[name]
+			fieldName := displayFieldName(blockName, name)
+
+			doc, ok := documented[name]
+			if !ok {
+				// the documentation for Deprecated fields is commonly removed ahead of the field itself
+				if field.Deprecated == "" {
+					issues = append(issues, fmt.Sprintf("the argument %s isn't documented", fieldName))
+				}
+				continue
+			}
+
+			// the arguments for Data Sources are commonly documented without specifying whether they're Required/Optional
+			documentsRequirement := !c.isDataSource || doc.required || doc.optional
+			if field.Required && !doc.required && documentsRequirement {
+				issues = append(issues, fmt.Sprintf("the argument %s is Required but isn't documented as Required", fieldName))
+			}
+			if field.Optional && !doc.optional && documentsRequirement {
+				issues = append(issues, fmt.Sprintf("the argument %s is Optional but isn't documented as Optional", fieldName))
+			}
+
+			if field.ForceNew && !doc.forceNew {
+				issues = append(issues, fmt.Sprintf("the argument %s is ForceNew but the documentation doesn't mention this", fieldName))
+			}
+			if !field.ForceNew && doc.forceNew && !c.isDataSource {
+				issues = append(issues, fmt.Sprintf("the argument %s is documented as ForceNew but isn't", fieldName))
+			}
+
+			if defaultValue := formatDefaultValue(field.Default); defaultValue != nil {
+				if doc.defaultValue == nil {
+					issues = append(issues, fmt.Sprintf("the argument %s defaults to `%s` but the documentation doesn't mention this", fieldName, *defaultValue))
+				} else if strings.Trim(*doc.defaultValue, "\"") != *defaultValue {
+					issues = append(issues, fmt.Sprintf("the argument %s defaults to `%s` but is documented as defaulting to `%s`", fieldName, *defaultValue, *doc.defaultValue))
+				}
+			}
+		}
+	}
+
+	for _, blockName := range sortedKeys(docs.arguments) {
+		for _, name := range sortedKeys(docs.arguments[blockName]) {
+			if _, ok := fields.arguments[blockName][name]; ok {
+				continue
+			}
+
+			fieldName := displayFieldName(blockName, name)
+			if _, ok := fields.attributes[blockName][name]; ok {
+				issues = append(issues, fmt.Sprintf("the attribute %s is documented as an argument but can't be set", fieldName))
+				continue
+			}
+
+			issues = append(issues, fmt.Sprintf("the argument %s is documented but doesn't exist in the Schema", fieldName))
+		}
+	}
+
+	return issues
+}
+
+func (c documentationChecker) checkAttributes(docs documentationSections, fields schemaFields) []string {
+	if !docs.hasAttributes {
+		return []string{"the `Attributes Reference` section is missing"}
+	}
+
+	issues := make([]string, 0)
+	for _, blockName := range sortedKeys(fields.attributes) {
+		attributes := fields.attributes[blockName]
+		documented, blockIsDocumented := docs.attributes[blockName]
+		if !blockIsDocumented {
+			// Optional blocks which contain a Computed field commonly document these within the Arguments Reference
+			if _, ok := docs.arguments[blockName]; blockName != "" && ok {
+				documented = docs.arguments[blockName]
+			} else {
+				issues = append(issues, fmt.Sprintf("the block `%s` isn't documented in the `Attributes Reference`", blockName))
+				continue
+			}
+		}
+
+		for _, name := range sortedKeys(attributes) {
+			if _, ok := documented[name]; ok || attributes[name].Deprecated != "" {
+				continue
+			}
+
+			issues = append(issues, fmt.Sprintf("the attribute %s isn't documented", displayFieldName(blockName, name)))
+		}
+	}
+
+	for _, blockName := range sortedKeys(docs.attributes) {
+		for _, name := range sortedKeys(docs.attributes[blockName]) {
+			// the ID is present in every Data Source/Resource, but isn't a part of the Schema
+			if blockName == "" && name == "id" {
+				continue
+			}
+
+			_, isAttribute := fields.attributes[blockName][name]
+			_, isArgument := fields.arguments[blockName][name]
+			if isAttribute || isArgument {
+				continue
+			}
+
+			issues = append(issues, fmt.Sprintf("the attribute %s is documented but doesn't exist in the Schema", displayFieldName(blockName, name)))
+		}
+	}
+
+	return issues
+}
+
+func (c documentationChecker) checkImport(docs documentationSections) []string {
+	// Data Sources can't be imported
+	if c.isDataSource || c.resource.Importer == nil {
+		return nil
+	}
+
+	if !docs.hasImport {
+		return []string{"the `Import` section is missing"}
+	}
+
+	ids := docs.imports[c.resourceName]
+	if len(ids) == 0 {
+		return []string{fmt.Sprintf("the `Import` section doesn't contain an example of importing a %q", c.resourceName)}
+	}
+
+	issues := make([]string, 0)
+	for resourceType := range docs.imports {
+		if resourceType != c.resourceName {
+			issues = append(issues, fmt.Sprintf("the `Import` section contains an example of importing a %q", resourceType))
+		}
+	}
+	sort.Strings(issues)
+
+	// when the Importer doesn't validate the Resource ID (so accepts an empty Resource ID) there's nothing to
+	// check it against
+	if pluginsdk.ImporterResourceIdError(c.resource, "") == nil {
+		return issues
+	}
+
+	for _, id := range ids {
+		if err := pluginsdk.ImporterResourceIdError(c.resource, id); err != nil {
+			issues = append(issues, fmt.Sprintf("the Resource ID %q used in the `Import` section isn't valid: %+v", id, err))
+		}
+	}
+
+	return issues
+}
+
+func displayFieldName(blockName, fieldName string) string {
+	if blockName == "" {
+		return fmt.Sprintf("`%s`", fieldName)
+	}
+
+	return fmt.Sprintf("`%s` (within the `%s` block)", fieldName, blockName)
+}
+
+// formatDefaultValue returns the Default value for a field in the format used in the documentation
+func formatDefaultValue(input interface{}) *string {
+	var output string
+	switch v := input.(type) {
+	case bool:
+		output = strconv.FormatBool(v)
+	case int:
+		output = strconv.Itoa(v)
+	case float64:
+		output = strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		// an empty string is used to differentiate between an unset/empty value
+		if v == "" {
+			return nil
+		}
+		output = v
+	default:
+		return nil
+	}
+
+	return &output
+}
+
+func sortedKeys(input interface{}) []string {
+	keys := make([]string, 0)
+	switch v := input.(type) {
+	case map[string]map[string]*schema.Schema:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]*schema.Schema:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]map[string]documentedField:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]documentedField:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -159,6 +161,195 @@ A 'block3' block supports the following:
 	runTest(t, expectedOut, actualOut)
 }
 
+func TestResourceDocumentationCheck(t *testing.T) {
+	documentation := strings.ReplaceAll(`---
+subcategory: "Foobar Category"
+---
+
+# azurerm_foobar
+
+## Arguments Reference
+
+The following arguments are supported:
+
+* 'name' - (Required) The name which should be used for this Foobar. Changing this forces a new Foobar to be created.
+
+* 'resource_group_name' - (Optional) The name of the Resource Group where the Foobar should exist.
+
+* 'sku' - (Optional) The SKU which should be used. Defaults to 'Basic'.
+
+* 'replicas' - (Optional) The number of replicas. Defaults to '2'. Changing this
+  forces a new Foobar to be created.
+
+* 'legacy' - (Optional) This no longer exists.
+
+* 'network' - (Optional) A 'network' block as defined below.
+
+---
+
+A 'network' block supports the following:
+
+* 'subnet_id' - (Required) The ID of the Subnet.
+
+## Attributes Reference
+
+In addition to the Arguments listed above - the following Attributes are exported:
+
+* 'id' - The ID of the Foobar.
+
+* 'endpoint' - The Endpoint of the Foobar.
+
+## Import
+
+Foobars can be imported using the 'resource id', e.g.
+
+'''shell
+terraform import azurerm_foobar.example /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/foobars/foobar1
+'''`, "'", "`")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Standard",
+			},
+			"replicas": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  2,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if !strings.HasPrefix(d.Id(), "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/") {
+					return nil, fmt.Errorf("parsing Resource ID %q: ID was missing the `providers` element", d.Id())
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+
+	checker := documentationChecker{
+		resourceName: RESOURCE_NAME,
+		resource:     resource,
+	}
+	actual := checker.check(documentation)
+	expected := []string{
+		"the argument `enabled` isn't documented",
+		"the argument `resource_group_name` is Required but isn't documented as Required",
+		"the argument `resource_group_name` is ForceNew but the documentation doesn't mention this",
+		"the argument `sku` defaults to `Standard` but is documented as defaulting to `Basic`",
+		"the argument `subnet_id` (within the `network` block) is Optional but isn't documented as Optional",
+		"the argument `legacy` is documented but doesn't exist in the Schema",
+		"the attribute `fqdn` isn't documented",
+		"the Resource ID \"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/foobars/foobar1\" used in the `Import` section isn't valid: parsing Resource ID \"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/foobars/foobar1\": ID was missing the `providers` element",
+	}
+
+	runTest(t, strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+}
+
+func TestDataSourceDocumentationCheck(t *testing.T) {
+	documentation := strings.ReplaceAll(`## Arguments Reference
+
+* 'name' - The name of this Foobar.
+
+* 'resource_group_name' - (Optional) The name of the Resource Group where the Foobar exists.
+
+## Attributes Reference
+
+* 'id' - The ID of the Foobar.
+
+* 'network' - A 'network' block as defined below.
+
+---
+
+A 'network' block exports the following:
+
+* 'subnet_id' - The ID of the Subnet.
+
+* 'private_ip_address' - The Private IP Address.`, "'", "`")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	checker := documentationChecker{
+		resourceName: RESOURCE_NAME,
+		resource:     resource,
+		isDataSource: true,
+	}
+	actual := checker.check(documentation)
+	expected := []string{
+		"the argument `resource_group_name` is Required but isn't documented as Required",
+		"the attribute `public_ip_address` (within the `network` block) isn't documented",
+		"the attribute `private_ip_address` (within the `network` block) is documented but doesn't exist in the Schema",
+	}
+
+	runTest(t, strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+}
+
 func runTest(t *testing.T, expected, actual string) {
 	dmp := diffmatchpatch.New()
 	diffs := dmp.DiffMain(actual, expected, true)
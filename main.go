@@ -5,8 +5,11 @@ import (
 	"flag"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 func main() {
@@ -18,17 +21,22 @@ func main() {
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	// the Private State is exposed to Resources via the gRPC server, so that Long Running Operations can be resumed
+	grpcProviderFunc := func() tfprotov5.ProviderServer {
+		return pluginsdk.NewProviderServerWithPrivateState(schema.NewGRPCProviderServer(provider.AzureProvider()))
+	}
+
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/azurerm",
 			&plugin.ServeOpts{
-				ProviderFunc: provider.AzureProvider,
+				GRPCProviderFunc: grpcProviderFunc,
 			})
 		if err != nil {
 			log.Println(err.Error())
 		}
 	} else {
 		plugin.Serve(&plugin.ServeOpts{
-			ProviderFunc: provider.AzureProvider,
+			GRPCProviderFunc: grpcProviderFunc,
 		})
 	}
 }